@@ -34,6 +34,18 @@ var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 // ErrGetRolesForAccount signals an error in getting esdt tokens and roles for a given address
 var ErrGetRolesForAccount = errors.New("get roles for account error")
 
+// ErrQueryLogs signals an error in querying the smart contract logs
+var ErrQueryLogs = errors.New("query logs error")
+
+// ErrSubscribe signals an error in creating a new subscription
+var ErrSubscribe = errors.New("subscribe error")
+
+// ErrGetTransactionsByAddress signals an error in getting the transactions of a given address
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
+// ErrGetDelegationPortfolio signals an error in getting the delegation positions of a given address
+var ErrGetDelegationPortfolio = errors.New("get delegation portfolio error")
+
 // ErrGetESDTNFTData signals an error in getting esdt nft data for given address, tokenID and nonce
 var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
 
@@ -76,9 +88,15 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrValidationEmptyToken signals that an empty token was provided
 var ErrValidationEmptyToken = errors.New("token is empty")
 
+// ErrValidationEmptyGovernanceReference signals that an empty governance proposal reference was provided
+var ErrValidationEmptyGovernanceReference = errors.New("governance proposal reference is empty")
+
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionsPool signals an error happening when trying to inspect the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -100,6 +118,15 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyAddresses signals that no address was provided
+var ErrValidationEmptyAddresses = errors.New("no address provided")
+
+// ErrValidationTooManyAddresses signals that too many addresses were provided in a single request
+var ErrValidationTooManyAddresses = errors.New("too many addresses")
+
+// ErrValidationTooManyLeaves signals that a too large number of leaves was requested
+var ErrValidationTooManyLeaves = errors.New("too many leaves requested")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -117,3 +144,12 @@ var ErrNilFacadeHandler = errors.New("nil facade handler")
 
 // ErrFacadeWrongTypeAssertion signals that a type conversion to a facade type failed
 var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
+
+// ErrNodeNotLive signals that at least one of the node's components failed its liveness check
+var ErrNodeNotLive = errors.New("node is not live")
+
+// ErrNodeNotReady signals that at least one of the node's components failed its readiness check
+var ErrNodeNotReady = errors.New("node is not ready")
+
+// ErrOriginNotAllowed signals that the origin of a web socket connection request is not allowed
+var ErrOriginNotAllowed = errors.New("origin not allowed")
@@ -156,6 +156,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["hardfork"] = hardforkGroup
 
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
 	networkGroup, err := groups.NewNetworkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -174,6 +180,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscriptions"] = subscriptionsGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -222,6 +234,13 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
+	routeMetricsMiddleware, err := middleware.NewRouteMetricsMiddleware(ws.facade.StatusMetrics())
+	if err != nil {
+		return nil, err
+	}
+
+	middlewares = append(middlewares, routeMetricsMiddleware)
+
 	sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,75 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	blockNonceQueryParam    = "blockNonce"
+	blockHashQueryParam     = "blockHash"
+	blockRootHashQueryParam = "rootHash"
+)
+
+// parseAccountQueryOptions reads the block an account query should be resolved at. At most one of the blockNonce,
+// blockHash or rootHash query parameters is accepted; none of them means the current state
+func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error) {
+	query := c.Request.URL.Query()
+	options := common.AccountQueryOptions{}
+	numProvided := 0
+
+	blockNonce := query.Get(blockNonceQueryParam)
+	if blockNonce != "" {
+		nonce, err := strconv.ParseUint(blockNonce, 10, 64)
+		if err != nil {
+			return common.AccountQueryOptions{}, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, blockNonceQueryParam)
+		}
+
+		options.BlockNonce = common.OptionalUint64{Value: nonce, HasValue: true}
+		numProvided++
+	}
+
+	blockHash, err := getHexQueryParam(c, blockHashQueryParam)
+	if err != nil {
+		return common.AccountQueryOptions{}, err
+	}
+	if len(blockHash) > 0 {
+		options.BlockHash = blockHash
+		numProvided++
+	}
+
+	rootHash, err := getHexQueryParam(c, blockRootHashQueryParam)
+	if err != nil {
+		return common.AccountQueryOptions{}, err
+	}
+	if len(rootHash) > 0 {
+		options.BlockRootHash = rootHash
+		numProvided++
+	}
+
+	if numProvided > 1 {
+		return common.AccountQueryOptions{}, fmt.Errorf("%w: only one of %s, %s or %s can be provided",
+			errors.ErrInvalidQueryParameter, blockNonceQueryParam, blockHashQueryParam, blockRootHashQueryParam)
+	}
+
+	return options, nil
+}
+
+func getHexQueryParam(c *gin.Context, name string) ([]byte, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return nil, nil
+	}
+
+	value, err := hex.DecodeString(valueStr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,20 +31,27 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+	getDelegationPath         = "/:address/delegation"
+
+	defaultTransactionsPageSize = 25
+	maxTransactionsPageSize     = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
 type addressFacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (api.AccountResponse, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetESDTsRoles(address string) (map[string][]string, error)
 	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
 	GetESDTsWithRole(address string, role string) ([]string, error)
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
+	GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error)
+	GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error)
 	IsInterfaceNil() bool
 }
 
@@ -137,6 +147,16 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
+		{
+			Path:    getDelegationPath,
+			Method:  http.MethodGet,
+			Handler: ag.getDelegationPortfolio,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -146,7 +166,20 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 // addressGroup returns a response containing information about the account correlated with provided address
 func (ag *addressGroup) getAccount(c *gin.Context) {
 	addr := c.Param("address")
-	accountResponse, err := ag.getFacade().GetAccount(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountResponse, err := ag.getFacade().GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -186,7 +219,20 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ag.getFacade().GetBalance(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := ag.getFacade().GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -223,7 +269,20 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := ag.getFacade().GetUsername(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetUsername.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	userName, err := ag.getFacade().GetUsername(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -273,7 +332,20 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetValueForKey(addr, key)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := ag.getFacade().GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -311,7 +383,20 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetKeyValuePairs(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -654,6 +739,131 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 	)
 }
 
+// getDelegationPortfolio returns the positions of the provided address in all the delegation contracts
+func (ag *addressGroup) getDelegationPortfolio(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetDelegationPortfolio.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	portfolio, err := ag.getFacade().GetDelegationPortfolio(addr)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetDelegationPortfolio.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"delegation": portfolio},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// getTransactions returns a page of the transactions sent or received by the provided address, newest first
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	query, err := getAddressTransactionsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	response, err := ag.getFacade().GetTransactionsByAddress(addr, query)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"transactions": response.Transactions, "hasMore": response.HasMore, "cursor": response.Cursor},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func getAddressTransactionsQuery(c *gin.Context) (dblookupext.AddressTransactionsQuery, error) {
+	query := dblookupext.AddressTransactionsQuery{
+		Size: defaultTransactionsPageSize,
+	}
+
+	var err error
+	query.FromNonce, err = getUint64QueryParam(c, "fromNonce")
+	if err != nil {
+		return query, err
+	}
+	query.ToNonce, err = getUint64QueryParam(c, "toNonce")
+	if err != nil {
+		return query, err
+	}
+	query.FromTimestamp, err = getUint64QueryParam(c, "fromTimestamp")
+	if err != nil {
+		return query, err
+	}
+	query.ToTimestamp, err = getUint64QueryParam(c, "toTimestamp")
+	if err != nil {
+		return query, err
+	}
+	query.Cursor, err = getUint64QueryParam(c, "cursor")
+	if err != nil {
+		return query, err
+	}
+
+	size, err := getUint64QueryParam(c, "size")
+	if err != nil {
+		return query, err
+	}
+	if size > maxTransactionsPageSize {
+		return query, fmt.Errorf("%w: size should be at most %d", errors.ErrInvalidQueryParameter, maxTransactionsPageSize)
+	}
+	if size > 0 {
+		query.Size = uint32(size)
+	}
+
+	return query, nil
+}
+
+func getUint64QueryParam(c *gin.Context, name string) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
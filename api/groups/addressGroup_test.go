@@ -12,11 +12,14 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -182,7 +185,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -211,7 +214,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -236,7 +239,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -259,7 +262,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -290,7 +293,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -316,7 +319,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -342,7 +345,7 @@ func TestGetUsername_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -368,7 +371,7 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testUsername := "value"
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return testUsername, nil
 		},
 	}
@@ -393,7 +396,7 @@ func TestGetAccount_FailWhenFacadeStubGetAccountFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{}, errors.New(returnedError)
 		},
 	}
@@ -419,7 +422,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{
 				Address:         "1234",
 				Balance:         big.NewInt(100).String(),
@@ -455,6 +458,82 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, response.Error)
 }
 
+func TestGetAccount_WithAccountQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block nonce", testGetAccountWithQueryOptions("?blockNonce=37", common.AccountQueryOptions{
+		BlockNonce: common.OptionalUint64{Value: 37, HasValue: true},
+	}))
+	t.Run("block hash", testGetAccountWithQueryOptions("?blockHash=abba", common.AccountQueryOptions{
+		BlockHash: []byte{0xab, 0xba},
+	}))
+	t.Run("root hash", testGetAccountWithQueryOptions("?rootHash=aabb", common.AccountQueryOptions{
+		BlockRootHash: []byte{0xaa, 0xbb},
+	}))
+	t.Run("no options", testGetAccountWithQueryOptions("", common.AccountQueryOptions{}))
+}
+
+func testGetAccountWithQueryOptions(query string, expectedOptions common.AccountQueryOptions) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetAccountHandler: func(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+				assert.Equal(t, expectedOptions, options)
+				return api.AccountResponse{Balance: "10"}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/address/test"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+	}
+}
+
+func TestGetValueForKey_InvalidAccountQueryOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
+			assert.Fail(t, "should have not been called")
+			return "", nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	queries := []string{
+		"?blockNonce=abc",
+		"?blockHash=not-hex",
+		"?rootHash=not-hex",
+		"?blockNonce=1&rootHash=aabb",
+		"?blockHash=abba&rootHash=aabb",
+	}
+	for _, query := range queries {
+		req, _ := http.NewRequest("GET", "/address/test/key/aa"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
 func TestGetESDTBalance_NodeFailsShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -785,7 +864,7 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -814,7 +893,7 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return pairs, nil
 		},
 	}
@@ -913,6 +992,82 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetDelegationPortfolio_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetDelegationPortfolioCalled: func(_ string) (*common.DelegationPortfolio, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/delegation", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationPortfolio.Error()))
+}
+
+func TestGetDelegationPortfolio_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	type delegationPortfolioResponse struct {
+		Data struct {
+			Delegation *common.DelegationPortfolio `json:"delegation"`
+		} `json:"data"`
+	}
+
+	testAddress := "address"
+	portfolio := &common.DelegationPortfolio{
+		Address:               testAddress,
+		TotalActiveStake:      "100",
+		TotalUnStaked:         "20",
+		TotalClaimableRewards: "5",
+		Contracts: []*common.DelegationContractPosition{
+			{
+				DelegationScAddress: "delegationSc",
+				ActiveStake:         "100",
+				UnStakedFunds: []*common.DelegationUnStakedFund{
+					{Value: "20", RemainingUnBondEpochs: 3},
+				},
+				TotalUnStaked:    "20",
+				ClaimableRewards: "5",
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetDelegationPortfolioCalled: func(address string) (*common.DelegationPortfolio, error) {
+			assert.Equal(t, testAddress, address)
+			return portfolio, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/delegation", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegationPortfolioResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, portfolio, response.Data.Delegation)
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -960,6 +1115,124 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	assert.True(t, strings.Contains(response.Error, newErr.Error()))
 }
 
+type addressTransactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	HasMore      bool                                `json:"hasMore"`
+	Cursor       uint64                              `json:"cursor"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetTransactionsByAddress_InvalidQueryParameterShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, params := range []string{"fromNonce=a", "toTimestamp=-1", "size=101"} {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?%s", testAddress, params), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := addressTransactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestGetTransactionsByAddress_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsByAddress_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	var receivedQuery dblookupext.AddressTransactionsQuery
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+			assert.Equal(t, testAddress, address)
+			receivedQuery = query
+
+			return &common.AddressTransactionsResponse{
+				Transactions: []*transaction.ApiTransactionResult{{Hash: "aa"}, {Hash: "bb"}},
+				HasMore:      true,
+				Cursor:       7,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?fromNonce=2&toNonce=10&toTimestamp=500&size=2&cursor=9", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(response.Data.Transactions))
+	assert.Equal(t, "aa", response.Data.Transactions[0].Hash)
+	assert.True(t, response.Data.HasMore)
+	assert.Equal(t, uint64(7), response.Data.Cursor)
+
+	expectedQuery := dblookupext.AddressTransactionsQuery{
+		FromNonce:   2,
+		ToNonce:     10,
+		ToTimestamp: 500,
+		Size:        2,
+		Cursor:      9,
+	}
+	assert.Equal(t, expectedQuery, receivedQuery)
+
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions", testAddress), nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(25), receivedQuery.Size)
+}
+
 func getAddressRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -976,6 +1249,8 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/delegation", Open: true},
 				},
 			},
 		},
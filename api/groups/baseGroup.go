@@ -67,13 +67,7 @@ func extractSpecificMiddlewares(middlewares []shared.AdditionalMiddleware) ([]gi
 }
 
 func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.ApiRoutesConfig) endpointProperties {
-	basePath := ws.BasePath()
-
-	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
-	splitPath := strings.Split(basePath, "/")
-	basePath = splitPath[len(splitPath)-1]
-
-	group, ok := apiConfig.APIPackages[basePath]
+	group, ok := apiConfig.APIPackages[getAPIPackageName(ws)]
 	if !ok {
 		return endpointProperties{
 			isOpen: false,
@@ -92,3 +86,10 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 		isOpen: false,
 	}
 }
+
+func getAPIPackageName(ws *gin.RouterGroup) string {
+	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
+	splitPath := strings.Split(ws.BasePath(), "/")
+
+	return splitPath[len(splitPath)-1]
+}
@@ -0,0 +1,140 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	queryLogsEndpoint = "/logs/query"
+	queryLogsPath     = "/query"
+
+	defaultLogsMaxResults = 100
+	maxLogsMaxResults     = 1000
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for logs requests
+type logsFacadeHandler interface {
+	GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", errors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    queryLogsPath,
+			Method:  http.MethodPost,
+			Handler: lg.queryLogs,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(queryLogsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// queryLogs returns the smart contract events matching the filters provided in the request body
+func (lg *logsGroup) queryLogs(c *gin.Context) {
+	request := common.LogsQueryRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+	if request.MaxResults > maxLogsMaxResults {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: maxResults should be at most %d", errors.ErrValidation.Error(), maxLogsMaxResults),
+		)
+		return
+	}
+	if request.MaxResults == 0 {
+		request.MaxResults = defaultLogsMaxResults
+	}
+
+	response, err := lg.getFacade().GetLogs(request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrQueryLogs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"events":    response.Events,
+			"truncated": response.Truncated,
+			"nextNonce": response.NextNonce,
+			"nextIndex": response.NextIndex,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for logs group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
@@ -0,0 +1,161 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logsQueryResponseData struct {
+	Events    []*common.LogEventApiResponse `json:"events"`
+	Truncated bool                          `json:"truncated"`
+	NextNonce uint64                        `json:"nextNonce"`
+	NextIndex uint32                        `json:"nextIndex"`
+}
+
+type logsQueryResponse struct {
+	Data  logsQueryResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestQueryLogs_InvalidRequestShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(_ common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	for _, body := range []string{"invalid", `{"maxResults": 1001}`} {
+		req, _ := http.NewRequest("POST", "/logs/query", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := logsQueryResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestQueryLogs_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(_ common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/logs/query", bytes.NewBufferString(`{"fromNonce": 1, "toNonce": 2}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsQueryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrQueryLogs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestQueryLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedRequest common.LogsQueryRequest
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(request common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+			receivedRequest = request
+
+			return &common.LogsQueryResponse{
+				Events:    []*common.LogEventApiResponse{{Identifier: "transfer", Topics: [][]byte{[]byte("alice")}}},
+				Truncated: true,
+				NextNonce: 7,
+				NextIndex: 3,
+			}, nil
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	body := `{"fromNonce": 1, "fromIndex": 4, "toNonce": 20, "addresses": ["erd1"], "identifier": "transfer", "topics": [["YWxpY2U="], []]}`
+	req, _ := http.NewRequest("POST", "/logs/query", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsQueryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Data.Events))
+	assert.Equal(t, "transfer", response.Data.Events[0].Identifier)
+	assert.Equal(t, [][]byte{[]byte("alice")}, response.Data.Events[0].Topics)
+	assert.True(t, response.Data.Truncated)
+	assert.Equal(t, uint64(7), response.Data.NextNonce)
+	assert.Equal(t, uint32(3), response.Data.NextIndex)
+
+	expectedRequest := common.LogsQueryRequest{
+		FromNonce:  1,
+		FromIndex:  4,
+		ToNonce:    20,
+		Addresses:  []string{"erd1"},
+		Identifier: "transfer",
+		Topics:     [][][]byte{{[]byte("alice")}, {}},
+		MaxResults: 100,
+	}
+	assert.Equal(t, expectedRequest, receivedRequest)
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
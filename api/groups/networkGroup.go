@@ -27,6 +27,11 @@ const (
 	getESDTSupplyPath    = "/esdt/supply/:token"
 	directStakedInfoPath = "/direct-staked-info"
 	delegatedInfoPath    = "/delegated-info"
+	governanceProposals  = "/governance/proposals"
+	governanceProposal   = "/governance/proposal/:reference"
+	governanceVotes      = "/governance/votes/:address"
+
+	queryParamStatus = "status"
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -37,6 +42,9 @@ type networkFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotes(address string) ([]*common.GovernanceVote, error)
 	IsInterfaceNil() bool
 }
 
@@ -113,6 +121,21 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
 		},
+		{
+			Path:    governanceProposals,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposals,
+		},
+		{
+			Path:    governanceProposal,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposal,
+		},
+		{
+			Path:    governanceVotes,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceVotes,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -295,6 +318,98 @@ func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	)
 }
 
+// getGovernanceProposals is the endpoint that will return the governance proposals, optionally filtered by status
+func (ng *networkGroup) getGovernanceProposals(c *gin.Context) {
+	status := c.Request.URL.Query().Get(queryParamStatus)
+	proposals, err := ng.getFacade().GetGovernanceProposals(status)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposals": proposals},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceProposal is the endpoint that will return a governance proposal along with its tally
+func (ng *networkGroup) getGovernanceProposal(c *gin.Context) {
+	reference := c.Param("reference")
+	if reference == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyGovernanceReference.Error()),
+		)
+		return
+	}
+
+	proposal, err := ng.getFacade().GetGovernanceProposal(reference)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposal": proposal},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getGovernanceVotes is the endpoint that will return the governance votes cast by an address
+func (ng *networkGroup) getGovernanceVotes(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	votes, err := ng.getFacade().GetGovernanceVotes(address)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"votes": votes},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (ng *networkGroup) getFacade() networkFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
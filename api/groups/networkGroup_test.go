@@ -470,6 +470,178 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	}}, respSupply)
 }
 
+func TestGetGovernanceProposals_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	type proposalsResponse struct {
+		Data struct {
+			Proposals []*common.GovernanceProposal `json:"proposals"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}
+
+	providedStatus := ""
+	expectedProposals := []*common.GovernanceProposal{
+		{
+			Reference: "ref",
+			Status:    "active",
+			Tally:     &common.GovernanceTally{Yes: "3", No: "1", Veto: "0", Total: "4"},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetGovernanceProposalsHandler: func(status string) ([]*common.GovernanceProposal, error) {
+			providedStatus = status
+			return expectedProposals, nil
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/proposals?status=active", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "active", providedStatus)
+	assert.Equal(t, expectedProposals, response.Data.Proposals)
+}
+
+func TestGetGovernanceProposals_InternalError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetGovernanceProposalsHandler: func(status string) ([]*common.GovernanceProposal, error) {
+			return nil, expectedErr
+		},
+	}
+
+	networkGroup, err := groups.NewNetworkGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+}
+
+func TestGetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReference := ""
+		facade := mock.FacadeStub{
+			GetGovernanceProposalHandler: func(reference string) (*common.GovernanceProposal, error) {
+				providedReference = reference
+				return &common.GovernanceProposal{Reference: reference, Status: "closed"}, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposal/ref", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		respBytes, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "ref", providedReference)
+		assert.True(t, strings.Contains(string(respBytes), `"status":"closed"`))
+	})
+	t.Run("facade error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceProposalHandler: func(reference string) (*common.GovernanceProposal, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposal/ref", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		respBytes, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+	})
+}
+
+func TestGetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedAddress := ""
+		facade := mock.FacadeStub{
+			GetGovernanceVotesHandler: func(address string) ([]*common.GovernanceVote, error) {
+				providedAddress = address
+				return []*common.GovernanceVote{{Proposal: "ref", Value: "veto", WithFunds: true}}, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/votes/erd1address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		respBytes, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "erd1address", providedAddress)
+		assert.True(t, strings.Contains(string(respBytes), `"value":"veto"`))
+	})
+	t.Run("facade error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceVotesHandler: func(address string) ([]*common.GovernanceVote, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/votes/erd1address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		respBytes, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(string(respBytes), expectedErr.Error()))
+	})
+}
+
 func getNetworkRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -484,6 +656,9 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposal/:reference", Open: true},
+					{Name: "/governance/votes/:address", Open: true},
 				},
 			},
 		},
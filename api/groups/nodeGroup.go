@@ -1,6 +1,7 @@
 package groups
 
 import (
+	goErrors "errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -9,9 +10,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/openMetrics"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,9 +23,14 @@ const (
 	pidQueryParam       = "pid"
 	debugPath           = "/debug"
 	heartbeatStatusPath = "/heartbeatstatus"
+	healthLivePath      = "/health/live"
+	healthReadyPath     = "/health/ready"
 	metricsPath         = "/metrics"
+	openMetricsPath     = "/openmetrics"
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
+	profilesPath        = "/profiles"
+	profilePath         = "/profiles/:name"
 	statusPath          = "/status"
 
 	// AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -39,6 +48,10 @@ type nodeFacadeHandler interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetLivenessReport() common.HealthReport
+	GetReadinessReport() common.HealthReport
+	GetHealthProfiles() ([]common.HealthProfileInfo, error)
+	GetHealthProfile(name string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -86,6 +99,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
 		},
+		{
+			Path:    openMetricsPath,
+			Method:  http.MethodGet,
+			Handler: ng.openMetrics,
+		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
@@ -96,6 +114,26 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    healthLivePath,
+			Method:  http.MethodGet,
+			Handler: ng.healthLive,
+		},
+		{
+			Path:    healthReadyPath,
+			Method:  http.MethodGet,
+			Handler: ng.healthReady,
+		},
+		{
+			Path:    profilesPath,
+			Method:  http.MethodGet,
+			Handler: ng.healthProfiles,
+		},
+		{
+			Path:    profilePath,
+			Method:  http.MethodGet,
+			Handler: ng.healthProfile,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -236,6 +274,107 @@ func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	)
 }
 
+// openMetrics is the endpoint which will return all the metrics, including the p2p ones and the latency histograms,
+// in the OpenMetrics text format
+func (ng *nodeGroup) openMetrics(c *gin.Context) {
+	metrics := ng.getFacade().StatusMetrics().StatusMetricsOpenMetricsString()
+	c.Data(
+		http.StatusOK,
+		openMetrics.ContentType,
+		[]byte(metrics),
+	)
+}
+
+// healthLive returns the liveness report of the node. The status code is 503 if any of the components is not live
+func (ng *nodeGroup) healthLive(c *gin.Context) {
+	respondWithHealthReport(c, ng.getFacade().GetLivenessReport(), errors.ErrNodeNotLive)
+}
+
+// healthReady returns the readiness report of the node. The status code is 503 if any of the components is not ready
+func (ng *nodeGroup) healthReady(c *gin.Context) {
+	respondWithHealthReport(c, ng.getFacade().GetReadinessReport(), errors.ErrNodeNotReady)
+}
+
+func respondWithHealthReport(c *gin.Context, report common.HealthReport, errDown error) {
+	if report.Status != common.HealthStatusUp {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"health": report},
+				Error: errDown.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"health": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// healthProfiles returns the list of the profiles captured by the health service
+func (ng *nodeGroup) healthProfiles(c *gin.Context) {
+	profiles, err := ng.getFacade().GetHealthProfiles()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"profiles": profiles},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// healthProfile returns the content of a profile captured by the health service, as a file to be downloaded
+func (ng *nodeGroup) healthProfile(c *gin.Context) {
+	name := c.Param("name")
+	content, err := ng.getFacade().GetHealthProfile(name)
+	if err != nil {
+		status, code := http.StatusInternalServerError, shared.ReturnCodeInternalError
+		switch {
+		case goErrors.Is(err, health.ErrInvalidProfileName):
+			status, code = http.StatusBadRequest, shared.ReturnCodeRequestError
+		case goErrors.Is(err, health.ErrProfileNotFound):
+			status, code = http.StatusNotFound, shared.ReturnCodeRequestError
+		}
+
+		c.JSON(
+			status,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  code,
+			},
+		)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	c.Data(
+		http.StatusOK,
+		"application/octet-stream",
+		content,
+	)
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -19,11 +19,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/openMetrics"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -369,6 +372,201 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestOpenMetrics_ShouldWork(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	statusMetricsProvider.SetUInt64Value(common.MetricNonce, 37)
+	statusMetricsProvider.SetUInt64Value(common.MetricP2PNumReceiverPeers+"_fast_reacting", 5)
+
+	facade := mock.FacadeStub{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusMetricsProvider
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/openmetrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, openMetrics.ContentType, resp.Header().Get("Content-Type"))
+	assert.Contains(t, respStr, "# TYPE erd_nonce gauge\nerd_nonce{shard=\"0\"} 37\n")
+	assert.Contains(t, respStr, "erd_p2p_num_receiver_peers{shard=\"0\",quota=\"fast_reacting\"} 5\n")
+	assert.True(t, strings.HasSuffix(respStr, "# EOF\n"))
+}
+
+type healthResponse struct {
+	Data struct {
+		Health common.HealthReport `json:"health"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestHealthLive_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	report := common.HealthReport{
+		Status: common.HealthStatusUp,
+		Components: []common.ComponentHealthStatus{
+			{Name: "storage", Status: common.HealthStatusUp},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetLivenessReportCalled: func() common.HealthReport {
+			return report
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &healthResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, report, response.Data.Health)
+}
+
+func TestHealthReady_NotReadyShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	report := common.HealthReport{
+		Status: common.HealthStatusDown,
+		Components: []common.ComponentHealthStatus{
+			{Name: "sync", Status: common.HealthStatusDown, Reason: "node is not synchronized"},
+			{Name: "p2p", Status: common.HealthStatusUp},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetReadinessReportCalled: func() common.HealthReport {
+			return report
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &healthResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, apiErrors.ErrNodeNotReady.Error(), response.Error)
+	assert.Equal(t, report, response.Data.Health)
+}
+
+func TestHealthProfiles_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	profiles := []common.HealthProfileInfo{
+		{Name: "profile__20210101000000__memory__heap.pprof", SizeInBytes: 10, Timestamp: 1609459200},
+	}
+	facade := mock.FacadeStub{
+		GetHealthProfilesCalled: func() ([]common.HealthProfileInfo, error) {
+			return profiles, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/profiles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &struct {
+		Data struct {
+			Profiles []common.HealthProfileInfo `json:"profiles"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, profiles, response.Data.Profiles)
+}
+
+func TestHealthProfile_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	profileName := "profile__20210101000000__memory__heap.pprof"
+	facade := mock.FacadeStub{
+		GetHealthProfileCalled: func(name string) ([]byte, error) {
+			assert.Equal(t, profileName, name)
+			return []byte("profile content"), nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/profiles/"+profileName, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "profile content", resp.Body.String())
+	assert.Contains(t, resp.Header().Get("Content-Disposition"), profileName)
+}
+
+func TestHealthProfile_ErrorsShouldSetStatusCode(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		err            error
+		expectedStatus int
+	}{
+		{err: fmt.Errorf("%w: name", health.ErrInvalidProfileName), expectedStatus: http.StatusBadRequest},
+		{err: fmt.Errorf("%w: name", health.ErrProfileNotFound), expectedStatus: http.StatusNotFound},
+		{err: errors.New("read error"), expectedStatus: http.StatusInternalServerError},
+	}
+	for _, td := range testData {
+		expectedErr := td.err
+		facade := mock.FacadeStub{
+			GetHealthProfileCalled: func(name string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/profiles/name.pprof", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, td.expectedStatus, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	}
+}
+
 func loadResponseAsString(rsp io.Reader, response *statusResponse) {
 	buff, err := ioutil.ReadAll(rsp)
 	if err != nil {
@@ -386,10 +584,15 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/status", Open: true},
 					{Name: "/metrics", Open: true},
+					{Name: "/openmetrics", Open: true},
 					{Name: "/heartbeatstatus", Open: true},
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/health/live", Open: true},
+					{Name: "/health/ready", Open: true},
+					{Name: "/profiles", Open: true},
+					{Name: "/profiles/:name", Open: true},
 				},
 			},
 		},
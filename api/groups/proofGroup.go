@@ -20,10 +20,18 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/root-hash/:roothash/multi"
+	getRangeProofEndpoint           = "/proof/root-hash/:roothash/range"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/root-hash/:roothash/multi"
+	getRangeProofPath               = "/root-hash/:roothash/range"
+
+	maxAddressesInMultiProof = 100
+	defaultRangeProofLeaves  = 100
+	maxRangeProofLeaves      = 1000
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +40,8 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +108,28 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getRangeProofPath,
+			Method:  http.MethodGet,
+			Handler: pg.getRangeProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getRangeProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +143,17 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the parameters needed to compute a Merkle proof for multiple addresses
+type MultiProofRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+// RangeProofLeaf represents an account returned along with a range proof
+type RangeProofLeaf struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -353,6 +396,170 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	)
 }
 
+// getMultiProof will receive a rootHash and a list of addresses from the client, and it will return a single
+// Merkle proof covering all the addresses
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	var multiProofParams = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&multiProofParams)
+	if err == nil {
+		err = checkMultiProofAddresses(multiProofParams.Addresses)
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(rootHash, multiProofParams.Addresses)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	values := make(map[string]string, len(multiProofParams.Addresses))
+	for i, address := range multiProofParams.Addresses {
+		values[address] = hex.EncodeToString(response.Values[i])
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proof":    bytesToHex(response.Proof),
+				"values":   values,
+				"rootHash": response.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func checkMultiProofAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return errors.ErrValidationEmptyAddresses
+	}
+	if len(addresses) > maxAddressesInMultiProof {
+		return fmt.Errorf("%w: maximum %d", errors.ErrValidationTooManyAddresses, maxAddressesInMultiProof)
+	}
+	for _, address := range addresses {
+		if address == "" {
+			return errors.ErrValidationEmptyAddress
+		}
+	}
+
+	return nil
+}
+
+// getRangeProof will receive a rootHash and an optional addresses range from the client, and it will return the
+// accounts found in the range along with the Merkle proof for the whole range
+func (pg *proofGroup) getRangeProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	maxLeaves, err := getRangeProofMaxLeaves(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	startAddress := c.Request.URL.Query().Get("start")
+	endAddress := c.Request.URL.Query().Get("end")
+	response, err := pg.getFacade().GetRangeProof(rootHash, startAddress, endAddress, maxLeaves)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	leaves := make([]RangeProofLeaf, 0, len(response.Keys))
+	for i := range response.Keys {
+		leaves = append(leaves, RangeProofLeaf{
+			Key:   hex.EncodeToString(response.Keys[i]),
+			Value: hex.EncodeToString(response.Values[i]),
+		})
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proof":    bytesToHex(response.Proof),
+				"leaves":   leaves,
+				"endKey":   hex.EncodeToString(response.EndKey),
+				"hasMore":  response.HasMore,
+				"rootHash": response.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getRangeProofMaxLeaves(c *gin.Context) (int, error) {
+	maxLeaves, err := getUint64QueryParam(c, "maxLeaves")
+	if err != nil {
+		return 0, err
+	}
+	if maxLeaves == 0 {
+		return defaultRangeProofLeaves, nil
+	}
+	if maxLeaves > maxRangeProofLeaves {
+		return 0, fmt.Errorf("%w: maximum %d", errors.ErrValidationTooManyLeaves, maxRangeProofLeaves)
+	}
+
+	return int(maxLeaves), nil
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
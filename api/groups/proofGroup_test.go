@@ -379,6 +379,237 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tooManyAddresses := make([]string, 101)
+	for i := range tooManyAddresses {
+		tooManyAddresses[i] = fmt.Sprintf("addr%d", i)
+	}
+
+	tooManyAddressesBytes, _ := json.Marshal(groups.MultiProofRequest{Addresses: tooManyAddresses})
+
+	testCases := []struct {
+		name          string
+		body          []byte
+		expectedError error
+	}{
+		{name: "invalid body", body: []byte("invalid bytes"), expectedError: apiErrors.ErrValidation},
+		{name: "no address", body: []byte(`{"addresses":[]}`), expectedError: apiErrors.ErrValidationEmptyAddresses},
+		{name: "empty address", body: []byte(`{"addresses":["addr", ""]}`), expectedError: apiErrors.ErrValidationEmptyAddress},
+		{name: "too many addresses", body: tooManyAddressesBytes, expectedError: apiErrors.ErrValidationTooManyAddresses},
+	}
+
+	for _, tc := range testCases {
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/root-hash/rootHash/multi", bytes.NewBuffer(tc.body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, tc.name)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code, tc.name)
+		assert.True(t, strings.Contains(response.Error, tc.expectedError.Error()), tc.name)
+	}
+}
+
+func TestGetMultiProof_GetMultiProofError(t *testing.T) {
+	t.Parallel()
+
+	getProofErr := fmt.Errorf("GetMultiProof error")
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+			return nil, getProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/proof/root-hash/rootHash/multi", bytes.NewBuffer([]byte(`{"addresses":["addr"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, getProofErr.Error()))
+}
+
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	proof := [][]byte{[]byte("valid"), []byte("proof")}
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+			assert.Equal(t, "rootHash", rootHash)
+			assert.Equal(t, []string{"addr1", "addr2"}, addresses)
+			return &common.GetMultiProofResponse{
+				Proof:    proof,
+				Values:   [][]byte{[]byte("value1"), nil},
+				RootHash: rootHash,
+			}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.MultiProofRequest{Addresses: []string{"addr1", "addr2"}})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/rootHash/multi", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{hex.EncodeToString(proof[0]), hex.EncodeToString(proof[1])}, responseMap["proof"])
+	assert.Equal(t, map[string]interface{}{"addr1": hex.EncodeToString([]byte("value1")), "addr2": ""}, responseMap["values"])
+	assert.Equal(t, "rootHash", responseMap["rootHash"])
+}
+
+func TestGetRangeProof_InvalidMaxLeavesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	for _, maxLeaves := range []string{"not a number", "1001"} {
+		req, _ := http.NewRequest("GET", "/proof/root-hash/rootHash/range?maxLeaves="+maxLeaves, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestGetRangeProof_GetRangeProofError(t *testing.T) {
+	t.Parallel()
+
+	getProofErr := fmt.Errorf("GetRangeProof error")
+	facade := &mock.FacadeStub{
+		GetRangeProofCalled: func(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+			return nil, getProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/rootHash/range", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+}
+
+func TestGetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default max leaves", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofCalled: func(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+				assert.Equal(t, "", startAddress)
+				assert.Equal(t, "", endAddress)
+				assert.Equal(t, 100, maxLeaves)
+				return &common.GetRangeProofResponse{RootHash: rootHash}, nil
+			},
+		}
+
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/root-hash/rootHash/range", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofCalled: func(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+				assert.Equal(t, "rootHash", rootHash)
+				assert.Equal(t, "start", startAddress)
+				assert.Equal(t, "end", endAddress)
+				assert.Equal(t, 2, maxLeaves)
+				return &common.GetRangeProofResponse{
+					TrieRangeProof: common.TrieRangeProof{
+						Proof:   [][]byte{[]byte("proof")},
+						Keys:    [][]byte{[]byte("key1"), []byte("key2")},
+						Values:  [][]byte{[]byte("value1"), []byte("value2")},
+						EndKey:  []byte("key2"),
+						HasMore: true,
+					},
+					RootHash: rootHash,
+				}, nil
+			},
+		}
+
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/root-hash/rootHash/range?start=start&end=end&maxLeaves=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("proof"))}, responseMap["proof"])
+		assert.Equal(t, hex.EncodeToString([]byte("key2")), responseMap["endKey"])
+		assert.Equal(t, true, responseMap["hasMore"])
+		leaves, ok := responseMap["leaves"].([]interface{})
+		require.True(t, ok)
+		require.Equal(t, 2, len(leaves))
+		assert.Equal(t, map[string]interface{}{
+			"key":   hex.EncodeToString([]byte("key1")),
+			"value": hex.EncodeToString([]byte("value1")),
+		}, leaves[0])
+	})
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -388,6 +619,8 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/root-hash/:roothash/multi", Open: true},
+					{Name: "/root-hash/:roothash/range", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,271 @@
+package groups
+
+import (
+	goErrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	sseSubscriptionPath       = "/sse"
+	webSocketSubscriptionPath = "/ws"
+
+	topicsQueryParam  = "topics"
+	txHashQueryParam  = "txHash"
+	addressQueryParam = "address"
+
+	subscriptionErrorEvent = "error"
+)
+
+// subscriptionsFacadeHandler defines the methods to be implemented by a facade for subscriptions requests
+type subscriptionsFacadeHandler interface {
+	Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error)
+	IsInterfaceNil() bool
+}
+
+type subscriptionsGroup struct {
+	*baseGroup
+	facade    subscriptionsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewSubscriptionsGroup returns a new instance of subscriptionsGroup
+func NewSubscriptionsGroup(facade subscriptionsFacadeHandler) (*subscriptionsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscriptions group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscriptionsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: common.NewWebSocketOriginChecker(nil),
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    sseSubscriptionPath,
+			Method:  http.MethodGet,
+			Handler: sg.subscribeSSE,
+		},
+		{
+			Path:    webSocketSubscriptionPath,
+			Method:  http.MethodGet,
+			Handler: sg.subscribeWebSocket,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// RegisterRoutes registers the endpoints, the web socket connections being accepted from the same origin and from
+// the allowed origins of the subscriptions API package
+func (sg *subscriptionsGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	packageConfig := apiConfig.APIPackages[getAPIPackageName(ws)]
+	sg.upgrader.CheckOrigin = common.NewWebSocketOriginChecker(packageConfig.AllowedOrigins)
+
+	sg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// subscribeSSE streams the events matching the query parameters as server-sent events, until the client
+// disconnects or the subscription is closed by the node
+func (sg *subscriptionsGroup) subscribeSSE(c *gin.Context) {
+	subscription, ok := sg.subscribe(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	// the headers are flushed right away so the client knows the subscription succeeded before the first event
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	c.Stream(func(_ io.Writer) bool {
+		select {
+		case event, isOpen := <-subscription.Events():
+			if !isOpen {
+				c.SSEvent(subscriptionErrorEvent, getSubscriptionCloseReason(subscription))
+				return false
+			}
+
+			c.SSEvent(event.Topic, event)
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// subscribeWebSocket upgrades the connection and writes the events matching the query parameters as JSON messages,
+// until the client disconnects or the subscription is closed by the node
+func (sg *subscriptionsGroup) subscribeWebSocket(c *gin.Context) {
+	if !sg.upgrader.CheckOrigin(c.Request) {
+		shared.RespondWith(
+			c,
+			http.StatusForbidden,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), errors.ErrOriginNotAllowed.Error()),
+			shared.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	subscription, ok := sg.subscribe(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscriptions group: cannot upgrade connection", "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// the client is not expected to send anything, the reads only detect the closed connections
+	clientGone := make(chan struct{})
+	go func() {
+		defer close(clientGone)
+		for {
+			_, _, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case event, isOpen := <-subscription.Events():
+			if !isOpen {
+				closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, getSubscriptionCloseReason(subscription))
+				_ = conn.WriteMessage(websocket.CloseMessage, closeMessage)
+				return
+			}
+
+			err = conn.WriteJSON(event)
+			if err != nil {
+				log.Debug("subscriptions group: cannot write event", "error", err.Error())
+				return
+			}
+		case <-clientGone:
+			return
+		}
+	}
+}
+
+func (sg *subscriptionsGroup) subscribe(c *gin.Context) (*subscriptions.Subscription, bool) {
+	request, err := getSubscriptionRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return nil, false
+	}
+
+	subscription, err := sg.getFacade().Subscribe(request)
+	if err != nil {
+		status, code := http.StatusInternalServerError, shared.ReturnCodeInternalError
+		if goErrors.Is(err, subscriptions.ErrTooManySubscribers) {
+			status, code = http.StatusTooManyRequests, shared.ReturnCodeSystemBusy
+		}
+
+		shared.RespondWith(
+			c,
+			status,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), err.Error()),
+			code,
+		)
+		return nil, false
+	}
+
+	return subscription, true
+}
+
+func getSubscriptionRequest(c *gin.Context) (common.SubscriptionRequest, error) {
+	request := common.SubscriptionRequest{
+		TxHashes:  c.QueryArray(txHashQueryParam),
+		Addresses: c.QueryArray(addressQueryParam),
+	}
+
+	topics := c.Query(topicsQueryParam)
+	if len(topics) > 0 {
+		for _, topic := range strings.Split(topics, ",") {
+			switch topic {
+			case subscriptions.TopicBlocks:
+				request.Blocks = true
+			case subscriptions.TopicFinalizedBlocks:
+				request.FinalizedBlocks = true
+			default:
+				return common.SubscriptionRequest{}, fmt.Errorf("%w, unknown topic %s", errors.ErrInvalidQueryParameter, topic)
+			}
+		}
+	}
+
+	isEmpty := !request.Blocks && !request.FinalizedBlocks && len(request.TxHashes) == 0 && len(request.Addresses) == 0
+	if isEmpty {
+		return common.SubscriptionRequest{}, fmt.Errorf("%w: at least one of %s, %s or %s should be provided",
+			errors.ErrInvalidQueryParameter, topicsQueryParam, txHashQueryParam, addressQueryParam)
+	}
+
+	return request, nil
+}
+
+func getSubscriptionCloseReason(subscription *subscriptions.Subscription) string {
+	err := subscription.Err()
+	if err == nil {
+		return "subscription closed"
+	}
+
+	return err.Error()
+}
+
+func (sg *subscriptionsGroup) getFacade() subscriptionsFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscriptionsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscriptionsFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for subscriptions group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscriptionsGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,289 @@
+package groups_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const subscriptionsReadTimeout = time.Second * 5
+
+type subscriptionsFacade interface {
+	Subscribe(filter subscriptions.Filter) (*subscriptions.Subscription, error)
+	SaveBlock(args *indexer.ArgsSaveBlockData) error
+	Close() error
+}
+
+// startSubscriptionsServer starts a server backed by a subscriptions hub, returning the hub once the client subscribed
+func startSubscriptionsServer(t *testing.T) (*httptest.Server, <-chan subscriptionsFacade) {
+	hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		PubKeyConverter:      testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:       1,
+		SubscriberBufferSize: 10,
+	})
+	require.NoError(t, err)
+
+	subscribed := make(chan subscriptionsFacade, 1)
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+			subscription, errSubscribe := hub.Subscribe(subscriptions.Filter{Blocks: request.Blocks})
+			subscribed <- hub
+
+			return subscription, errSubscribe
+		},
+	}
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig()))
+	t.Cleanup(func() {
+		_ = hub.Close()
+		server.Close()
+	})
+
+	return server, subscribed
+}
+
+func waitSubscribed(t *testing.T, subscribed <-chan subscriptionsFacade) subscriptionsFacade {
+	select {
+	case hub := <-subscribed:
+		return hub
+	case <-time.After(subscriptionsReadTimeout):
+		require.Fail(t, "timeout waiting for the subscription")
+		return nil
+	}
+}
+
+func createSaveBlockArgs(nonce uint64) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+		Header:     &block.Header{Nonce: nonce},
+		Body:       &block.Body{},
+	}
+}
+
+func TestNewSubscriptionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscribe_InvalidQueryShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(_ common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig())
+
+	for _, path := range []string{"/subscriptions/sse", "/subscriptions/sse?topics=blocks,unknown", "/subscriptions/ws"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestSubscribe_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		err            error
+		expectedStatus int
+	}{
+		{err: errors.New("expected error"), expectedStatus: http.StatusInternalServerError},
+		{err: fmt.Errorf("%w, maximum: 1", subscriptions.ErrTooManySubscribers), expectedStatus: http.StatusTooManyRequests},
+	}
+
+	for _, tc := range testCases {
+		expectedErr := tc.err
+		facade := &mock.FacadeStub{
+			SubscribeCalled: func(_ common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+				return nil, expectedErr
+			},
+		}
+
+		subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(subscriptionsGroup, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/sse?txHash=aa&address=bb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, tc.expectedStatus, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSubscribe.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+}
+
+func TestSubscribe_SSEShouldStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	server, subscribed := startSubscriptionsServer(t)
+
+	resp, err := http.Get(server.URL + "/subscriptions/sse?topics=blocks")
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	hub := waitSubscribed(t, subscribed)
+	require.NoError(t, hub.SaveBlock(createSaveBlockArgs(7)))
+	require.NoError(t, hub.Close())
+
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if len(scanner.Text()) > 0 {
+			lines = append(lines, scanner.Text())
+		}
+	}
+
+	require.Equal(t, 4, len(lines))
+	assert.Equal(t, "event:"+subscriptions.TopicBlocks, lines[0])
+	event := subscriptions.Event{}
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data:")), &event))
+	assert.Equal(t, uint64(7), event.Nonce)
+	assert.Equal(t, "event:error", lines[2])
+	assert.Equal(t, "data:"+subscriptions.ErrHubClosed.Error(), lines[3])
+}
+
+func TestSubscribe_WebSocketShouldSendEvents(t *testing.T) {
+	t.Parallel()
+
+	server, subscribed := startSubscriptionsServer(t)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws?topics=blocks"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	hub := waitSubscribed(t, subscribed)
+	require.NoError(t, hub.SaveBlock(createSaveBlockArgs(7)))
+
+	_ = conn.SetReadDeadline(time.Now().Add(subscriptionsReadTimeout))
+	event := subscriptions.Event{}
+	require.NoError(t, conn.ReadJSON(&event))
+	assert.Equal(t, subscriptions.TopicBlocks, event.Topic)
+	assert.Equal(t, uint64(7), event.Nonce)
+
+	require.NoError(t, hub.Close())
+	_, _, err = conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok)
+	assert.Equal(t, subscriptions.ErrHubClosed.Error(), closeErr.Text)
+}
+
+func TestSubscribe_WebSocketShouldCheckTheOrigin(t *testing.T) {
+	t.Parallel()
+
+	allowedOrigin := "https://explorer.example"
+	routesConfig := getSubscriptionsRoutesConfig()
+	packageConfig := routesConfig.APIPackages["subscriptions"]
+	packageConfig.AllowedOrigins = []string{allowedOrigin}
+	routesConfig.APIPackages["subscriptions"] = packageConfig
+
+	facade := &mock.FacadeStub{
+		SubscribeCalled: func(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+			return nil, subscriptions.ErrTooManySubscribers
+		},
+	}
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startWebServer(subscriptionsGroup, "subscriptions", routesConfig))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws?topics=blocks"
+
+	t.Run("cross origin not allowed", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Origin", "https://attacker.example")
+		conn, resp, errDial := websocket.DefaultDialer.Dial(url, header)
+		require.Equal(t, websocket.ErrBadHandshake, errDial)
+		require.Nil(t, conn)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		response := shared.GenericAPIResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrOriginNotAllowed.Error()))
+	})
+	t.Run("allowed cross origin", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Origin", allowedOrigin)
+		conn, resp, errDial := websocket.DefaultDialer.Dial(url, header)
+		require.Equal(t, websocket.ErrBadHandshake, errDial)
+		require.Nil(t, conn)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		// the origin check passed and the request reached the facade
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	})
+}
+
+func getSubscriptionsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/sse", Open: true},
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -3,9 +3,11 @@ package groups
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -14,23 +16,38 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	sendTransactionEndpoint          = "/transaction/send"
-	simulateTransactionEndpoint      = "/transaction/simulate"
-	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
-	getTransactionEndpoint           = "/transaction/:hash"
-	sendTransactionPath              = "/send"
-	simulateTransactionPath          = "/simulate"
-	costPath                         = "/cost"
-	sendMultiplePath                 = "/send-multiple"
-	getTransactionPath               = "/:txhash"
+	sendTransactionEndpoint           = "/transaction/send"
+	simulateTransactionEndpoint       = "/transaction/simulate"
+	sendMultipleTransactionsEndpoint  = "/transaction/send-multiple"
+	getTransactionEndpoint            = "/transaction/:hash"
+	getTransactionsPoolEndpoint       = "/transaction/pool"
+	getTransactionsPoolCountsEndpoint = "/transaction/pool/counts"
+	sendTransactionPath               = "/send"
+	simulateTransactionPath           = "/simulate"
+	costPath                          = "/cost"
+	sendMultiplePath                  = "/send-multiple"
+	getTransactionPath                = "/:txhash"
+	transactionsPoolPath              = "/pool"
+	transactionsPoolCountsPath        = "/pool/counts"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamWithTrace      = "withTrace"
+	queryParamSender         = "sender"
+	queryParamFields         = "fields"
+	queryParamOffset         = "offset"
+	queryParamLimit          = "limit"
+
+	allTxPoolFields = "*"
+
+	defaultTxPoolSendersLimit = 100
+	maxTxPoolSendersLimit     = 1000
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -40,8 +57,10 @@ type transactionFacadeHandler interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error)
+	GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -104,6 +123,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    transactionsPoolPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPool,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionsPoolEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    transactionsPoolCountsPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolCounts,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionsPoolCountsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
@@ -192,6 +233,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	tx, txHash, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
 		gtx.Value,
@@ -232,7 +286,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, withTrace)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -465,6 +519,114 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
+// getTransactionsPool returns the pending transactions of the self shard senders, grouped by sender. The sender query
+// parameter restricts the response to a single sender, otherwise the offset and limit query parameters select the page
+// of senders. The fields query parameter selects the comma separated transaction fields to be returned, "*" meaning all
+// of them
+func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
+	request, err := getTransactionsPoolRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	pool, err := tg.getFacade().GetTransactionsPool(request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": pool}, "", shared.ReturnCodeSuccess)
+}
+
+// getTransactionsPoolCounts returns the number of transactions held by the pools
+func (tg *transactionGroup) getTransactionsPoolCounts(c *gin.Context) {
+	counts, err := tg.getFacade().GetTransactionsPoolCounts()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"counts": counts}, "", shared.ReturnCodeSuccess)
+}
+
+func getTransactionsPoolRequest(c *gin.Context) (common.TransactionsPoolRequest, error) {
+	request := common.TransactionsPoolRequest{
+		Sender: c.Query(queryParamSender),
+		Limit:  defaultTxPoolSendersLimit,
+	}
+
+	var err error
+	request.Fields, err = getTxPoolFields(c.Query(queryParamFields))
+	if err != nil {
+		return request, err
+	}
+
+	offset, err := getUint64QueryParam(c, queryParamOffset)
+	if err != nil {
+		return request, err
+	}
+	if offset > math.MaxUint32 {
+		return request, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamOffset)
+	}
+	request.Offset = uint32(offset)
+
+	limit, err := getUint64QueryParam(c, queryParamLimit)
+	if err != nil {
+		return request, err
+	}
+	if limit > maxTxPoolSendersLimit {
+		return request, fmt.Errorf("%w: limit should be at most %d", errors.ErrInvalidQueryParameter, maxTxPoolSendersLimit)
+	}
+	if limit > 0 {
+		request.Limit = uint32(limit)
+	}
+
+	return request, nil
+}
+
+func getTxPoolFields(fieldsParam string) ([]string, error) {
+	if len(fieldsParam) == 0 {
+		return []string{common.TxPoolFieldHash, common.TxPoolFieldNonce}, nil
+	}
+	if fieldsParam == allTxPoolFields {
+		return common.TxPoolFields, nil
+	}
+
+	fields := strings.Split(fieldsParam, ",")
+	for _, field := range fields {
+		if !isTxPoolField(field) {
+			return nil, fmt.Errorf("%w, unknown field %s", errors.ErrInvalidQueryParameter, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func isTxPoolField(field string) bool {
+	for _, txPoolField := range common.TxPoolFields {
+		if field == txPoolField {
+			return true
+		}
+	}
+
+	return false
+}
+
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	var gtx SendTxRequest
@@ -540,6 +702,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamWithTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
@@ -543,7 +544,7 @@ func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -598,7 +599,7 @@ func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -691,7 +692,7 @@ func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
 		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
 			return &dataTx.Transaction{}, []byte("hash"), nil
 		},
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			return &txSimData.SimulationResults{}, nil
 		},
 	}
@@ -729,7 +730,7 @@ func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			return nil, expectedErr
 		},
 		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
@@ -775,7 +776,7 @@ func TestSimulateTransaction(t *testing.T) {
 	processTxWasCalled := false
 
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -823,6 +824,276 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_InvalidWithTraceParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:   "sender1",
+		Receiver: "receiver1",
+		Value:    "100",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=tttt", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(simulateResponse.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestSimulateTransaction_WithTraceShouldReturnTrace(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+			assert.True(t, withTrace)
+			return &txSimData.SimulationResults{
+				Status: "success",
+				Trace: &txSimData.ExecutionTrace{
+					GasUsed: 37,
+					Steps: []*txSimData.TraceStep{
+						{
+							Type:     "scCall",
+							Function: "doSomething",
+						},
+					},
+				},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:   "sender1",
+		Receiver: "receiver1",
+		Value:    "100",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=true", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := struct {
+		Data struct {
+			Result txSimData.SimulationResults `json:"result"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, simulateResponse.Data.Result.Trace)
+	require.Len(t, simulateResponse.Data.Result.Trace.Steps, 1)
+	assert.Equal(t, "doSomething", simulateResponse.Data.Result.Trace.Steps[0].Function)
+	assert.Equal(t, uint64(37), simulateResponse.Data.Result.Trace.GasUsed)
+}
+
+type txPoolResponseData struct {
+	TxPool common.TransactionsPoolResponse `json:"txPool"`
+}
+
+type txPoolResponse struct {
+	Data  txPoolResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetTransactionsPool_InvalidFieldsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolCalled: func(_ common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	for _, path := range []string{"/transaction/pool?fields=hash,signature", "/transaction/pool?offset=-1", "/transaction/pool?limit=1001"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestGetTransactionsPool_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolCalled: func(_ common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := &common.TransactionsPoolResponse{
+		Senders: []*common.TransactionsPoolSender{
+			{
+				Sender:          "erd1alice",
+				Score:           50,
+				NumTransactions: 1,
+				NonceGaps:       []*common.NonceGap{{FromNonce: 1, ToNonce: 2}},
+				Transactions:    []map[string]interface{}{{"hash": "aa", "gasLimit": float64(50000)}},
+			},
+		},
+		NumSenders: 1,
+	}
+
+	receivedRequests := make([]common.TransactionsPoolRequest, 0)
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolCalled: func(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+			receivedRequests = append(receivedRequests, request)
+			return expectedPool, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	paths := []string{
+		"/transaction/pool",
+		"/transaction/pool?sender=erd1alice&fields=hash,gasLimit",
+		"/transaction/pool?fields=*&offset=200&limit=50",
+	}
+	for _, path := range paths {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedPool, response.Data.TxPool)
+	}
+
+	expectedRequests := []common.TransactionsPoolRequest{
+		{Fields: []string{common.TxPoolFieldHash, common.TxPoolFieldNonce}, Limit: 100},
+		{Sender: "erd1alice", Fields: []string{common.TxPoolFieldHash, common.TxPoolFieldGasLimit}, Limit: 100},
+		{Fields: common.TxPoolFields, Offset: 200, Limit: 50},
+	}
+	assert.Equal(t, expectedRequests, receivedRequests)
+}
+
+func TestGetTransactionsPoolCounts(t *testing.T) {
+	t.Parallel()
+
+	expectedCounts := &common.TransactionsPoolCountsResponse{
+		NumTransactions:     10,
+		NumBytes:            1000,
+		NumSelfShardSenders: 2,
+		NumSelfShardTxs:     7,
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolCountsCalled: func() (*common.TransactionsPoolCountsResponse, error) {
+			return expectedCounts, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/counts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Counts common.TransactionsPoolCountsResponse `json:"counts"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedCounts, response.Data.Counts)
+}
+
+func TestGetTransactionsPoolCounts_ErrorWithExceededNumGoRoutines(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(_ string) (core.Throttler, bool) {
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool { return false },
+			}, true
+		},
+		GetTransactionsPoolCountsCalled: func() (*common.TransactionsPoolCountsResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/counts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyRequests.Error()))
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -834,6 +1105,8 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/pool", Open: true},
+					{Name: "/pool/counts", Open: true},
 				},
 			},
 		},
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
@@ -21,11 +22,13 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+	batchPath  = "/query/batch"
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueries([]*process.SCQuery) (*common.SCQueriesBatchResponse, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -68,13 +71,19 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
 		},
+		{
+			Path:    batchPath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeQueriesBatch,
+		},
 	}
 	vvg.endpoints = endpoints
 
 	return vvg, nil
 }
 
-// VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// VMValueRequest represents the structure on which user input for generating a new transaction will validate against.
+// The optional block nonce, block hash or block root hash select the state the query is executed against
 type VMValueRequest struct {
 	ScAddress      string   `json:"scAddress"`
 	FuncName       string   `json:"funcName"`
@@ -83,6 +92,14 @@ type VMValueRequest struct {
 	Args           []string `json:"args"`
 	SameScState    bool     `json:"sameScState"`
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	BlockNonce     *uint64  `json:"blockNonce,omitempty"`
+	BlockHash      string   `json:"blockHash,omitempty"`
+	BlockRootHash  string   `json:"blockRootHash,omitempty"`
+}
+
+// VMValuesBatchRequest represents the structure of a request holding multiple queries to be executed against the same state
+type VMValuesBatchRequest struct {
+	Queries []VMValueRequest `json:"queries"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -127,6 +144,42 @@ func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
 	vvg.returnOkResponse(context, vmOutput, execErrMsg)
 }
 
+// executeQueriesBatch executes all the provided queries against the same state and returns their results in the
+// order of the queries
+func (vvg *vmValuesGroup) executeQueriesBatch(context *gin.Context) {
+	request := VMValuesBatchRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueriesBatch", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	queries := make([]*process.SCQuery, 0, len(request.Queries))
+	for i := range request.Queries {
+		query, errCreate := vvg.createSCQuery(&request.Queries[i])
+		if errCreate != nil {
+			vvg.returnBadRequest(context, "executeQueriesBatch", fmt.Errorf("query %d: %w", i, errCreate))
+			return
+		}
+
+		queries = append(queries, query)
+	}
+
+	response, err := vvg.getFacade().ExecuteSCQueries(queries)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeQueriesBatch", err)
+		return
+	}
+
+	for _, result := range response.Results {
+		if len(result.Error) == 0 && result.Data != nil {
+			result.Error = getVmExecErrMsg(result.Data)
+		}
+	}
+
+	vvg.returnOkResponse(context, response, "")
+}
+
 func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
@@ -144,12 +197,15 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", err
 	}
 
-	vmExecErrMsg := ""
+	return vmOutputApi, getVmExecErrMsg(vmOutputApi), nil
+}
+
+func getVmExecErrMsg(vmOutputApi *vm.VMOutputApi) string {
 	if len(vmOutputApi.ReturnCode) > 0 && vmOutputApi.ReturnCode != vmcommon.Ok.String() {
-		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
+		return vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
 	}
 
-	return vmOutputApi, vmExecErrMsg, nil
+	return ""
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
@@ -194,9 +250,48 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	err = setQueryBlock(scQuery, request)
+	if err != nil {
+		return nil, err
+	}
+
 	return scQuery, nil
 }
 
+func setQueryBlock(scQuery *process.SCQuery, request *VMValueRequest) error {
+	numBlockOptions := 0
+	for _, isSet := range []bool{request.BlockNonce != nil, len(request.BlockHash) > 0, len(request.BlockRootHash) > 0} {
+		if isSet {
+			numBlockOptions++
+		}
+	}
+	if numBlockOptions > 1 {
+		return fmt.Errorf("only one of blockNonce, blockHash or blockRootHash can be provided")
+	}
+
+	if request.BlockNonce != nil {
+		scQuery.BlockNonce = common.OptionalUint64{Value: *request.BlockNonce, HasValue: true}
+	}
+
+	if len(request.BlockHash) > 0 {
+		blockHash, err := hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+		scQuery.BlockHash = blockHash
+	}
+
+	if len(request.BlockRootHash) > 0 {
+		blockRootHash, err := hex.DecodeString(request.BlockRootHash)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid block root hash: %s", request.BlockRootHash, err.Error())
+		}
+		scQuery.BlockRootHash = blockRootHash
+	}
+
+	return nil
+}
+
 func (vvg *vmValuesGroup) returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	context.JSON(
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -48,6 +49,11 @@ type vmOutputResponse struct {
 	Error string             `json:"error"`
 }
 
+type queriesBatchResponse struct {
+	Data  *common.SCQueriesBatchResponse `json:"data"`
+	Error string                         `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -163,6 +169,250 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_AtHistoricalBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+				require.Equal(t, common.OptionalUint64{Value: blockNonce, HasValue: true}, query.BlockNonce)
+				require.Empty(t, query.BlockHash)
+
+				return &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}}, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress:  dummyScAddress,
+			FuncName:   "function",
+			BlockNonce: &blockNonce,
+		}
+
+		response := vmOutputResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+	})
+	t.Run("block hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockHash := []byte("block hash")
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+				require.False(t, query.BlockNonce.HasValue)
+				require.Equal(t, blockHash, query.BlockHash)
+
+				return &vm.VMOutputApi{}, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			BlockHash: hex.EncodeToString(blockHash),
+		}
+
+		response := vmOutputResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+	})
+	t.Run("invalid block hash should err", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			BlockHash: "not hex",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "'not hex' is not a valid block hash")
+	})
+	t.Run("both block nonce and hash should err", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		request := groups.VMValueRequest{
+			ScAddress:  dummyScAddress,
+			FuncName:   "function",
+			BlockNonce: &blockNonce,
+			BlockHash:  "aabb",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "only one of blockNonce, blockHash or blockRootHash can be provided")
+	})
+	t.Run("block root hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockRootHash := []byte("block root hash")
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, error) {
+				require.False(t, query.BlockNonce.HasValue)
+				require.Empty(t, query.BlockHash)
+				require.Equal(t, blockRootHash, query.BlockRootHash)
+
+				return &vm.VMOutputApi{}, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress:     dummyScAddress,
+			FuncName:      "function",
+			BlockRootHash: hex.EncodeToString(blockRootHash),
+		}
+
+		response := vmOutputResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+	})
+	t.Run("invalid block root hash should err", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValueRequest{
+			ScAddress:     dummyScAddress,
+			FuncName:      "function",
+			BlockRootHash: "not hex",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "'not hex' is not a valid block root hash")
+	})
+	t.Run("both block hash and root hash should err", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValueRequest{
+			ScAddress:     dummyScAddress,
+			FuncName:      "function",
+			BlockHash:     "aabb",
+			BlockRootHash: "ccdd",
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "only one of blockNonce, blockHash or blockRootHash can be provided")
+	})
+}
+
+func TestQueriesBatch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+			require.Equal(t, 3, len(queries))
+			require.Equal(t, "first", queries[0].FuncName)
+			require.Equal(t, "second", queries[1].FuncName)
+			require.Equal(t, "third", queries[2].FuncName)
+
+			return &common.SCQueriesBatchResponse{
+				RootHash: "aabb",
+				Results: []*common.SCQueryBatchResult{
+					{Data: &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}, ReturnCode: vmcommon.Ok.String()}},
+					{Data: &vm.VMOutputApi{ReturnCode: vmcommon.UserError.String(), ReturnMessage: "user error"}},
+					{Error: "execution error"},
+				},
+			}, nil
+		},
+	}
+
+	request := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueRequest{
+			{ScAddress: dummyScAddress, FuncName: "first"},
+			{ScAddress: dummyScAddress, FuncName: "second"},
+			{ScAddress: dummyScAddress, FuncName: "third"},
+		},
+	}
+
+	response := queriesBatchResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query/batch", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, "aabb", response.Data.RootHash)
+	require.Equal(t, 3, len(response.Data.Results))
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.Results[0].Data.ReturnData[0]).Int64())
+	require.Empty(t, response.Data.Results[0].Error)
+	require.Equal(t, vmcommon.UserError.String()+":user error", response.Data.Results[1].Error)
+	require.Equal(t, "execution error", response.Data.Results[2].Error)
+}
+
+func TestQueriesBatch_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+			require.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	request := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueRequest{
+			{ScAddress: dummyScAddress, FuncName: "first"},
+			{ScAddress: dummyScAddress, FuncName: "second", Args: []string{"bad arg"}},
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query/batch", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, "query 1: 'bad arg' is not a valid hex string")
+}
+
+func TestQueriesBatch_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	facade := mock.FacadeStub{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+			return nil, errExpected
+		},
+	}
+
+	request := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueRequest{
+			{ScAddress: dummyScAddress, FuncName: "function"},
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query/batch", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestQueriesBatch_BadJsonShouldErr(t *testing.T) {
+	t.Parallel()
+
+	response := simpleResponse{}
+	statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query/batch", []byte("dummy"), &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -344,6 +594,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/query/batch", Open: true},
 				},
 			},
 		},
@@ -7,3 +7,6 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrNilRouteDurationObserver signals that a nil route duration observer has been provided
+var ErrNilRouteDurationObserver = errors.New("nil route duration observer")
@@ -0,0 +1,9 @@
+package middleware
+
+import "time"
+
+// RouteDurationObserver defines the component able to record the duration of the requests served on each API route
+type RouteDurationObserver interface {
+	ObserveApiRouteDuration(route string, duration time.Duration)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute is used for the requests that do not match any registered route, so that random paths do not create
+// new histograms
+const unmatchedRoute = "unmatched"
+
+// routeMetricsMiddleware is a middleware which records the duration of the requests, grouped by the matched route
+type routeMetricsMiddleware struct {
+	observer RouteDurationObserver
+}
+
+// NewRouteMetricsMiddleware creates a new instance of a routeMetricsMiddleware
+func NewRouteMetricsMiddleware(observer RouteDurationObserver) (*routeMetricsMiddleware, error) {
+	if check.IfNil(observer) {
+		return nil, ErrNilRouteDurationObserver
+	}
+
+	return &routeMetricsMiddleware{
+		observer: observer,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (rmm *routeMetricsMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		// the route template is used instead of the request path so the parameters do not end up in the metrics labels
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unmatchedRoute
+		}
+
+		rmm.observer.ObserveApiRouteDuration(route, time.Since(start))
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rmm *routeMetricsMiddleware) IsInterfaceNil() bool {
+	return rmm == nil
+}
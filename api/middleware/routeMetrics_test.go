@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type routeDurationObserverStub struct {
+	ObserveApiRouteDurationCalled func(route string, duration time.Duration)
+}
+
+func (stub *routeDurationObserverStub) ObserveApiRouteDuration(route string, duration time.Duration) {
+	if stub.ObserveApiRouteDurationCalled != nil {
+		stub.ObserveApiRouteDurationCalled(route, duration)
+	}
+}
+
+func (stub *routeDurationObserverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewRouteMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rmm, err := NewRouteMetricsMiddleware(nil)
+	assert.True(t, check.IfNil(rmm))
+	assert.Equal(t, ErrNilRouteDurationObserver, err)
+
+	rmm, err = NewRouteMetricsMiddleware(&routeDurationObserverStub{})
+	assert.False(t, check.IfNil(rmm))
+	assert.Nil(t, err)
+}
+
+func TestRouteMetricsMiddleware_ShouldObserveRouteTemplate(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	observedRoutes := make([]string, 0)
+	observer := &routeDurationObserverStub{
+		ObserveApiRouteDurationCalled: func(route string, duration time.Duration) {
+			mut.Lock()
+			observedRoutes = append(observedRoutes, route)
+			mut.Unlock()
+		},
+	}
+	rmm, _ := NewRouteMetricsMiddleware(observer)
+
+	ws := gin.New()
+	ws.Use(rmm.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/balance", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	for _, path := range []string{"/address/erd1/balance", "/address/erd2/balance", "/unknown"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, []string{"/address/:address/balance", "/address/:address/balance", unmatchedRoute}, observedRoutes)
+}
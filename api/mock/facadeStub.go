@@ -10,9 +10,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -23,8 +25,8 @@ type FacadeStub struct {
 	ShouldErrorStart           bool
 	ShouldErrorStop            bool
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (api.AccountResponse, error)
+	BalanceHandler             func(string, common.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -33,21 +35,31 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueriesHandler                 func(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
-	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	GetUsernameCalled                       func(address string, options common.AccountQueryOptions) (string, error)
+	GetKeyValuePairsCalled                  func(address string, options common.AccountQueryOptions) (map[string]string, error)
+	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
+	GetLivenessReportCalled                 func() common.HealthReport
+	GetReadinessReportCalled                func() common.HealthReport
+	GetHealthProfilesCalled                 func() ([]common.HealthProfileInfo, error)
+	GetHealthProfileCalled                  func(name string) ([]byte, error)
 	GetESDTDataCalled                       func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                  func(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetTransactionsByAddressCalled          func(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error)
+	GetLogsCalled                           func(request common.LogsQueryRequest) (*common.LogsQueryResponse, error)
+	SubscribeCalled                         func(request common.SubscriptionRequest) (*subscriptions.Subscription, error)
+	GetTransactionsPoolCalled               func(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error)
+	GetTransactionsPoolCountsCalled         func() (*common.TransactionsPoolCountsResponse, error)
 	GetESDTsWithRoleCalled                  func(address string, role string) ([]string, error)
 	GetESDTsRolesCalled                     func(address string) (map[string][]string, error)
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
@@ -58,10 +70,16 @@ type FacadeStub struct {
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetGovernanceProposalsHandler           func(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalHandler            func(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotesHandler               func(address string) ([]*common.GovernanceVote, error)
+	GetDelegationPortfolioCalled            func(address string) (*common.DelegationPortfolio, error)
 	GetProofCalled                          func(string, string) (*common.GetProofResponse, error)
 	GetProofCurrentRootHashCalled           func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                     func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetRangeProofCalled                     func(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error)
 	GetTokenSupplyCalled                    func(token string) (*api.ESDTSupply, error)
 }
 
@@ -110,10 +128,28 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetRangeProof -
+func (f *FacadeStub) GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	if f.GetRangeProofCalled != nil {
+		return f.GetRangeProofCalled(rootHash, startAddress, endAddress, maxLeaves)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
-func (f *FacadeStub) GetUsername(address string) (string, error) {
+func (f *FacadeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if f.GetUsernameCalled != nil {
-		return f.GetUsernameCalled(address)
+		return f.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
@@ -149,23 +185,23 @@ func (f *FacadeStub) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *FacadeStub) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *FacadeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *FacadeStub) GetValueForKey(address string, key string) (string, error) {
+func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
@@ -198,6 +234,33 @@ func (f *FacadeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigita
 	return make(map[string]*esdt.ESDigitalToken), nil
 }
 
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetLogs -
+func (f *FacadeStub) GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+	if f.GetLogsCalled != nil {
+		return f.GetLogsCalled(request)
+	}
+
+	return nil, nil
+}
+
+// Subscribe -
+func (f *FacadeStub) Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+	if f.SubscribeCalled != nil {
+		return f.SubscribeCalled(request)
+	}
+
+	return nil, nil
+}
+
 // GetNFTTokenIDsRegisteredByAddress -
 func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
@@ -226,8 +289,8 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return f.GetAccountHandler(address)
+func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -254,9 +317,27 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 	return f.GetTransactionHandler(hash, withResults)
 }
 
+// GetTransactionsPool -
+func (f *FacadeStub) GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+	if f.GetTransactionsPoolCalled != nil {
+		return f.GetTransactionsPoolCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolCounts -
+func (f *FacadeStub) GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error) {
+	if f.GetTransactionsPoolCountsCalled != nil {
+		return f.GetTransactionsPoolCountsCalled()
+	}
+
+	return nil, nil
+}
+
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return f.SimulateTransactionExecutionHandler(tx)
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx, withTrace)
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -284,6 +365,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, er
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries -
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -304,6 +394,26 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return f.GetDelegatorsListHandler()
 }
 
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	return f.GetGovernanceProposalsHandler(status)
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	return f.GetGovernanceProposalHandler(reference)
+}
+
+// GetGovernanceVotes -
+func (f *FacadeStub) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	return f.GetGovernanceVotesHandler(address)
+}
+
+// GetDelegationPortfolio -
+func (f *FacadeStub) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	return f.GetDelegationPortfolioCalled(address)
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -352,6 +462,42 @@ func (f *FacadeStub) GetNumCheckpointsFromPeerState() uint32 {
 	return 0
 }
 
+// GetLivenessReport -
+func (f *FacadeStub) GetLivenessReport() common.HealthReport {
+	if f.GetLivenessReportCalled != nil {
+		return f.GetLivenessReportCalled()
+	}
+
+	return common.HealthReport{}
+}
+
+// GetReadinessReport -
+func (f *FacadeStub) GetReadinessReport() common.HealthReport {
+	if f.GetReadinessReportCalled != nil {
+		return f.GetReadinessReportCalled()
+	}
+
+	return common.HealthReport{}
+}
+
+// GetHealthProfiles -
+func (f *FacadeStub) GetHealthProfiles() ([]common.HealthProfileInfo, error) {
+	if f.GetHealthProfilesCalled != nil {
+		return f.GetHealthProfilesCalled()
+	}
+
+	return nil, nil
+}
+
+// GetHealthProfile -
+func (f *FacadeStub) GetHealthProfile(name string) ([]byte, error) {
+	if f.GetHealthProfileCalled != nil {
+		return f.GetHealthProfileCalled(name)
+	}
+
+	return nil, nil
+}
+
 // GetBlockByNonce -
 func (f *FacadeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByNonceCalled(nonce, withTxs)
@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForDBMigrator
+    generateForTrieInspector
+    generateForStateExporter
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForDBMigrator() {
+    HELP="
+# Elrond DB migration Tool CLI
+
+The **Elrond DB migration Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
+generateForTrieInspector() {
+    HELP="
+# Elrond Trie inspector Tool CLI
+
+The **Elrond Trie inspector Tool** exposes the following Command Line Interface:
+$(code)
+\$ trieinspector --help
+
+$(./trieinspector/trieinspector --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trieinspector/CLI.md
+}
+
+generateForStateExporter() {
+    HELP="
+# Elrond State exporter Tool CLI
+
+The **Elrond State exporter Tool** exposes the following Command Line Interface:
+$(code)
+\$ stateexporter --help
+
+$(./stateexporter/stateexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./stateexporter/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbmigrator/migration"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	sourcePath        string
+	destinationPath   string
+	sourceType        string
+	destinationType   string
+	maxOpenFiles      int
+	maxBatchSize      int
+	batchDelaySeconds int
+	logLevel          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// sourcePath defines a flag for the path of the databases to be migrated
+	sourcePath = cli.StringFlag{
+		Name: "source-path",
+		Usage: "The path of the node's databases directory, the one containing the Epoch_X and Static " +
+			"directories. Example: ./db/1",
+		Destination: &argsConfig.sourcePath,
+	}
+	// destinationPath defines a flag for the path where the migrated databases will be written
+	destinationPath = cli.StringFlag{
+		Name:        "destination-path",
+		Usage:       "The path where the migrated databases will be written. It should not exist or be empty.",
+		Destination: &argsConfig.destinationPath,
+	}
+	// sourceType defines a flag for the persister type of the source databases
+	sourceType = cli.StringFlag{
+		Name: "source-type",
+		Usage: fmt.Sprintf("The persister type of the source databases. Available options: %s, %s, %s",
+			storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BoltDB),
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &argsConfig.sourceType,
+	}
+	// destinationType defines a flag for the persister type of the migrated databases
+	destinationType = cli.StringFlag{
+		Name: "destination-type",
+		Usage: fmt.Sprintf("The persister type of the migrated databases. Available options: %s, %s, %s",
+			storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BoltDB),
+		Value:       string(storageUnit.BoltDB),
+		Destination: &argsConfig.destinationType,
+	}
+	// maxOpenFiles defines a flag for the maximum number of files a LevelDB persister can keep open
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files a LevelDB persister can keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// maxBatchSize defines a flag for the number of writes grouped in a single batch
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The number of writes grouped in a single batch on the destination persisters",
+		Value:       10000,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// batchDelaySeconds defines a flag for the maximum delay before a batch is committed
+	batchDelaySeconds = cli.IntFlag{
+		Name:        "batch-delay-seconds",
+		Usage:       "The maximum delay in seconds before a partially filled batch is committed",
+		Value:       2,
+		Destination: &argsConfig.batchDelaySeconds,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond DB migration Tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "This binary will copy all the storers of a stopped node into a different persister type, " +
+		"verifying the key counts and the checksums"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		sourcePath,
+		destinationPath,
+		sourceType,
+		destinationType,
+		maxOpenFiles,
+		maxBatchSize,
+		batchDelaySeconds,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	args := migration.ArgsDBMigrator{
+		SourcePath:          argsConfig.sourcePath,
+		DestinationPath:     argsConfig.destinationPath,
+		SourceDBConfig:      createDBConfig(argsConfig.sourceType),
+		DestinationDBConfig: createDBConfig(argsConfig.destinationType),
+		Hasher:              blake2b.NewBlake2b(),
+	}
+	migrator, err := migration.NewDBMigrator(args)
+	if err != nil {
+		return err
+	}
+
+	results, err := migrator.Migrate()
+	if err != nil {
+		return err
+	}
+
+	totalKeys := uint64(0)
+	totalBytes := uint64(0)
+	for _, result := range results {
+		totalKeys += result.NumKeys
+		totalBytes += result.NumBytes
+	}
+
+	log.Info("migration finished",
+		"num storers", len(results),
+		"num keys", totalKeys,
+		"num bytes", totalBytes,
+		"destination", argsConfig.destinationPath,
+		"destination type", argsConfig.destinationType,
+	)
+
+	return nil
+}
+
+func createDBConfig(dbType string) config.DBConfig {
+	return config.DBConfig{
+		Type:              dbType,
+		BatchDelaySeconds: argsConfig.batchDelaySeconds,
+		MaxBatchSize:      argsConfig.maxBatchSize,
+		MaxOpenFiles:      argsConfig.maxOpenFiles,
+	}
+}
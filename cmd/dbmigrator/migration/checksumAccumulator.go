@@ -0,0 +1,53 @@
+package migration
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+)
+
+// checksumAccumulator computes an iteration-order independent checksum over a set of (key, value) pairs
+// by XOR-ing the hashes of all the entries. The keys are unique inside a persister so no entry can
+// cancel another one
+type checksumAccumulator struct {
+	hasher   hashing.Hasher
+	checksum []byte
+	numKeys  uint64
+	numBytes uint64
+}
+
+func newChecksumAccumulator(hasher hashing.Hasher) *checksumAccumulator {
+	return &checksumAccumulator{
+		hasher:   hasher,
+		checksum: make([]byte, hasher.Size()),
+	}
+}
+
+func (ca *checksumAccumulator) add(key []byte, val []byte) {
+	keyLength := make([]byte, 8)
+	binary.BigEndian.PutUint64(keyLength, uint64(len(key)))
+
+	entry := make([]byte, 0, len(keyLength)+len(key)+len(val))
+	entry = append(entry, keyLength...)
+	entry = append(entry, key...)
+	entry = append(entry, val...)
+
+	entryHash := ca.hasher.Compute(string(entry))
+	for i := 0; i < len(ca.checksum) && i < len(entryHash); i++ {
+		ca.checksum[i] ^= entryHash[i]
+	}
+
+	ca.numKeys++
+	ca.numBytes += uint64(len(key) + len(val))
+}
+
+func (ca *checksumAccumulator) result() MigrationResult {
+	checksum := make([]byte, len(ca.checksum))
+	copy(checksum, ca.checksum)
+
+	return MigrationResult{
+		NumKeys:  ca.numKeys,
+		NumBytes: ca.numBytes,
+		Checksum: checksum,
+	}
+}
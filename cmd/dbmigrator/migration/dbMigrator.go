@@ -0,0 +1,230 @@
+package migration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+var log = logger.GetOrCreate("dbmigrator/migration")
+
+// ArgsDBMigrator is the DTO used to create a new instance of the DB migrator
+type ArgsDBMigrator struct {
+	SourcePath          string
+	DestinationPath     string
+	SourceDBConfig      config.DBConfig
+	DestinationDBConfig config.DBConfig
+	Hasher              hashing.Hasher
+}
+
+// MigrationResult holds the outcome of the migration of one storer directory
+type MigrationResult struct {
+	Directory StorerDirectory
+	NumKeys   uint64
+	NumBytes  uint64
+	Checksum  []byte
+}
+
+type persisterCreator interface {
+	Create(path string) (storage.Persister, error)
+}
+
+type dbMigrator struct {
+	sourcePath                  string
+	destinationPath             string
+	sourceDBType                storageUnit.DBType
+	sourcePersisterCreator      persisterCreator
+	destinationPersisterCreator persisterCreator
+	hasher                      hashing.Hasher
+}
+
+// NewDBMigrator creates a new instance able to copy all the storers from the source path into the
+// destination path, changing the persister type
+func NewDBMigrator(args ArgsDBMigrator) (*dbMigrator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbMigrator{
+		sourcePath:                  args.SourcePath,
+		destinationPath:             args.DestinationPath,
+		sourceDBType:                storageUnit.DBType(args.SourceDBConfig.Type),
+		sourcePersisterCreator:      factory.NewPersisterFactory(args.SourceDBConfig),
+		destinationPersisterCreator: factory.NewPersisterFactory(args.DestinationDBConfig),
+		hasher:                      args.Hasher,
+	}, nil
+}
+
+func checkArgs(args ArgsDBMigrator) error {
+	if len(args.SourcePath) == 0 {
+		return ErrEmptySourcePath
+	}
+	if len(args.DestinationPath) == 0 {
+		return ErrEmptyDestinationPath
+	}
+	if filepath.Clean(args.SourcePath) == filepath.Clean(args.DestinationPath) {
+		return ErrSameSourceAndDestination
+	}
+	if args.SourceDBConfig.Type == args.DestinationDBConfig.Type {
+		return fmt.Errorf("%w: %s", ErrSameDBType, args.SourceDBConfig.Type)
+	}
+	_, err := getMarkerFile(storageUnit.DBType(args.SourceDBConfig.Type))
+	if err != nil {
+		return err
+	}
+	_, err = getMarkerFile(storageUnit.DBType(args.DestinationDBConfig.Type))
+	if err != nil {
+		return err
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return checkDestinationIsEmpty(args.DestinationPath)
+}
+
+func checkDestinationIsEmpty(destinationPath string) error {
+	entries, err := ioutil.ReadDir(destinationPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, destinationPath)
+	}
+
+	return nil
+}
+
+// Migrate copies every storer found in the source path into the destination path, verifying the key
+// count and the checksum of each of them. It stops at the first error
+func (dm *dbMigrator) Migrate() ([]MigrationResult, error) {
+	storerDirectories, err := FindStorerDirectories(dm.sourcePath, dm.sourceDBType)
+	if err != nil {
+		return nil, err
+	}
+	if len(storerDirectories) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoStorerDirectoryFound, dm.sourcePath)
+	}
+
+	results := make([]MigrationResult, 0, len(storerDirectories))
+	for index, directory := range storerDirectories {
+		log.Info("migrating storer",
+			"directory", directory.RelativePath,
+			"progress", fmt.Sprintf("%d/%d", index+1, len(storerDirectories)),
+		)
+
+		result, errMigrate := dm.migrateDirectory(directory)
+		if errMigrate != nil {
+			return results, fmt.Errorf("%w while migrating %s", errMigrate, directory.RelativePath)
+		}
+
+		log.Info("storer migrated",
+			"directory", directory.RelativePath,
+			"num keys", result.NumKeys,
+			"num bytes", result.NumBytes,
+			"checksum", result.Checksum,
+		)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (dm *dbMigrator) migrateDirectory(directory StorerDirectory) (MigrationResult, error) {
+	source, err := dm.sourcePersisterCreator.Create(filepath.Join(dm.sourcePath, directory.RelativePath))
+	if err != nil {
+		return MigrationResult{}, err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	destinationPath := filepath.Join(dm.destinationPath, directory.RelativePath)
+	sourceResult, err := dm.copyPersister(source, destinationPath)
+	if err != nil {
+		return MigrationResult{}, err
+	}
+	sourceResult.Directory = directory
+
+	destinationResult, err := dm.computeDestinationResult(destinationPath)
+	if err != nil {
+		return MigrationResult{}, err
+	}
+
+	if sourceResult.NumKeys != destinationResult.NumKeys {
+		return MigrationResult{}, fmt.Errorf("%w: source %d, destination %d",
+			ErrKeyCountMismatch, sourceResult.NumKeys, destinationResult.NumKeys)
+	}
+	if string(sourceResult.Checksum) != string(destinationResult.Checksum) {
+		return MigrationResult{}, fmt.Errorf("%w: source %x, destination %x",
+			ErrChecksumMismatch, sourceResult.Checksum, destinationResult.Checksum)
+	}
+
+	return sourceResult, nil
+}
+
+func (dm *dbMigrator) copyPersister(source storage.Persister, destinationPath string) (MigrationResult, error) {
+	destination, err := dm.destinationPersisterCreator.Create(destinationPath)
+	if err != nil {
+		return MigrationResult{}, err
+	}
+
+	accumulator := newChecksumAccumulator(dm.hasher)
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		err = destination.Put(key, val)
+		if err != nil {
+			return false
+		}
+
+		accumulator.add(key, val)
+		return true
+	})
+	if err != nil {
+		_ = destination.Close()
+		return MigrationResult{}, err
+	}
+
+	// closing the destination will flush any pending batch
+	err = destination.Close()
+	if err != nil {
+		return MigrationResult{}, err
+	}
+
+	return accumulator.result(), nil
+}
+
+func (dm *dbMigrator) computeDestinationResult(destinationPath string) (MigrationResult, error) {
+	destination, err := dm.destinationPersisterCreator.Create(destinationPath)
+	if err != nil {
+		return MigrationResult{}, err
+	}
+	defer func() {
+		_ = destination.Close()
+	}()
+
+	accumulator := newChecksumAccumulator(dm.hasher)
+	destination.RangeKeys(func(key []byte, val []byte) bool {
+		accumulator.add(key, val)
+		return true
+	})
+
+	return accumulator.result(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *dbMigrator) IsInterfaceNil() bool {
+	return dm == nil
+}
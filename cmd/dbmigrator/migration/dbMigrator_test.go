@@ -0,0 +1,203 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDBConfig(dbType storageUnit.DBType) config.DBConfig {
+	return config.DBConfig{
+		Type:              string(dbType),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createMockArgsDBMigrator(t *testing.T) ArgsDBMigrator {
+	return ArgsDBMigrator{
+		SourcePath:          t.TempDir(),
+		DestinationPath:     filepath.Join(t.TempDir(), "migrated"),
+		SourceDBConfig:      createDBConfig(storageUnit.LvlDBSerial),
+		DestinationDBConfig: createDBConfig(storageUnit.BoltDB),
+		Hasher:              blake2b.NewBlake2b(),
+	}
+}
+
+func populateStorer(t *testing.T, path string, dbType storageUnit.DBType, numKeys int) {
+	persister, err := factory.NewPersisterFactory(createDBConfig(dbType)).Create(path)
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, persister.Close())
+}
+
+func TestNewDBMigrator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.SourcePath = ""
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.Equal(t, ErrEmptySourcePath, err)
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.DestinationPath = ""
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.Equal(t, ErrEmptyDestinationPath, err)
+	})
+	t.Run("same paths should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.DestinationPath = args.SourcePath + string(filepath.Separator)
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+	})
+	t.Run("same DB types should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.DestinationDBConfig = args.SourceDBConfig
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.True(t, errors.Is(err, ErrSameDBType))
+	})
+	t.Run("memory source DB type should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.SourceDBConfig = createDBConfig(storageUnit.MemoryDB)
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.True(t, errors.Is(err, ErrUnknownDBLayout))
+	})
+	t.Run("memory destination DB type should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.DestinationDBConfig = createDBConfig(storageUnit.MemoryDB)
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.True(t, errors.Is(err, ErrUnknownDBLayout))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.Hasher = nil
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("destination not empty should error", func(t *testing.T) {
+		args := createMockArgsDBMigrator(t)
+		args.DestinationPath = t.TempDir()
+		require.Nil(t, ioutil.WriteFile(filepath.Join(args.DestinationPath, "file"), []byte("data"), 0600))
+
+		dm, err := NewDBMigrator(args)
+		assert.True(t, check.IfNil(dm))
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("should work", func(t *testing.T) {
+		dm, err := NewDBMigrator(createMockArgsDBMigrator(t))
+		assert.False(t, check.IfNil(dm))
+		assert.Nil(t, err)
+	})
+}
+
+func TestDbMigrator_MigrateNoStorerShouldError(t *testing.T) {
+	t.Parallel()
+
+	dm, _ := NewDBMigrator(createMockArgsDBMigrator(t))
+	results, err := dm.Migrate()
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, ErrNoStorerDirectoryFound))
+}
+
+func TestDbMigrator_MigrateShouldCopyAllStorers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDBMigrator(t)
+	storers := map[string]int{
+		filepath.Join("Epoch_0", "Shard_0", "Transactions"):                25,
+		filepath.Join("Epoch_1", "Shard_0", "Transactions"):                3,
+		filepath.Join("Epoch_1", "Shard_0", "AccountsTrie", "MainDB"):      7,
+		filepath.Join("Static", "Shard_0", "DbLookupExtensions_RoundHash"): 0,
+	}
+	for relativePath, numKeys := range storers {
+		populateStorer(t, filepath.Join(args.SourcePath, relativePath), storageUnit.LvlDBSerial, numKeys)
+	}
+	require.Nil(t, os.MkdirAll(filepath.Join(args.SourcePath, "unknown", "Shard_0", "Transactions"), 0700))
+
+	dm, _ := NewDBMigrator(args)
+	results, err := dm.Migrate()
+	require.Nil(t, err)
+	require.Equal(t, len(storers), len(results))
+
+	for _, result := range results {
+		expectedNumKeys, found := storers[result.Directory.RelativePath]
+		require.True(t, found)
+		assert.Equal(t, uint64(expectedNumKeys), result.NumKeys)
+		assert.Equal(t, "0", result.Directory.ShardID)
+
+		migrated, errCreate := factory.NewPersisterFactory(args.DestinationDBConfig).Create(
+			filepath.Join(args.DestinationPath, result.Directory.RelativePath))
+		require.Nil(t, errCreate)
+		for i := 0; i < expectedNumKeys; i++ {
+			val, errGet := migrated.Get([]byte(fmt.Sprintf("key%d", i)))
+			assert.Nil(t, errGet)
+			assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+		}
+		_ = migrated.Close()
+	}
+}
+
+func TestDbMigrator_MigrateBackAndForthShouldKeepTheChecksum(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDBMigrator(t)
+	relativePath := filepath.Join("Epoch_3", "Shard_metachain", "MetaBlock")
+	populateStorer(t, filepath.Join(args.SourcePath, relativePath), storageUnit.LvlDBSerial, 50)
+
+	dm, _ := NewDBMigrator(args)
+	firstResults, err := dm.Migrate()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(firstResults))
+	assert.Equal(t, uint32(3), firstResults[0].Directory.Epoch)
+	assert.Equal(t, "metachain", firstResults[0].Directory.ShardID)
+
+	backArgs := ArgsDBMigrator{
+		SourcePath:          args.DestinationPath,
+		DestinationPath:     filepath.Join(t.TempDir(), "back"),
+		SourceDBConfig:      args.DestinationDBConfig,
+		DestinationDBConfig: createDBConfig(storageUnit.LvlDB),
+		Hasher:              args.Hasher,
+	}
+	dm, _ = NewDBMigrator(backArgs)
+	secondResults, err := dm.Migrate()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(secondResults))
+
+	assert.Equal(t, firstResults[0].NumKeys, secondResults[0].NumKeys)
+	assert.Equal(t, firstResults[0].NumBytes, secondResults[0].NumBytes)
+	assert.Equal(t, firstResults[0].Checksum, secondResults[0].Checksum)
+}
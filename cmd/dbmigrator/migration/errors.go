@@ -0,0 +1,33 @@
+package migration
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptySourcePath signals that an empty source path has been provided
+var ErrEmptySourcePath = errors.New("empty source path")
+
+// ErrEmptyDestinationPath signals that an empty destination path has been provided
+var ErrEmptyDestinationPath = errors.New("empty destination path")
+
+// ErrSameSourceAndDestination signals that the source and the destination paths point to the same location
+var ErrSameSourceAndDestination = errors.New("source and destination paths are the same")
+
+// ErrDestinationNotEmpty signals that the destination directory already contains data
+var ErrDestinationNotEmpty = errors.New("destination directory is not empty")
+
+// ErrSameDBType signals that the source and the destination persister types are the same
+var ErrSameDBType = errors.New("source and destination DB types are the same")
+
+// ErrUnknownDBLayout signals that the persister type does not have a known on-disk layout
+var ErrUnknownDBLayout = errors.New("unknown on-disk layout for DB type")
+
+// ErrNoStorerDirectoryFound signals that no storer directory has been found in the source path
+var ErrNoStorerDirectoryFound = errors.New("no storer directory found")
+
+// ErrKeyCountMismatch signals that the destination persister holds a different number of keys than the source
+var ErrKeyCountMismatch = errors.New("key count mismatch")
+
+// ErrChecksumMismatch signals that the destination persister checksum differs from the source one
+var ErrChecksumMismatch = errors.New("checksum mismatch")
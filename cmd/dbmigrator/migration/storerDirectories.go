@@ -0,0 +1,157 @@
+package migration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// levelDBMarkerFile is the file that any LevelDB directory contains
+const levelDBMarkerFile = "CURRENT"
+
+// StorerDirectory holds the location of one persister, as laid out by the storage path manager
+type StorerDirectory struct {
+	RelativePath string
+	Epoch        uint32
+	IsStatic     bool
+	ShardID      string
+}
+
+// String returns a readable representation of the storer directory
+func (sd StorerDirectory) String() string {
+	return sd.RelativePath
+}
+
+// FindStorerDirectories walks the provided database path (the one containing the Epoch_X and Static
+// directories) and returns all the persister directories of the provided DB type, sorted by their relative path
+func FindStorerDirectories(dbPath string, dbType storageUnit.DBType) ([]StorerDirectory, error) {
+	markerFile, err := getMarkerFile(dbType)
+	if err != nil {
+		return nil, err
+	}
+
+	rootEntries, err := ioutil.ReadDir(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	storerDirectories := make([]StorerDirectory, 0)
+	for _, rootEntry := range rootEntries {
+		if !rootEntry.IsDir() {
+			continue
+		}
+
+		isStatic, epoch, ok := parseRootDirectory(rootEntry.Name())
+		if !ok {
+			log.Debug("skipping unknown directory", "name", rootEntry.Name())
+			continue
+		}
+
+		shardEntries, errRead := ioutil.ReadDir(filepath.Join(dbPath, rootEntry.Name()))
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for _, shardEntry := range shardEntries {
+			shardID, isShardDir := parseShardDirectory(shardEntry)
+			if !isShardDir {
+				continue
+			}
+
+			shardRelativePath := filepath.Join(rootEntry.Name(), shardEntry.Name())
+			found, errWalk := findDatabaseDirectories(dbPath, shardRelativePath, markerFile)
+			if errWalk != nil {
+				return nil, errWalk
+			}
+
+			for _, relativePath := range found {
+				storerDirectories = append(storerDirectories, StorerDirectory{
+					RelativePath: relativePath,
+					Epoch:        epoch,
+					IsStatic:     isStatic,
+					ShardID:      shardID,
+				})
+			}
+		}
+	}
+
+	sort.Slice(storerDirectories, func(i, j int) bool {
+		return storerDirectories[i].RelativePath < storerDirectories[j].RelativePath
+	})
+
+	return storerDirectories, nil
+}
+
+func getMarkerFile(dbType storageUnit.DBType) (string, error) {
+	switch dbType {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		return levelDBMarkerFile, nil
+	case storageUnit.BoltDB:
+		return boltdb.DataFileName, nil
+	default:
+		return "", fmt.Errorf("%w %s", ErrUnknownDBLayout, dbType)
+	}
+}
+
+func parseRootDirectory(name string) (isStatic bool, epoch uint32, ok bool) {
+	if name == common.DefaultStaticDbString {
+		return true, 0, true
+	}
+
+	epochPrefix := common.DefaultEpochString + "_"
+	if !strings.HasPrefix(name, epochPrefix) {
+		return false, 0, false
+	}
+
+	parsedEpoch, err := strconv.ParseUint(strings.TrimPrefix(name, epochPrefix), 10, 32)
+	if err != nil {
+		return false, 0, false
+	}
+
+	return false, uint32(parsedEpoch), true
+}
+
+func parseShardDirectory(entry os.FileInfo) (string, bool) {
+	shardPrefix := common.DefaultShardString + "_"
+	if !entry.IsDir() || !strings.HasPrefix(entry.Name(), shardPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(entry.Name(), shardPrefix), true
+}
+
+// findDatabaseDirectories recursively searches for directories containing the marker file. The search continues
+// inside database directories as well because some storers are nested (e.g. AccountsTrie/MainDB)
+func findDatabaseDirectories(dbPath string, relativePath string, markerFile string) ([]string, error) {
+	entries, err := ioutil.ReadDir(filepath.Join(dbPath, relativePath))
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			if entry.Name() == markerFile {
+				found = append(found, relativePath)
+			}
+			continue
+		}
+
+		nested, errFind := findDatabaseDirectories(dbPath, filepath.Join(relativePath, entry.Name()), markerFile)
+		if errFind != nil {
+			return nil, errFind
+		}
+
+		found = append(found, nested...)
+	}
+
+	return found, nil
+}
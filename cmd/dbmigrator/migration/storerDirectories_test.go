@@ -0,0 +1,77 @@
+package migration
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMarker(t *testing.T, dbPath string, relativePath string, markerFile string) {
+	directory := filepath.Join(dbPath, relativePath)
+	require.Nil(t, os.MkdirAll(directory, 0700))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(directory, markerFile), []byte("marker"), 0600))
+}
+
+func TestFindStorerDirectories_UnknownLayoutShouldError(t *testing.T) {
+	t.Parallel()
+
+	directories, err := FindStorerDirectories(t.TempDir(), storageUnit.MemoryDB)
+
+	assert.Nil(t, directories)
+	assert.True(t, errors.Is(err, ErrUnknownDBLayout))
+}
+
+func TestFindStorerDirectories_MissingPathShouldError(t *testing.T) {
+	t.Parallel()
+
+	directories, err := FindStorerDirectories(filepath.Join(t.TempDir(), "missing"), storageUnit.LvlDB)
+
+	assert.Nil(t, directories)
+	assert.NotNil(t, err)
+}
+
+func TestFindStorerDirectories_ShouldFollowThePathManagerLayout(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	createMarker(t, dbPath, filepath.Join("Epoch_12", "Shard_1", "MiniBlocks"), levelDBMarkerFile)
+	createMarker(t, dbPath, filepath.Join("Epoch_12", "Shard_1", "AccountsTrie", "MainDB"), levelDBMarkerFile)
+	createMarker(t, dbPath, filepath.Join("Static", "Shard_1", "MetaHdrHashNonce"), levelDBMarkerFile)
+	createMarker(t, dbPath, filepath.Join("Static", "Shard_1", "BoltStorer"), boltdb.DataFileName)
+	createMarker(t, dbPath, filepath.Join("Epoch_x", "Shard_1", "MiniBlocks"), levelDBMarkerFile)
+	createMarker(t, dbPath, filepath.Join("Epoch_12", "NotAShard", "MiniBlocks"), levelDBMarkerFile)
+
+	directories, err := FindStorerDirectories(dbPath, storageUnit.LvlDBSerial)
+	require.Nil(t, err)
+
+	expected := []StorerDirectory{
+		{
+			RelativePath: filepath.Join("Epoch_12", "Shard_1", "AccountsTrie", "MainDB"),
+			Epoch:        12,
+			ShardID:      "1",
+		},
+		{
+			RelativePath: filepath.Join("Epoch_12", "Shard_1", "MiniBlocks"),
+			Epoch:        12,
+			ShardID:      "1",
+		},
+		{
+			RelativePath: filepath.Join("Static", "Shard_1", "MetaHdrHashNonce"),
+			IsStatic:     true,
+			ShardID:      "1",
+		},
+	}
+	assert.Equal(t, expected, directories)
+
+	directories, err = FindStorerDirectories(dbPath, storageUnit.BoltDB)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(directories))
+	assert.Equal(t, filepath.Join("Static", "Shard_1", "BoltStorer"), directories[0].RelativePath)
+}
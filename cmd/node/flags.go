@@ -316,6 +316,14 @@ var (
 			"and by advanced users, as a too high memory ballast could lead to Out Of Memory panics. The memory ballast " +
 			"should not be higher than 20-25% of the machine's available RAM",
 	}
+	// stateArchive defines a flag for the path of a state archive used when bootstrapping from an epoch start
+	stateArchive = cli.StringFlag{
+		Name: "state-archive",
+		Usage: "This flag specifies the path of a state archive created with the stateexporter tool. When the node " +
+			"starts in epoch, the accounts and peer accounts tries are imported from the archive instead of being " +
+			"synced from the network, if their root hashes match the epoch start ones",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -364,6 +372,7 @@ func getFlags() []cli.Flag {
 		redundancyLevel,
 		fullArchive,
 		memBallast,
+		stateArchive,
 	}
 }
 
@@ -414,6 +423,9 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	if ctx.IsSet(fullArchive.Name) {
 		cfgs.PreferencesConfig.Preferences.FullArchive = ctx.GlobalBool(fullArchive.Name)
 	}
+	if ctx.IsSet(stateArchive.Name) {
+		cfgs.GeneralConfig.TrieSync.StateArchivePath = ctx.GlobalString(stateArchive.Name)
+	}
 
 	importDbDirectoryValue := ctx.GlobalString(importDbDirectory.Name)
 	importDBConfigs := &config.ImportDbConfig{
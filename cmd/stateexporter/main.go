@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/trieinspector/inspection"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state/archive"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrieStorerName     = "AccountsTrie"
+	peerAccountsTrieStorerName = "PeerAccountsTrie"
+	megabyte                   = 1024 * 1024
+)
+
+type cfg struct {
+	dbPath               string
+	dbType               string
+	shardID              string
+	epoch                uint
+	accountsRootHash     string
+	peerAccountsRootHash string
+	outputPath           string
+	maxChunkSizeInMB     uint64
+	maxOpenFiles         int
+	logLevel             string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the databases holding the tries
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The path of the node's databases directory, the one containing the Epoch_X and Static " +
+			"directories. Example: ./db/1",
+		Destination: &argsConfig.dbPath,
+	}
+	// dbType defines a flag for the persister type of the databases
+	dbType = cli.StringFlag{
+		Name: "db-type",
+		Usage: fmt.Sprintf("The persister type of the databases. Available options: %s, %s, %s",
+			storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BoltDB),
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &argsConfig.dbType,
+	}
+	// shardID defines a flag for the shard of the exported state
+	shardID = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the exported state, as found in the Shard_X directory names. Example: 0, metachain",
+		Value:       "0",
+		Destination: &argsConfig.shardID,
+	}
+	// epoch defines a flag for the epoch of the exported state
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch whose start root hashes are exported. It is recorded in the archive manifest",
+		Destination: &argsConfig.epoch,
+	}
+	// accountsRootHash defines a flag for the root hash of the accounts trie
+	accountsRootHash = cli.StringFlag{
+		Name:        "accounts-root-hash",
+		Usage:       "The hex encoded root hash of the accounts trie, as found in the epoch start block",
+		Destination: &argsConfig.accountsRootHash,
+	}
+	// peerAccountsRootHash defines a flag for the root hash of the peer accounts trie
+	peerAccountsRootHash = cli.StringFlag{
+		Name: "peer-accounts-root-hash",
+		Usage: "The hex encoded root hash of the peer accounts trie, as found in the epoch start block. " +
+			"If empty, the peer accounts trie is not exported",
+		Destination: &argsConfig.peerAccountsRootHash,
+	}
+	// outputPath defines a flag for the directory where the archive is written
+	outputPath = cli.StringFlag{
+		Name:        "output-path",
+		Usage:       "The directory where the state archive is written. It should not exist or be empty",
+		Value:       "./state-archive",
+		Destination: &argsConfig.outputPath,
+	}
+	// maxChunkSizeInMB defines a flag for the maximum size of an archive chunk
+	maxChunkSizeInMB = cli.Uint64Flag{
+		Name:        "max-chunk-size-mb",
+		Usage:       "The maximum size, in MB, of a chunk file of the archive",
+		Value:       64,
+		Destination: &argsConfig.maxChunkSizeInMB,
+	}
+	// maxOpenFiles defines a flag for the maximum number of files a LevelDB persister can keep open
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files a LevelDB persister can keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("stateexporter")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond State exporter Tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "This binary will open the trie storage of a stopped node and export the accounts and peer accounts " +
+		"tries at the provided epoch start root hashes into a chunked, checksummed state archive. The archive can be " +
+		"used by a new node to bootstrap its state locally"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		dbType,
+		shardID,
+		epoch,
+		accountsRootHash,
+		peerAccountsRootHash,
+		outputPath,
+		maxChunkSizeInMB,
+		maxOpenFiles,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	shard, err := parseShardID(argsConfig.shardID)
+	if err != nil {
+		return err
+	}
+
+	accountsRootHashBytes, err := hex.DecodeString(argsConfig.accountsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the accounts root hash", err)
+	}
+	peerAccountsRootHashBytes, err := hex.DecodeString(argsConfig.peerAccountsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the peer accounts root hash", err)
+	}
+
+	accountsDB, err := openTrieStorage(accountsTrieStorerName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = accountsDB.Close()
+	}()
+
+	tries := []archive.TrieToExport{
+		{
+			Name:          trieFactory.UserAccountTrie,
+			RootHash:      accountsRootHashBytes,
+			WithDataTries: true,
+			DB:            accountsDB,
+		},
+	}
+	if len(peerAccountsRootHashBytes) > 0 {
+		peerAccountsDB, errOpen := openTrieStorage(peerAccountsTrieStorerName)
+		if errOpen != nil {
+			return errOpen
+		}
+		defer func() {
+			_ = peerAccountsDB.Close()
+		}()
+
+		tries = append(tries, archive.TrieToExport{
+			Name:     trieFactory.PeerAccountTrie,
+			RootHash: peerAccountsRootHashBytes,
+			DB:       peerAccountsDB,
+		})
+	}
+
+	exporter, err := archive.NewExporter(archive.ArgsExporter{
+		Marshalizer:         &marshal.GogoProtoMarshalizer{},
+		Hasher:              blake2b.NewBlake2b(),
+		ArchivePath:         argsConfig.outputPath,
+		MaxChunkSizeInBytes: argsConfig.maxChunkSizeInMB * megabyte,
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest, err := exporter.Export(uint32(argsConfig.epoch), shard, tries)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state archive written", "path", argsConfig.outputPath, "epoch", manifest.Epoch, "num tries", len(manifest.Tries))
+
+	return nil
+}
+
+func openTrieStorage(storerName string) (common.DBWriteCacher, error) {
+	return inspection.OpenTrieStorage(inspection.ArgsOpenTrieStorage{
+		DBPath:       argsConfig.dbPath,
+		DBType:       argsConfig.dbType,
+		ShardID:      argsConfig.shardID,
+		StorerName:   storerName,
+		MaxOpenFiles: argsConfig.maxOpenFiles,
+	})
+}
+
+func parseShardID(shardID string) (uint32, error) {
+	if shardID == "metachain" {
+		return core.MetachainShardId, nil
+	}
+
+	value, err := strconv.ParseUint(shardID, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w while parsing the shard", err)
+	}
+
+	return uint32(value), nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -19,6 +20,9 @@ type config struct {
 	logWithLoggerName  bool
 	useWss             bool
 	interval           int
+	numRecentBlocks    int
+	lagThreshold       uint64
+	nodes              string
 	address            string
 	logLevel           string
 }
@@ -77,6 +81,31 @@ VERSION:
 		Destination: &argsConfig.interval,
 	}
 
+	// numRecentBlocks configures how many blocks are kept for the block explorer view
+	numRecentBlocks = cli.IntFlag{
+		Name:        "num-recent-blocks",
+		Usage:       "This flag specifies the number of recent blocks, with their miniblocks and transactions, which can be browsed in the block explorer view",
+		Value:       20,
+		Destination: &argsConfig.numRecentBlocks,
+	}
+
+	// nodes defines a flag for setting the additional nodes displayed in the nodes dashboard view
+	nodes = cli.StringFlag{
+		Name: "nodes",
+		Usage: "Comma-separated list of additional node addresses (address:port) which will be monitored in the nodes " +
+			"dashboard view, along with the node specified by the address flag",
+		Value:       "",
+		Destination: &argsConfig.nodes,
+	}
+
+	// lagThreshold configures when a monitored node is considered lagging
+	lagThreshold = cli.Uint64Flag{
+		Name:        "lag-threshold",
+		Usage:       "This flag specifies the number of blocks a node can be behind the majority of its shard's monitored nodes before being highlighted as lagging",
+		Value:       5,
+		Destination: &argsConfig.lagThreshold,
+	}
+
 	//useWss is used when the user require connection through wss
 	useWss = cli.BoolFlag{
 		Name:        "use-wss",
@@ -115,12 +144,38 @@ func startTermuiViewer(ctx *cli.Context) error {
 		return err
 	}
 
-	termuiConsole, err := termuic.NewTermuiConsole(presenterStatusHandler, fetchIntervalFlagValue, chanNodeIsStarting)
+	argsExplorerDataProvider := provider.ArgsExplorerDataProvider{
+		NonceGetter:     presenterStatusHandler,
+		NodeAddress:     nodeAddress,
+		FetchInterval:   fetchIntervalFlagValue,
+		NumRecentBlocks: argsConfig.numRecentBlocks,
+	}
+	explorerDataProvider, err := provider.NewExplorerDataProvider(argsExplorerDataProvider)
+	if err != nil {
+		return err
+	}
+
+	monitoredNodesProvider, err := createMonitoredNodesProvider(nodeAddress, argsConfig.nodes, fetchIntervalFlagValue)
+	if err != nil {
+		return err
+	}
+
+	argsTermuiConsole := termuic.ArgsTermuiConsole{
+		Presenter:                 presenterStatusHandler,
+		ExplorerDataHandler:       explorerDataProvider,
+		MonitoredNodesHandler:     monitoredNodesProvider,
+		LagThreshold:              argsConfig.lagThreshold,
+		RefreshTimeInMilliseconds: fetchIntervalFlagValue,
+		ChanNodeIsStarting:        chanNodeIsStarting,
+	}
+	termuiConsole, err := termuic.NewTermuiConsole(argsTermuiConsole)
 	if err != nil {
 		return err
 	}
 
 	statusMetricsProvider.StartUpdatingData()
+	explorerDataProvider.StartUpdatingData()
+	monitoredNodesProvider.StartUpdatingData()
 
 	loggerProfile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
@@ -155,18 +210,48 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+// createMonitoredNodesProvider creates the provider of the nodes dashboard view. The main node is always the first
+// monitored node, followed by the additional ones, each having its own presenter
+func createMonitoredNodesProvider(mainNodeAddress string, additionalNodes string, fetchInterval int) (*provider.MonitoredNodesProvider, error) {
+	addresses := []string{mainNodeAddress}
+	knownAddresses := map[string]struct{}{mainNodeAddress: {}}
+	for _, address := range strings.Split(additionalNodes, ",") {
+		address = strings.TrimSpace(address)
+		_, isKnown := knownAddresses[address]
+		if len(address) == 0 || isKnown {
+			continue
+		}
+
+		knownAddresses[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	nodesArgs := make([]provider.MonitoredNodeArgs, 0, len(addresses))
+	for _, address := range addresses {
+		nodesArgs = append(nodesArgs, provider.MonitoredNodeArgs{
+			Address:   address,
+			Presenter: presenter.NewPresenterStatusHandler(),
+		})
+	}
+
+	return provider.NewMonitoredNodesProvider(nodesArgs, fetchInterval)
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
 	cliApp.Name = "Elrond Terminal UI App"
 	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
-	cliApp.Usage = "Terminal UI application used to display metrics from the node"
+	cliApp.Usage = "Terminal UI application used to display metrics, recent blocks and peers from the node, as well as a dashboard of several nodes"
 	cliApp.Flags = []cli.Flag{
 		address,
 		logLevel,
 		logWithCorrelation,
 		logWithLoggerName,
 		fetchIntervalInMilliseconds,
+		numRecentBlocks,
+		nodes,
+		lagThreshold,
 		useWss,
 	}
 	cliApp.Authors = []cli.Author{
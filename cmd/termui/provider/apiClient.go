@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+const apiCodeSuccess = "successful"
+
+type genericResponseFromApi struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+// getDataFromApi will fetch the provided url and unmarshal the data field of the node's response in the given value
+func getDataFromApi(url string, value interface{}) error {
+	client := http.Client{}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Error("close response body", "error", errClose.Error())
+		}
+	}()
+
+	responseBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var response genericResponseFromApi
+	err = json.Unmarshal(responseBytes, &response)
+	if err != nil {
+		return err
+	}
+	if response.Code != apiCodeSuccess {
+		return fmt.Errorf("%w: code %s, %s", ErrApiResponse, response.Code, response.Error)
+	}
+
+	return json.Unmarshal(response.Data, value)
+}
@@ -19,3 +19,15 @@ var ErrNilChanNodeIsStarting = errors.New("nil node starting channel")
 
 // ErrEmptyNodeURL signals that an empty URL for the node has been provided
 var ErrEmptyNodeURL = errors.New("empty node URL")
+
+// ErrNilNonceGetter signals that a nil nonce getter has been provided
+var ErrNilNonceGetter = errors.New("nil nonce getter")
+
+// ErrInvalidNumRecentBlocks signals that an invalid number of recent blocks has been provided
+var ErrInvalidNumRecentBlocks = errors.New("invalid number of recent blocks")
+
+// ErrApiResponse signals that the node's API responded with an error
+var ErrApiResponse = errors.New("error response from the node's API")
+
+// ErrNoMonitoredNodes signals that no node to be monitored has been provided
+var ErrNoMonitoredNodes = errors.New("no monitored nodes")
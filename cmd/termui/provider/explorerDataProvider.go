@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+const (
+	blockByNonceUrlFormat    = "/block/by-nonce/%d?withTxs=true"
+	heartbeatStatusUrlSuffix = "/node/heartbeatstatus"
+
+	// heartbeatsRefreshInterval is kept higher than the usual fetch interval as the heartbeat list can become large
+	heartbeatsRefreshInterval = 10 * time.Second
+)
+
+type blockResponseData struct {
+	Block *api.Block `json:"block"`
+}
+
+type heartbeatsResponseData struct {
+	Heartbeats []heartbeatData.PubKeyHeartbeat `json:"heartbeats"`
+}
+
+// ArgsExplorerDataProvider is the DTO used to create a new instance of ExplorerDataProvider
+type ArgsExplorerDataProvider struct {
+	NonceGetter     NonceGetter
+	NodeAddress     string
+	FetchInterval   int
+	NumRecentBlocks int
+}
+
+// ExplorerDataProvider is the struct that will fetch the recent blocks and the heartbeats from the node
+type ExplorerDataProvider struct {
+	nonceGetter     NonceGetter
+	nodeAddress     string
+	fetchInterval   int
+	numRecentBlocks int
+
+	mutData               sync.RWMutex
+	recentBlocks          []*api.Block
+	heartbeats            []heartbeatData.PubKeyHeartbeat
+	lastHeartbeatsRefresh time.Time
+}
+
+// NewExplorerDataProvider will return a new instance of ExplorerDataProvider
+func NewExplorerDataProvider(args ArgsExplorerDataProvider) (*ExplorerDataProvider, error) {
+	if check.IfNil(args.NonceGetter) {
+		return nil, ErrNilNonceGetter
+	}
+	if len(args.NodeAddress) == 0 {
+		return nil, ErrInvalidAddressLength
+	}
+	if args.FetchInterval < 1 {
+		return nil, ErrInvalidFetchInterval
+	}
+	if args.NumRecentBlocks < 1 {
+		return nil, ErrInvalidNumRecentBlocks
+	}
+
+	return &ExplorerDataProvider{
+		nonceGetter:     args.NonceGetter,
+		nodeAddress:     formatUrlAddress(args.NodeAddress),
+		fetchInterval:   args.FetchInterval,
+		numRecentBlocks: args.NumRecentBlocks,
+		recentBlocks:    make([]*api.Block, 0),
+		heartbeats:      make([]heartbeatData.PubKeyHeartbeat, 0),
+	}, nil
+}
+
+// StartUpdatingData will update the recent blocks and the heartbeats from the API at a given interval
+func (edp *ExplorerDataProvider) StartUpdatingData() {
+	go func() {
+		for {
+			edp.updateRecentBlocks()
+			edp.updateHeartbeatsIfNeeded()
+
+			time.Sleep(time.Duration(edp.fetchInterval) * time.Millisecond)
+		}
+	}()
+}
+
+func (edp *ExplorerDataProvider) updateRecentBlocks() {
+	currentNonce := edp.nonceGetter.GetNonce()
+	if currentNonce == 0 {
+		return
+	}
+
+	firstNonce := edp.computeFirstNonceToFetch(currentNonce)
+	for nonce := firstNonce; nonce <= currentNonce; nonce++ {
+		block, err := edp.loadBlockFromApi(nonce)
+		if err != nil {
+			log.Debug("fetch block from API",
+				"nonce", nonce,
+				"error", err.Error())
+			return
+		}
+
+		edp.addBlock(block)
+	}
+}
+
+func (edp *ExplorerDataProvider) computeFirstNonceToFetch(currentNonce uint64) uint64 {
+	firstNonce := uint64(1)
+	if currentNonce >= uint64(edp.numRecentBlocks) {
+		firstNonce = currentNonce - uint64(edp.numRecentBlocks) + 1
+	}
+
+	edp.mutData.RLock()
+	defer edp.mutData.RUnlock()
+
+	if len(edp.recentBlocks) == 0 {
+		return firstNonce
+	}
+
+	highestKnownNonce := edp.recentBlocks[0].Nonce
+	if highestKnownNonce >= currentNonce {
+		// the node might have been restarted with a fresh database, start over
+		return firstNonce
+	}
+	if highestKnownNonce+1 > firstNonce {
+		return highestKnownNonce + 1
+	}
+
+	return firstNonce
+}
+
+func (edp *ExplorerDataProvider) loadBlockFromApi(nonce uint64) (*api.Block, error) {
+	blockUrl := edp.nodeAddress + fmt.Sprintf(blockByNonceUrlFormat, nonce)
+
+	response := &blockResponseData{}
+	err := getDataFromApi(blockUrl, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Block == nil {
+		return nil, fmt.Errorf("%w: empty block for nonce %d", ErrApiResponse, nonce)
+	}
+
+	return response.Block, nil
+}
+
+// addBlock keeps the recent blocks sorted descending by nonce, the newest one being the first
+func (edp *ExplorerDataProvider) addBlock(block *api.Block) {
+	edp.mutData.Lock()
+	defer edp.mutData.Unlock()
+
+	if len(edp.recentBlocks) > 0 && edp.recentBlocks[0].Nonce >= block.Nonce {
+		edp.recentBlocks = make([]*api.Block, 0, edp.numRecentBlocks)
+	}
+
+	edp.recentBlocks = append([]*api.Block{block}, edp.recentBlocks...)
+	if len(edp.recentBlocks) > edp.numRecentBlocks {
+		edp.recentBlocks = edp.recentBlocks[:edp.numRecentBlocks]
+	}
+}
+
+func (edp *ExplorerDataProvider) updateHeartbeatsIfNeeded() {
+	edp.mutData.RLock()
+	lastRefresh := edp.lastHeartbeatsRefresh
+	edp.mutData.RUnlock()
+
+	if time.Since(lastRefresh) < heartbeatsRefreshInterval {
+		return
+	}
+
+	heartbeats, err := edp.loadHeartbeatsFromApi()
+	if err != nil {
+		log.Debug("fetch heartbeats from API",
+			"error", err.Error())
+		return
+	}
+
+	edp.mutData.Lock()
+	edp.heartbeats = heartbeats
+	edp.lastHeartbeatsRefresh = time.Now()
+	edp.mutData.Unlock()
+}
+
+func (edp *ExplorerDataProvider) loadHeartbeatsFromApi() ([]heartbeatData.PubKeyHeartbeat, error) {
+	response := &heartbeatsResponseData{}
+	err := getDataFromApi(edp.nodeAddress+heartbeatStatusUrlSuffix, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Heartbeats, nil
+}
+
+// GetRecentBlocks returns the recently fetched blocks, the newest one being the first
+func (edp *ExplorerDataProvider) GetRecentBlocks() []*api.Block {
+	edp.mutData.RLock()
+	defer edp.mutData.RUnlock()
+
+	blocks := make([]*api.Block, len(edp.recentBlocks))
+	copy(blocks, edp.recentBlocks)
+
+	return blocks
+}
+
+// GetHeartbeats returns the last fetched heartbeats
+func (edp *ExplorerDataProvider) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
+	edp.mutData.RLock()
+	defer edp.mutData.RUnlock()
+
+	heartbeats := make([]heartbeatData.PubKeyHeartbeat, len(edp.heartbeats))
+	copy(heartbeats, edp.heartbeats)
+
+	return heartbeats
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (edp *ExplorerDataProvider) IsInterfaceNil() bool {
+	return edp == nil
+}
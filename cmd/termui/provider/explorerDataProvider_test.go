@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nonceGetterStub struct {
+	nonce uint64
+}
+
+func (ngs *nonceGetterStub) GetNonce() uint64 {
+	return ngs.nonce
+}
+
+func (ngs *nonceGetterStub) IsInterfaceNil() bool {
+	return ngs == nil
+}
+
+func createMockArgsExplorerDataProvider() ArgsExplorerDataProvider {
+	return ArgsExplorerDataProvider{
+		NonceGetter:     &nonceGetterStub{},
+		NodeAddress:     "127.0.0.1:8080",
+		FetchInterval:   1000,
+		NumRecentBlocks: 3,
+	}
+}
+
+func writeApiResponse(w http.ResponseWriter, data interface{}, errMessage string, code string) {
+	dataBytes, _ := json.Marshal(data)
+	response := genericResponseFromApi{
+		Data:  dataBytes,
+		Error: errMessage,
+		Code:  code,
+	}
+	responseBytes, _ := json.Marshal(response)
+	_, _ = w.Write(responseBytes)
+}
+
+func createNodeServer(requestedNonces *[]uint64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == heartbeatStatusUrlSuffix {
+			writeApiResponse(w, heartbeatsResponseData{
+				Heartbeats: []heartbeatData.PubKeyHeartbeat{{PublicKey: "pk", IsActive: true}},
+			}, "", apiCodeSuccess)
+			return
+		}
+
+		var nonce uint64
+		_, err := fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/block/by-nonce/"), "%d", &nonce)
+		if err != nil || r.URL.Query().Get("withTxs") != "true" {
+			writeApiResponse(w, nil, "bad request", "bad_request")
+			return
+		}
+
+		*requestedNonces = append(*requestedNonces, nonce)
+		writeApiResponse(w, blockResponseData{
+			Block: &api.Block{Nonce: nonce, Hash: fmt.Sprintf("hash%d", nonce)},
+		}, "", apiCodeSuccess)
+	}))
+}
+
+func getNonces(blocks []*api.Block) []uint64 {
+	nonces := make([]uint64, 0, len(blocks))
+	for _, block := range blocks {
+		nonces = append(nonces, block.Nonce)
+	}
+
+	return nonces
+}
+
+func TestNewExplorerDataProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nonce getter should error", func(t *testing.T) {
+		args := createMockArgsExplorerDataProvider()
+		args.NonceGetter = nil
+		edp, err := NewExplorerDataProvider(args)
+		assert.Nil(t, edp)
+		assert.Equal(t, ErrNilNonceGetter, err)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		args := createMockArgsExplorerDataProvider()
+		args.NodeAddress = ""
+		edp, err := NewExplorerDataProvider(args)
+		assert.Nil(t, edp)
+		assert.Equal(t, ErrInvalidAddressLength, err)
+	})
+	t.Run("invalid fetch interval should error", func(t *testing.T) {
+		args := createMockArgsExplorerDataProvider()
+		args.FetchInterval = 0
+		edp, err := NewExplorerDataProvider(args)
+		assert.Nil(t, edp)
+		assert.Equal(t, ErrInvalidFetchInterval, err)
+	})
+	t.Run("invalid number of recent blocks should error", func(t *testing.T) {
+		args := createMockArgsExplorerDataProvider()
+		args.NumRecentBlocks = 0
+		edp, err := NewExplorerDataProvider(args)
+		assert.Nil(t, edp)
+		assert.Equal(t, ErrInvalidNumRecentBlocks, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		edp, err := NewExplorerDataProvider(createMockArgsExplorerDataProvider())
+		assert.Nil(t, err)
+		assert.False(t, edp.IsInterfaceNil())
+		assert.Equal(t, "http://127.0.0.1:8080", edp.nodeAddress)
+	})
+}
+
+func TestExplorerDataProvider_UpdateRecentBlocks(t *testing.T) {
+	t.Parallel()
+
+	requestedNonces := make([]uint64, 0)
+	server := createNodeServer(&requestedNonces)
+	defer server.Close()
+
+	nonceGetter := &nonceGetterStub{}
+	args := createMockArgsExplorerDataProvider()
+	args.NonceGetter = nonceGetter
+	args.NodeAddress = server.URL
+	edp, _ := NewExplorerDataProvider(args)
+
+	edp.updateRecentBlocks()
+	assert.Empty(t, requestedNonces)
+	assert.Empty(t, edp.GetRecentBlocks())
+
+	nonceGetter.nonce = 2
+	edp.updateRecentBlocks()
+	assert.Equal(t, []uint64{1, 2}, requestedNonces)
+	assert.Equal(t, []uint64{2, 1}, getNonces(edp.GetRecentBlocks()))
+
+	// only the new blocks are requested and the oldest ones are evicted
+	nonceGetter.nonce = 5
+	edp.updateRecentBlocks()
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, requestedNonces)
+	assert.Equal(t, []uint64{5, 4, 3}, getNonces(edp.GetRecentBlocks()))
+
+	// a big gap only fetches the last blocks
+	requestedNonces = requestedNonces[:0]
+	nonceGetter.nonce = 100
+	edp.updateRecentBlocks()
+	assert.Equal(t, []uint64{98, 99, 100}, requestedNonces)
+	assert.Equal(t, []uint64{100, 99, 98}, getNonces(edp.GetRecentBlocks()))
+
+	// a lower nonce (node restarted) should start over
+	requestedNonces = requestedNonces[:0]
+	nonceGetter.nonce = 10
+	edp.updateRecentBlocks()
+	assert.Equal(t, []uint64{8, 9, 10}, requestedNonces)
+	assert.Equal(t, []uint64{10, 9, 8}, getNonces(edp.GetRecentBlocks()))
+}
+
+func TestExplorerDataProvider_UpdateRecentBlocksApiErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numCalls++
+		writeApiResponse(w, nil, "block not found", "internal_issue")
+	}))
+	defer server.Close()
+
+	args := createMockArgsExplorerDataProvider()
+	args.NonceGetter = &nonceGetterStub{nonce: 10}
+	args.NodeAddress = server.URL
+	edp, _ := NewExplorerDataProvider(args)
+
+	edp.updateRecentBlocks()
+	assert.Equal(t, 1, numCalls)
+	assert.Empty(t, edp.GetRecentBlocks())
+}
+
+func TestExplorerDataProvider_UpdateHeartbeatsIfNeeded(t *testing.T) {
+	t.Parallel()
+
+	requestedNonces := make([]uint64, 0)
+	server := createNodeServer(&requestedNonces)
+	defer server.Close()
+
+	args := createMockArgsExplorerDataProvider()
+	args.NodeAddress = server.URL
+	edp, _ := NewExplorerDataProvider(args)
+
+	edp.updateHeartbeatsIfNeeded()
+	heartbeats := edp.GetHeartbeats()
+	require.Equal(t, 1, len(heartbeats))
+	assert.Equal(t, "pk", heartbeats[0].PublicKey)
+	assert.True(t, heartbeats[0].IsActive)
+
+	// not refreshed before the refresh interval elapses
+	edp.mutData.Lock()
+	edp.heartbeats = make([]heartbeatData.PubKeyHeartbeat, 0)
+	edp.mutData.Unlock()
+	edp.updateHeartbeatsIfNeeded()
+	assert.Empty(t, edp.GetHeartbeats())
+
+	edp.mutData.Lock()
+	edp.lastHeartbeatsRefresh = time.Now().Add(-heartbeatsRefreshInterval)
+	edp.mutData.Unlock()
+	edp.updateHeartbeatsIfNeeded()
+	assert.Equal(t, 1, len(edp.GetHeartbeats()))
+}
+
+func TestGetDataFromApi_ErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeApiResponse(w, nil, "something went wrong", "internal_issue")
+	}))
+	defer server.Close()
+
+	response := &blockResponseData{}
+	err := getDataFromApi(server.URL, response)
+	assert.True(t, errors.Is(err, ErrApiResponse))
+	assert.True(t, strings.Contains(err.Error(), "something went wrong"))
+}
@@ -16,3 +16,9 @@ type PresenterHandler interface {
 	Write(p []byte) (n int, err error)
 	view.Presenter
 }
+
+// NonceGetter defines a component able to provide the current nonce of the node
+type NonceGetter interface {
+	GetNonce() uint64
+	IsInterfaceNil() bool
+}
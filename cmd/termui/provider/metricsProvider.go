@@ -54,12 +54,10 @@ func NewStatusMetricsProvider(presenter PresenterHandler, nodeAddress string, fe
 func (smp *StatusMetricsProvider) StartUpdatingData() {
 	go func() {
 		for {
-			metricsMap, err := smp.loadMetricsFromApi()
+			err := smp.updateMetrics()
 			if err != nil {
 				log.Debug("fetch from API",
 					"error", err.Error())
-			} else {
-				smp.applyMetricsToPresenter(metricsMap)
 			}
 
 			time.Sleep(time.Duration(smp.fetchInterval) * time.Millisecond)
@@ -67,6 +65,17 @@ func (smp *StatusMetricsProvider) StartUpdatingData() {
 	}()
 }
 
+func (smp *StatusMetricsProvider) updateMetrics() error {
+	metricsMap, err := smp.loadMetricsFromApi()
+	if err != nil {
+		return err
+	}
+
+	smp.applyMetricsToPresenter(metricsMap)
+
+	return nil
+}
+
 func (smp *StatusMetricsProvider) loadMetricsFromApi() (map[string]interface{}, error) {
 	client := http.Client{}
 
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/view"
+)
+
+// numFetchIntervalsBeforeUnreachable represents the number of fetch intervals without a successful update after which
+// a monitored node is considered unreachable
+const numFetchIntervalsBeforeUnreachable = 3
+
+// MonitoredNodeArgs holds the address of a monitored node and the presenter which will store its metrics
+type MonitoredNodeArgs struct {
+	Address   string
+	Presenter PresenterHandler
+}
+
+type monitoredNode struct {
+	address          string
+	presenter        PresenterHandler
+	metricsProvider  *StatusMetricsProvider
+	mutLastUpdate    sync.RWMutex
+	lastUpdate       time.Time
+	hasBeenRefreshed bool
+}
+
+// MonitoredNodesProvider is the struct that will fetch the status metrics of a list of nodes
+type MonitoredNodesProvider struct {
+	nodes         []*monitoredNode
+	fetchInterval int
+}
+
+// NewMonitoredNodesProvider will return a new instance of MonitoredNodesProvider
+func NewMonitoredNodesProvider(nodesArgs []MonitoredNodeArgs, fetchInterval int) (*MonitoredNodesProvider, error) {
+	if len(nodesArgs) == 0 {
+		return nil, ErrNoMonitoredNodes
+	}
+
+	nodes := make([]*monitoredNode, 0, len(nodesArgs))
+	for _, nodeArgs := range nodesArgs {
+		metricsProvider, err := NewStatusMetricsProvider(nodeArgs.Presenter, nodeArgs.Address, fetchInterval)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, &monitoredNode{
+			address:         nodeArgs.Address,
+			presenter:       nodeArgs.Presenter,
+			metricsProvider: metricsProvider,
+		})
+	}
+
+	return &MonitoredNodesProvider{
+		nodes:         nodes,
+		fetchInterval: fetchInterval,
+	}, nil
+}
+
+// StartUpdatingData will update the metrics of each monitored node at a given interval. Each node is polled
+// independently so that a slow node will not delay the others
+func (mnp *MonitoredNodesProvider) StartUpdatingData() {
+	for _, node := range mnp.nodes {
+		go mnp.updateNodeContinuously(node)
+	}
+}
+
+func (mnp *MonitoredNodesProvider) updateNodeContinuously(node *monitoredNode) {
+	for {
+		mnp.updateNode(node)
+
+		time.Sleep(time.Duration(mnp.fetchInterval) * time.Millisecond)
+	}
+}
+
+func (mnp *MonitoredNodesProvider) updateNode(node *monitoredNode) {
+	err := node.metricsProvider.updateMetrics()
+	if err != nil {
+		log.Debug("fetch from API",
+			"node", node.address,
+			"error", err.Error())
+		return
+	}
+
+	node.mutLastUpdate.Lock()
+	node.lastUpdate = time.Now()
+	node.hasBeenRefreshed = true
+	node.mutLastUpdate.Unlock()
+}
+
+func (mnp *MonitoredNodesProvider) isReachable(node *monitoredNode) bool {
+	node.mutLastUpdate.RLock()
+	defer node.mutLastUpdate.RUnlock()
+
+	if !node.hasBeenRefreshed {
+		return false
+	}
+
+	maxDelay := time.Duration(numFetchIntervalsBeforeUnreachable*mnp.fetchInterval) * time.Millisecond
+
+	return time.Since(node.lastUpdate) <= maxDelay
+}
+
+// GetMonitoredNodes returns the monitored nodes, in the order they were provided
+func (mnp *MonitoredNodesProvider) GetMonitoredNodes() []view.MonitoredNode {
+	monitoredNodes := make([]view.MonitoredNode, 0, len(mnp.nodes))
+	for _, node := range mnp.nodes {
+		monitoredNodes = append(monitoredNodes, view.MonitoredNode{
+			Address:     node.address,
+			IsReachable: mnp.isReachable(node),
+			Presenter:   node.presenter,
+		})
+	}
+
+	return monitoredNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mnp *MonitoredNodesProvider) IsInterfaceNil() bool {
+	return mnp == nil
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/presenter"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMonitoredNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no nodes should error", func(t *testing.T) {
+		mnp, err := NewMonitoredNodesProvider(nil, 1000)
+		assert.Nil(t, mnp)
+		assert.Equal(t, ErrNoMonitoredNodes, err)
+	})
+	t.Run("invalid node should error", func(t *testing.T) {
+		nodesArgs := []MonitoredNodeArgs{
+			{Address: "127.0.0.1:8080", Presenter: presenter.NewPresenterStatusHandler()},
+			{Address: "", Presenter: presenter.NewPresenterStatusHandler()},
+		}
+		mnp, err := NewMonitoredNodesProvider(nodesArgs, 1000)
+		assert.Nil(t, mnp)
+		assert.Equal(t, ErrInvalidAddressLength, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		nodesArgs := []MonitoredNodeArgs{
+			{Address: "127.0.0.1:8080", Presenter: presenter.NewPresenterStatusHandler()},
+		}
+		mnp, err := NewMonitoredNodesProvider(nodesArgs, 1000)
+		assert.Nil(t, err)
+		assert.False(t, mnp.IsInterfaceNil())
+	})
+}
+
+func TestMonitoredNodesProvider_UpdateNode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeApiResponse(w, statusMetricsResponseData{
+			Response: map[string]interface{}{
+				common.MetricNonce:           float64(37),
+				common.MetricNodeDisplayName: "observer-0",
+			},
+		}, "", apiCodeSuccess)
+	}))
+	defer server.Close()
+
+	reachablePresenter := presenter.NewPresenterStatusHandler()
+	nodesArgs := []MonitoredNodeArgs{
+		{Address: server.URL, Presenter: reachablePresenter},
+		{Address: "127.0.0.1:1", Presenter: presenter.NewPresenterStatusHandler()},
+	}
+	mnp, _ := NewMonitoredNodesProvider(nodesArgs, 1000)
+
+	nodes := mnp.GetMonitoredNodes()
+	require.Equal(t, 2, len(nodes))
+	assert.False(t, nodes[0].IsReachable)
+	assert.False(t, nodes[1].IsReachable)
+
+	for _, node := range mnp.nodes {
+		mnp.updateNode(node)
+	}
+
+	nodes = mnp.GetMonitoredNodes()
+	assert.True(t, nodes[0].IsReachable)
+	assert.Equal(t, uint64(37), nodes[0].Presenter.GetNonce())
+	assert.Equal(t, "observer-0", reachablePresenter.GetNodeName())
+	assert.False(t, nodes[1].IsReachable)
+	assert.Equal(t, "http://127.0.0.1:1", mnp.nodes[1].metricsProvider.nodeAddress)
+
+	// a node without recent updates becomes unreachable
+	mnp.nodes[0].mutLastUpdate.Lock()
+	mnp.nodes[0].lastUpdate = time.Now().Add(-time.Duration(numFetchIntervalsBeforeUnreachable+1) * time.Second)
+	mnp.nodes[0].mutLastUpdate.Unlock()
+	assert.False(t, mnp.GetMonitoredNodes()[0].IsReachable)
+}
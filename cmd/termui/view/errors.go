@@ -13,3 +13,12 @@ var ErrNilGrid = errors.New("nil grid")
 
 // ErrInvalidRefreshTimeInMilliseconds signals that an invalid time in milliseconds was provided
 var ErrInvalidRefreshTimeInMilliseconds = errors.New("invalid refresh time in milliseconds")
+
+// ErrNilExplorerDataHandler will be returned when a nil ExplorerDataHandler is passed as parameter
+var ErrNilExplorerDataHandler = errors.New("nil explorer data handler")
+
+// ErrNilMonitoredNodesHandler will be returned when a nil MonitoredNodesHandler is passed as parameter
+var ErrNilMonitoredNodesHandler = errors.New("nil monitored nodes handler")
+
+// ErrInvalidLagThreshold signals that an invalid threshold for lagging nodes was provided
+var ErrInvalidLagThreshold = errors.New("invalid lag threshold")
@@ -1,5 +1,10 @@
 package view
 
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
 // Presenter defines the methods that return information about node
 type Presenter interface {
 	GetAppVersion() string
@@ -60,3 +65,23 @@ type Presenter interface {
 	InvalidateCache()
 	IsInterfaceNil() bool
 }
+
+// ExplorerDataHandler defines the methods that return the recent blocks and the peers information
+type ExplorerDataHandler interface {
+	GetRecentBlocks() []*api.Block
+	GetHeartbeats() []heartbeatData.PubKeyHeartbeat
+	IsInterfaceNil() bool
+}
+
+// MonitoredNode holds the presenter of a node displayed in the nodes dashboard
+type MonitoredNode struct {
+	Address     string
+	IsReachable bool
+	Presenter   Presenter
+}
+
+// MonitoredNodesHandler defines the methods that return the nodes displayed in the nodes dashboard
+type MonitoredNodesHandler interface {
+	GetMonitoredNodes() []MonitoredNode
+	IsInterfaceNil() bool
+}
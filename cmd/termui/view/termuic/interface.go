@@ -1,5 +1,9 @@
 package termuic
 
+import (
+	ui "github.com/gizak/termui/v3"
+)
+
 //TermuiRender defines the actions which should be handled by a render
 type TermuiRender interface {
 	// RefreshData method is used to refresh data that are displayed on a grid
@@ -7,3 +11,15 @@ type TermuiRender interface {
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
+
+// KeyHandler defines a render which reacts to the keys pressed by the user
+type KeyHandler interface {
+	// HandleKey processes the provided key and returns true if the key was handled
+	HandleKey(key string) bool
+}
+
+// DrawableContainerHandler defines a container holding the drawables of a view
+type DrawableContainerHandler interface {
+	Items() []ui.Drawable
+	SetRectangle(startWidth, startHeight, termWidth, termHeight int)
+}
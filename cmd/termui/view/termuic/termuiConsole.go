@@ -3,6 +3,7 @@ package termuic
 import (
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -20,33 +21,73 @@ const numOfTicksBeforeRedrawing = 10
 
 var log = logger.GetOrCreate("statushandler/view/termuic")
 
+// the keys used for switching between the views of the console
+const (
+	keyStatusView = "1"
+	keyBlocksView = "2"
+	keyPeersView  = "3"
+	keyNodesView  = "4"
+	keyNextView   = "<Tab>"
+)
+
+type termuiView struct {
+	render    TermuiRender
+	container DrawableContainerHandler
+}
+
+// ArgsTermuiConsole is the DTO used to create a new instance of TermuiConsole
+type ArgsTermuiConsole struct {
+	Presenter                 view.Presenter
+	ExplorerDataHandler       view.ExplorerDataHandler
+	MonitoredNodesHandler     view.MonitoredNodesHandler
+	LagThreshold              uint64
+	RefreshTimeInMilliseconds int
+	ChanNodeIsStarting        chan struct{}
+}
+
 // TermuiConsole data where is store data from handler
 type TermuiConsole struct {
 	presenter                 view.Presenter
-	consoleRender             TermuiRender
-	grid                      *termuiRenders.DrawableContainer
+	explorerDataHandler       view.ExplorerDataHandler
+	monitoredNodesHandler     view.MonitoredNodesHandler
+	lagThreshold              uint64
+	views                     []*termuiView
+	activeView                int
 	mutRefresh                *sync.RWMutex
 	chanNodeIsStarting        chan struct{}
 	refreshTimeInMilliseconds int
 }
 
 // NewTermuiConsole method is used to return a new TermuiConsole structure
-func NewTermuiConsole(presenter view.Presenter, refreshTimeInMilliseconds int, chanNodeIsStarting chan struct{}) (*TermuiConsole, error) {
-	if presenter == nil {
+func NewTermuiConsole(args ArgsTermuiConsole) (*TermuiConsole, error) {
+	if args.Presenter == nil {
 		return nil, view.ErrNilPresenterInterface
 	}
-	if refreshTimeInMilliseconds < 1 {
+	if args.ExplorerDataHandler == nil || args.ExplorerDataHandler.IsInterfaceNil() {
+		return nil, view.ErrNilExplorerDataHandler
+	}
+	if args.MonitoredNodesHandler == nil || args.MonitoredNodesHandler.IsInterfaceNil() {
+		return nil, view.ErrNilMonitoredNodesHandler
+	}
+	if args.LagThreshold == 0 {
+		return nil, view.ErrInvalidLagThreshold
+	}
+	if args.RefreshTimeInMilliseconds < 1 {
 		return nil, view.ErrInvalidRefreshTimeInMilliseconds
 	}
-	if chanNodeIsStarting == nil {
+	if args.ChanNodeIsStarting == nil {
 		return nil, view.ErrNilChanNodeIsStarting
 	}
 
 	tc := TermuiConsole{
-		presenter:                 presenter,
+		presenter:                 args.Presenter,
+		explorerDataHandler:       args.ExplorerDataHandler,
+		monitoredNodesHandler:     args.MonitoredNodesHandler,
+		lagThreshold:              args.LagThreshold,
+		views:                     make([]*termuiView, 0),
 		mutRefresh:                &sync.RWMutex{},
-		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
-		chanNodeIsStarting:        chanNodeIsStarting,
+		refreshTimeInMilliseconds: args.RefreshTimeInMilliseconds,
+		chanNodeIsStarting:        args.ChanNodeIsStarting,
 	}
 
 	return &tc, nil
@@ -67,28 +108,23 @@ func (tc *TermuiConsole) Start() error {
 }
 
 func (tc *TermuiConsole) eventLoop() {
-	tc.grid = termuiRenders.NewDrawableContainer()
-	if tc.grid == nil {
-		log.Debug("cannot render termui console", "error", view.ErrNilGrid.Error())
-		return
-	}
-
-	var err error
-	tc.consoleRender, err = termuiRenders.NewWidgetsRender(tc.presenter, tc.grid)
+	err := tc.createViews()
 	if err != nil {
-		log.Debug("nil console render", "error", err.Error())
+		log.Debug("cannot render termui console", "error", err.Error())
 		return
 	}
 
 	termWidth, termHeight := ui.TerminalDimensions()
-	tc.grid.SetRectangle(0, 0, termWidth, termHeight)
+	for _, v := range tc.views {
+		v.container.SetRectangle(0, 0, termWidth, termHeight)
+	}
 
 	uiEvents := ui.PollEvents()
 	// handles kill signal sent to gotop
 	sigTerm := make(chan os.Signal, 2)
 	signal.Notify(sigTerm, os.Interrupt, syscall.SIGTERM)
 
-	tc.consoleRender.RefreshData(tc.refreshTimeInMilliseconds)
+	tc.currentView().render.RefreshData(tc.refreshTimeInMilliseconds)
 	ticksCounter := uint32(0)
 
 	for {
@@ -114,6 +150,75 @@ func (tc *TermuiConsole) processUiEvents(e ui.Event, numMillisecondsRefreshTime
 		ui.Close()
 		stopApplication()
 		return
+	case keyStatusView, keyBlocksView, keyPeersView, keyNodesView:
+		viewIndex, _ := strconv.Atoi(e.ID)
+		tc.switchToView(viewIndex-1, numMillisecondsRefreshTime)
+	case keyNextView:
+		tc.switchToView(tc.activeView+1, numMillisecondsRefreshTime)
+	default:
+		tc.forwardKeyToActiveView(e.ID)
+	}
+}
+
+func (tc *TermuiConsole) createViews() error {
+	statusContainer := termuiRenders.NewDrawableContainer()
+	statusRender, err := termuiRenders.NewWidgetsRender(tc.presenter, statusContainer)
+	if err != nil {
+		return err
+	}
+
+	blocksContainer := termuiRenders.NewFullScreenContainer()
+	blocksRender, err := termuiRenders.NewExplorerRender(tc.explorerDataHandler, blocksContainer)
+	if err != nil {
+		return err
+	}
+
+	peersContainer := termuiRenders.NewFullScreenContainer()
+	peersRender, err := termuiRenders.NewPeersRender(tc.explorerDataHandler, peersContainer)
+	if err != nil {
+		return err
+	}
+
+	nodesContainer := termuiRenders.NewFullScreenContainer()
+	nodesRender, err := termuiRenders.NewNodesDashboardRender(tc.monitoredNodesHandler, nodesContainer, tc.lagThreshold)
+	if err != nil {
+		return err
+	}
+
+	// the order of the views must match the keys used for switching between them
+	tc.views = []*termuiView{
+		{render: statusRender, container: statusContainer},
+		{render: blocksRender, container: blocksContainer},
+		{render: peersRender, container: peersContainer},
+		{render: nodesRender, container: nodesContainer},
+	}
+
+	return nil
+}
+
+func (tc *TermuiConsole) currentView() *termuiView {
+	return tc.views[tc.activeView]
+}
+
+func (tc *TermuiConsole) switchToView(viewIndex int, numMillisecondsRefreshTime int) {
+	tc.mutRefresh.Lock()
+	tc.activeView = viewIndex % len(tc.views)
+	tc.mutRefresh.Unlock()
+
+	tc.refreshWindow(numMillisecondsRefreshTime)
+}
+
+func (tc *TermuiConsole) forwardKeyToActiveView(key string) {
+	tc.mutRefresh.Lock()
+	defer tc.mutRefresh.Unlock()
+
+	keyHandler, ok := tc.currentView().render.(KeyHandler)
+	if !ok {
+		return
+	}
+
+	if keyHandler.HandleKey(key) {
+		tc.render()
 	}
 }
 
@@ -134,7 +239,12 @@ func (tc *TermuiConsole) doResizeEvent(e ui.Event, numMillisecondsRefreshTime in
 }
 
 func (tc *TermuiConsole) doResize(width int, height int, numMillisecondsRefreshTime int) {
-	tc.grid.SetRectangle(0, 0, width, height)
+	tc.mutRefresh.Lock()
+	for _, v := range tc.views {
+		v.container.SetRectangle(0, 0, width, height)
+	}
+	tc.mutRefresh.Unlock()
+
 	tc.refreshWindow(numMillisecondsRefreshTime)
 }
 
@@ -142,7 +252,11 @@ func (tc *TermuiConsole) refreshWindow(numMillisecondsRefreshTime int) {
 	tc.mutRefresh.Lock()
 	defer tc.mutRefresh.Unlock()
 
-	tc.consoleRender.RefreshData(numMillisecondsRefreshTime)
+	tc.currentView().render.RefreshData(numMillisecondsRefreshTime)
+	tc.render()
+}
+
+func (tc *TermuiConsole) render() {
 	ui.Clear()
-	ui.Render(tc.grid.TopLeft(), tc.grid.TopRight(), tc.grid.Bottom())
+	ui.Render(tc.currentView().container.Items()...)
 }
@@ -0,0 +1,337 @@
+package termuiRenders
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+type explorerLevel int
+
+const (
+	levelBlocks explorerLevel = iota
+	levelMiniBlocks
+	levelTransactions
+	levelTransactionDetails
+)
+
+const shortHashLength = 16
+
+// explorerNavigator holds the navigation state of the block explorer: the current level and the selected items.
+// The selected block, miniblock and transaction are kept as pointers so that a drilled down view remains stable
+// even if the block is evicted from the recent blocks list in the meantime
+type explorerNavigator struct {
+	level             explorerLevel
+	selectedRow       int
+	blocks            []*api.Block
+	selectedBlock     *api.Block
+	selectedMiniBlock *api.MiniBlock
+	selectedTx        *transaction.ApiTransactionResult
+
+	// rows selected on the upper levels, restored when going back
+	blockRow     int
+	miniBlockRow int
+	txRow        int
+}
+
+func newExplorerNavigator() *explorerNavigator {
+	return &explorerNavigator{
+		level:  levelBlocks,
+		blocks: make([]*api.Block, 0),
+	}
+}
+
+// setBlocks updates the recent blocks. On the blocks level, the selection follows the newest block if the first row
+// was selected, otherwise the previously selected block remains selected
+func (en *explorerNavigator) setBlocks(blocks []*api.Block) {
+	previousHighlighted := en.highlightedBlock()
+	followLatest := en.blockRow == 0
+
+	en.blocks = blocks
+	if followLatest || previousHighlighted == nil {
+		en.blockRow = 0
+	} else {
+		en.blockRow = en.indexOfBlock(previousHighlighted.Hash, en.blockRow)
+	}
+
+	if en.level == levelBlocks {
+		en.selectedRow = en.blockRow
+	}
+	en.clampSelectedRow()
+}
+
+func (en *explorerNavigator) indexOfBlock(hash string, defaultIndex int) int {
+	for i, block := range en.blocks {
+		if block.Hash == hash {
+			return i
+		}
+	}
+
+	return defaultIndex
+}
+
+func (en *explorerNavigator) highlightedBlock() *api.Block {
+	if en.blockRow < 0 || en.blockRow >= len(en.blocks) {
+		return nil
+	}
+
+	return en.blocks[en.blockRow]
+}
+
+func (en *explorerNavigator) numRows() int {
+	switch en.level {
+	case levelBlocks:
+		return len(en.blocks)
+	case levelMiniBlocks:
+		return len(en.selectedBlock.MiniBlocks)
+	case levelTransactions:
+		return len(en.selectedMiniBlock.Transactions)
+	case levelTransactionDetails:
+		return len(formatTransactionDetails(en.selectedTx))
+	default:
+		return 0
+	}
+}
+
+func (en *explorerNavigator) clampSelectedRow() {
+	if en.selectedRow >= en.numRows() {
+		en.selectedRow = en.numRows() - 1
+	}
+	if en.selectedRow < 0 {
+		en.selectedRow = 0
+	}
+	en.saveSelectedRow()
+}
+
+func (en *explorerNavigator) saveSelectedRow() {
+	switch en.level {
+	case levelBlocks:
+		en.blockRow = en.selectedRow
+	case levelMiniBlocks:
+		en.miniBlockRow = en.selectedRow
+	case levelTransactions:
+		en.txRow = en.selectedRow
+	}
+}
+
+func (en *explorerNavigator) moveBy(delta int) {
+	en.selectedRow += delta
+	en.clampSelectedRow()
+}
+
+func (en *explorerNavigator) moveToTop() {
+	en.selectedRow = 0
+	en.clampSelectedRow()
+}
+
+func (en *explorerNavigator) moveToBottom() {
+	en.selectedRow = en.numRows() - 1
+	en.clampSelectedRow()
+}
+
+// enter drills down into the selected item. Returns false if there is nothing to open
+func (en *explorerNavigator) enter() bool {
+	if en.numRows() == 0 {
+		return false
+	}
+
+	switch en.level {
+	case levelBlocks:
+		en.selectedBlock = en.blocks[en.selectedRow]
+		en.level = levelMiniBlocks
+		en.miniBlockRow = 0
+	case levelMiniBlocks:
+		en.selectedMiniBlock = en.selectedBlock.MiniBlocks[en.selectedRow]
+		en.level = levelTransactions
+		en.txRow = 0
+	case levelTransactions:
+		en.selectedTx = en.selectedMiniBlock.Transactions[en.selectedRow]
+		en.level = levelTransactionDetails
+	default:
+		return false
+	}
+
+	en.selectedRow = 0
+	return true
+}
+
+// back returns to the upper level. Returns false if already on the top level
+func (en *explorerNavigator) back() bool {
+	switch en.level {
+	case levelMiniBlocks:
+		en.level = levelBlocks
+		en.selectedBlock = nil
+		en.selectedRow = en.blockRow
+	case levelTransactions:
+		en.level = levelMiniBlocks
+		en.selectedMiniBlock = nil
+		en.selectedRow = en.miniBlockRow
+	case levelTransactionDetails:
+		en.level = levelTransactions
+		en.selectedTx = nil
+		en.selectedRow = en.txRow
+	default:
+		return false
+	}
+
+	en.clampSelectedRow()
+	return true
+}
+
+func (en *explorerNavigator) title() string {
+	switch en.level {
+	case levelBlocks:
+		return fmt.Sprintf("Recent blocks (%d)", len(en.blocks))
+	case levelMiniBlocks:
+		return fmt.Sprintf("Block %d > miniblocks (%d)", en.selectedBlock.Nonce, len(en.selectedBlock.MiniBlocks))
+	case levelTransactions:
+		return fmt.Sprintf("Block %d > miniblock %s > transactions (%d)",
+			en.selectedBlock.Nonce, shortHash(en.selectedMiniBlock.Hash), len(en.selectedMiniBlock.Transactions))
+	case levelTransactionDetails:
+		return fmt.Sprintf("Block %d > miniblock %s > transaction %s",
+			en.selectedBlock.Nonce, shortHash(en.selectedMiniBlock.Hash), shortHash(en.selectedTx.Hash))
+	default:
+		return ""
+	}
+}
+
+func (en *explorerNavigator) rows() []string {
+	switch en.level {
+	case levelBlocks:
+		rows := make([]string, 0, len(en.blocks))
+		for _, block := range en.blocks {
+			rows = append(rows, fmt.Sprintf("#%d  round %d  %s  txs: %d  miniblocks: %d",
+				block.Nonce, block.Round, shortHash(block.Hash), block.NumTxs, len(block.MiniBlocks)))
+		}
+		return rows
+	case levelMiniBlocks:
+		rows := make([]string, 0, len(en.selectedBlock.MiniBlocks))
+		for _, mb := range en.selectedBlock.MiniBlocks {
+			rows = append(rows, fmt.Sprintf("%s  %s  %s -> %s  txs: %d",
+				shortHash(mb.Hash), mb.Type, shardIDToString(mb.SourceShard), shardIDToString(mb.DestinationShard), len(mb.Transactions)))
+		}
+		return rows
+	case levelTransactions:
+		rows := make([]string, 0, len(en.selectedMiniBlock.Transactions))
+		for _, tx := range en.selectedMiniBlock.Transactions {
+			rows = append(rows, fmt.Sprintf("%s  %s  value: %s  %s",
+				shortHash(tx.Hash), tx.Type, tx.Value, tx.Status))
+		}
+		return rows
+	case levelTransactionDetails:
+		return formatTransactionDetails(en.selectedTx)
+	default:
+		return make([]string, 0)
+	}
+}
+
+// details returns the lines describing the highlighted item
+func (en *explorerNavigator) details() []string {
+	if en.numRows() == 0 {
+		return make([]string, 0)
+	}
+
+	switch en.level {
+	case levelBlocks:
+		return formatBlockDetails(en.blocks[en.selectedRow])
+	case levelMiniBlocks:
+		return formatMiniBlockDetails(en.selectedBlock.MiniBlocks[en.selectedRow])
+	case levelTransactions:
+		return formatTransactionDetails(en.selectedMiniBlock.Transactions[en.selectedRow])
+	case levelTransactionDetails:
+		return []string{en.rows()[en.selectedRow]}
+	default:
+		return make([]string, 0)
+	}
+}
+
+func formatBlockDetails(block *api.Block) []string {
+	lines := []string{
+		fmt.Sprintf("Nonce: %d", block.Nonce),
+		fmt.Sprintf("Round: %d", block.Round),
+		fmt.Sprintf("Epoch: %d", block.Epoch),
+		fmt.Sprintf("Shard: %s", shardIDToString(block.Shard)),
+		fmt.Sprintf("Hash: %s", block.Hash),
+		fmt.Sprintf("Previous hash: %s", block.PrevBlockHash),
+		fmt.Sprintf("Timestamp: %s", time.Unix(int64(block.Timestamp), 0).UTC().Format(time.RFC3339)),
+		fmt.Sprintf("Transactions: %d", block.NumTxs),
+		fmt.Sprintf("Miniblocks: %d", len(block.MiniBlocks)),
+		fmt.Sprintf("Notarized blocks: %d", len(block.NotarizedBlocks)),
+	}
+	if len(block.AccumulatedFees) > 0 {
+		lines = append(lines, fmt.Sprintf("Accumulated fees: %s", block.AccumulatedFees))
+	}
+	if len(block.DeveloperFees) > 0 {
+		lines = append(lines, fmt.Sprintf("Developer fees: %s", block.DeveloperFees))
+	}
+	if len(block.Status) > 0 {
+		lines = append(lines, fmt.Sprintf("Status: %s", block.Status))
+	}
+
+	return lines
+}
+
+func formatMiniBlockDetails(mb *api.MiniBlock) []string {
+	return []string{
+		fmt.Sprintf("Hash: %s", mb.Hash),
+		fmt.Sprintf("Type: %s", mb.Type),
+		fmt.Sprintf("Source shard: %s", shardIDToString(mb.SourceShard)),
+		fmt.Sprintf("Destination shard: %s", shardIDToString(mb.DestinationShard)),
+		fmt.Sprintf("Transactions: %d", len(mb.Transactions)),
+		fmt.Sprintf("Receipts: %d", len(mb.Receipts)),
+	}
+}
+
+func formatTransactionDetails(tx *transaction.ApiTransactionResult) []string {
+	lines := []string{
+		fmt.Sprintf("Hash: %s", tx.Hash),
+		fmt.Sprintf("Type: %s", tx.Type),
+		fmt.Sprintf("Status: %s", tx.Status),
+		fmt.Sprintf("Nonce: %d", tx.Nonce),
+		fmt.Sprintf("Sender: %s", tx.Sender),
+		fmt.Sprintf("Receiver: %s", tx.Receiver),
+		fmt.Sprintf("Value: %s", tx.Value),
+		fmt.Sprintf("Gas price: %d", tx.GasPrice),
+		fmt.Sprintf("Gas limit: %d", tx.GasLimit),
+		fmt.Sprintf("Source shard: %s", shardIDToString(tx.SourceShard)),
+		fmt.Sprintf("Destination shard: %s", shardIDToString(tx.DestinationShard)),
+	}
+	if len(tx.Data) > 0 {
+		lines = append(lines, fmt.Sprintf("Data: %s", string(tx.Data)))
+	}
+	if len(tx.Function) > 0 {
+		lines = append(lines, fmt.Sprintf("Function: %s", tx.Function))
+	}
+	if len(tx.ReturnMessage) > 0 {
+		lines = append(lines, fmt.Sprintf("Return message: %s", tx.ReturnMessage))
+	}
+	for _, scr := range tx.SmartContractResults {
+		lines = append(lines, fmt.Sprintf("Smart contract result: %s", scr.Hash))
+	}
+	if tx.Logs != nil {
+		for _, event := range tx.Logs.Events {
+			lines = append(lines, fmt.Sprintf("Log event: %s", event.Identifier))
+		}
+	}
+
+	return lines
+}
+
+func shortHash(hash string) string {
+	if len(hash) <= shortHashLength {
+		return hash
+	}
+
+	return hash[:shortHashLength/2] + ".." + hash[len(hash)-shortHashLength/2:]
+}
+
+func shardIDToString(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return "meta"
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
@@ -0,0 +1,141 @@
+package termuiRenders
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestBlocks(nonces ...uint64) []*api.Block {
+	blocks := make([]*api.Block, 0, len(nonces))
+	for _, nonce := range nonces {
+		blocks = append(blocks, &api.Block{
+			Nonce: nonce,
+			Hash:  string(rune('a' + nonce)),
+			MiniBlocks: []*api.MiniBlock{
+				{
+					Hash: "mb0",
+					Transactions: []*transaction.ApiTransactionResult{
+						{Hash: "tx0", Data: []byte("transfer")},
+						{Hash: "tx1"},
+					},
+				},
+				{Hash: "mb1", DestinationShard: core.MetachainShardId},
+			},
+		})
+	}
+
+	return blocks
+}
+
+func TestExplorerNavigator_DrillDownAndBack(t *testing.T) {
+	t.Parallel()
+
+	en := newExplorerNavigator()
+	assert.False(t, en.enter())
+	assert.False(t, en.back())
+
+	en.setBlocks(createTestBlocks(3, 2, 1))
+	require.Equal(t, 3, len(en.rows()))
+
+	en.moveBy(1)
+	assert.True(t, en.enter())
+	assert.Equal(t, levelMiniBlocks, en.level)
+	assert.Equal(t, uint64(2), en.selectedBlock.Nonce)
+	assert.Equal(t, 2, len(en.rows()))
+	assert.Contains(t, en.rows()[1], "meta")
+
+	assert.True(t, en.enter())
+	assert.Equal(t, levelTransactions, en.level)
+	assert.Equal(t, "mb0", en.selectedMiniBlock.Hash)
+
+	en.moveToBottom()
+	assert.Equal(t, 1, en.selectedRow)
+	en.moveToTop()
+	assert.True(t, en.enter())
+	assert.Equal(t, levelTransactionDetails, en.level)
+	assert.Equal(t, "tx0", en.selectedTx.Hash)
+	assert.Contains(t, en.rows(), "Data: transfer")
+	assert.False(t, en.enter())
+
+	assert.True(t, en.back())
+	assert.Equal(t, levelTransactions, en.level)
+	assert.True(t, en.back())
+	assert.Equal(t, levelMiniBlocks, en.level)
+	assert.True(t, en.back())
+	assert.Equal(t, levelBlocks, en.level)
+	assert.Equal(t, 1, en.selectedRow)
+}
+
+func TestExplorerNavigator_SetBlocksShouldKeepSelection(t *testing.T) {
+	t.Parallel()
+
+	en := newExplorerNavigator()
+	en.setBlocks(createTestBlocks(3, 2, 1))
+
+	// the first row follows the newest block
+	en.setBlocks(createTestBlocks(4, 3, 2))
+	assert.Equal(t, 0, en.selectedRow)
+
+	// a selected older block remains selected when new blocks arrive
+	en.moveBy(1)
+	en.setBlocks(createTestBlocks(5, 4, 3))
+	assert.Equal(t, 2, en.selectedRow)
+	assert.Contains(t, en.details(), "Nonce: 3")
+
+	// the drilled down block remains available even if evicted
+	en.enter()
+	en.setBlocks(createTestBlocks(8, 7, 6))
+	assert.Equal(t, levelMiniBlocks, en.level)
+	assert.Equal(t, uint64(3), en.selectedBlock.Nonce)
+	assert.Equal(t, 2, len(en.rows()))
+}
+
+func TestExplorerNavigator_MoveShouldClamp(t *testing.T) {
+	t.Parallel()
+
+	en := newExplorerNavigator()
+	en.setBlocks(createTestBlocks(3, 2, 1))
+
+	en.moveBy(pageSize)
+	assert.Equal(t, 2, en.selectedRow)
+	en.moveBy(-pageSize)
+	assert.Equal(t, 0, en.selectedRow)
+
+	en.setBlocks(make([]*api.Block, 0))
+	assert.Equal(t, 0, en.selectedRow)
+	assert.Empty(t, en.details())
+}
+
+func TestShortHash(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "abc", shortHash("abc"))
+	assert.Equal(t, "01234567..89abcdef", shortHash("0123456789abcdef0123456789abcdef"))
+}
+
+func TestPreparePeersRowsAndSummary(t *testing.T) {
+	t.Parallel()
+
+	heartbeats := []heartbeatData.PubKeyHeartbeat{
+		{PublicKey: "pk3", NodeDisplayName: "node-b", ComputedShardID: 1, IsActive: true},
+		{PublicKey: "pk2", NodeDisplayName: "node-a", ComputedShardID: core.MetachainShardId},
+		{PublicKey: "pk1", NodeDisplayName: "node-c", ComputedShardID: 0, IsActive: true},
+		{PublicKey: "pk0", NodeDisplayName: "node-a", ComputedShardID: 1, IsActive: true},
+	}
+
+	rows := preparePeersRows(heartbeats)
+	require.Equal(t, 4, len(rows))
+	assert.Equal(t, "node-c", rows[0][0])
+	assert.Equal(t, "node-a", rows[1][0])
+	assert.Equal(t, "node-b", rows[2][0])
+	assert.Equal(t, "meta", rows[3][2])
+	assert.Equal(t, "no", rows[3][peersActiveColumn])
+
+	assert.Equal(t, "Active: 3/4  shard 0: 1/1  shard 1: 2/2  shard meta: 0/1", summarizePeers(heartbeats))
+}
@@ -0,0 +1,118 @@
+package termuiRenders
+
+import (
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/view"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+const explorerKeysHelp = "Up/Down: select  Enter: open  Esc/Backspace: back"
+
+// ExplorerRender will define the termui widgets used to browse the recent blocks, their miniblocks and transactions
+type ExplorerRender struct {
+	container   *FullScreenContainer
+	header      *widgets.Paragraph
+	list        *widgets.List
+	details     *widgets.List
+	dataHandler view.ExplorerDataHandler
+	navigator   *explorerNavigator
+}
+
+// NewExplorerRender method will create a new ExplorerRender
+func NewExplorerRender(dataHandler view.ExplorerDataHandler, container *FullScreenContainer) (*ExplorerRender, error) {
+	if dataHandler == nil || dataHandler.IsInterfaceNil() {
+		return nil, view.ErrNilExplorerDataHandler
+	}
+	if container == nil {
+		return nil, view.ErrNilGrid
+	}
+
+	er := &ExplorerRender{
+		container:   container,
+		dataHandler: dataHandler,
+		navigator:   newExplorerNavigator(),
+	}
+	er.initWidgets()
+	er.setGrid()
+
+	return er, nil
+}
+
+func (er *ExplorerRender) initWidgets() {
+	er.header = widgets.NewParagraph()
+	er.header.Title = "Block explorer"
+	er.header.Text = viewsKeysHelp + "\n" + explorerKeysHelp
+
+	er.list = widgets.NewList()
+	er.list.TextStyle = ui.NewStyle(ui.ColorWhite)
+	er.list.SelectedRowStyle = ui.NewStyle(ui.ColorBlack, ui.ColorYellow)
+
+	er.details = widgets.NewList()
+	er.details.Title = "Details"
+	er.details.TextStyle = ui.NewStyle(ui.ColorWhite)
+	er.details.WrapText = true
+}
+
+func (er *ExplorerRender) setGrid() {
+	grid := ui.NewGrid()
+	grid.Set(
+		ui.NewRow(1.0/6, er.header),
+		ui.NewRow(5.0/6,
+			ui.NewCol(1.0/2, er.list),
+			ui.NewCol(1.0/2, er.details),
+		),
+	)
+
+	er.container.SetDrawable(grid)
+}
+
+// RefreshData method is used to prepare data that are displayed on container
+func (er *ExplorerRender) RefreshData(_ int) {
+	er.navigator.setBlocks(er.dataHandler.GetRecentBlocks())
+	er.prepareWidgets()
+}
+
+// HandleKey will process the navigation keys. Returns true if the key was handled
+func (er *ExplorerRender) HandleKey(key string) bool {
+	handled := true
+	switch key {
+	case keyUp, keyVimUp:
+		er.navigator.moveBy(-1)
+	case keyDown, keyVimDown:
+		er.navigator.moveBy(1)
+	case keyPageUp:
+		er.navigator.moveBy(-pageSize)
+	case keyPageDown:
+		er.navigator.moveBy(pageSize)
+	case keyHome:
+		er.navigator.moveToTop()
+	case keyEnd:
+		er.navigator.moveToBottom()
+	case keyEnter:
+		handled = er.navigator.enter()
+	case keyEscape, keyBackspace:
+		handled = er.navigator.back()
+	default:
+		handled = false
+	}
+
+	if handled {
+		er.prepareWidgets()
+	}
+
+	return handled
+}
+
+func (er *ExplorerRender) prepareWidgets() {
+	er.list.Title = er.navigator.title()
+	er.list.Rows = er.navigator.rows()
+	er.list.SelectedRow = er.navigator.selectedRow
+
+	er.details.Rows = er.navigator.details()
+	er.details.SelectedRow = 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (er *ExplorerRender) IsInterfaceNil() bool {
+	return er == nil
+}
@@ -0,0 +1,36 @@
+package termuiRenders
+
+import (
+	"github.com/gizak/termui/v3"
+)
+
+// FullScreenContainer defines a container holding a single drawable object which uses the whole terminal
+type FullScreenContainer struct {
+	drawable termui.Drawable
+}
+
+// NewFullScreenContainer method is used to return a new FullScreenContainer structure
+func NewFullScreenContainer() *FullScreenContainer {
+	return &FullScreenContainer{}
+}
+
+// SetDrawable sets the contained drawable
+func (fsc *FullScreenContainer) SetDrawable(drawable termui.Drawable) {
+	fsc.drawable = drawable
+}
+
+// Items returns the containing items list
+func (fsc *FullScreenContainer) Items() []termui.Drawable {
+	if fsc.drawable == nil {
+		return make([]termui.Drawable, 0)
+	}
+
+	return []termui.Drawable{fsc.drawable}
+}
+
+// SetRectangle sets the rectangle of this drawable object
+func (fsc *FullScreenContainer) SetRectangle(startWidth, startHeight, termWidth, termHeight int) {
+	if fsc.drawable != nil {
+		fsc.drawable.SetRect(startWidth, startHeight, termWidth, termHeight)
+	}
+}
@@ -0,0 +1,20 @@
+package termuiRenders
+
+// the termui identifiers of the keys used for navigating inside the views
+const (
+	keyUp        = "<Up>"
+	keyDown      = "<Down>"
+	keyPageUp    = "<PageUp>"
+	keyPageDown  = "<PageDown>"
+	keyHome      = "<Home>"
+	keyEnd       = "<End>"
+	keyEnter     = "<Enter>"
+	keyEscape    = "<Escape>"
+	keyBackspace = "<Backspace>"
+	keyVimUp     = "k"
+	keyVimDown   = "j"
+)
+
+const pageSize = 10
+
+const viewsKeysHelp = "1: status  2: blocks  3: peers  4: nodes  Tab: next view  Ctrl+C: quit"
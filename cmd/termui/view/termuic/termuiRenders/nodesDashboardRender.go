@@ -0,0 +1,183 @@
+package termuiRenders
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/view"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+type nodeState int
+
+const (
+	nodeStateOk nodeState = iota
+	nodeStateSyncing
+	nodeStateLagging
+	nodeStateUnreachable
+)
+
+var nodesTableHeader = []string{"Address", "Name", "Shard", "Type", "Nonce", "Behind", "Sync state", "Epoch", "Peers", "Signed/Consensus", "Proposed/Leader"}
+
+// NodesDashboardRender will define the termui widgets used to display a compact overview of several nodes
+type NodesDashboardRender struct {
+	container    *FullScreenContainer
+	header       *widgets.Paragraph
+	table        *scrollableTable
+	nodesHandler view.MonitoredNodesHandler
+	lagThreshold uint64
+}
+
+// NewNodesDashboardRender method will create a new NodesDashboardRender. A node is highlighted as lagging if its nonce
+// is behind the majority of the reachable nodes from the same shard by more than lagThreshold
+func NewNodesDashboardRender(
+	nodesHandler view.MonitoredNodesHandler,
+	container *FullScreenContainer,
+	lagThreshold uint64,
+) (*NodesDashboardRender, error) {
+	if nodesHandler == nil || nodesHandler.IsInterfaceNil() {
+		return nil, view.ErrNilMonitoredNodesHandler
+	}
+	if container == nil {
+		return nil, view.ErrNilGrid
+	}
+	if lagThreshold == 0 {
+		return nil, view.ErrInvalidLagThreshold
+	}
+
+	ndr := &NodesDashboardRender{
+		container:    container,
+		nodesHandler: nodesHandler,
+		lagThreshold: lagThreshold,
+	}
+	ndr.initWidgets()
+	ndr.setGrid()
+
+	return ndr, nil
+}
+
+func (ndr *NodesDashboardRender) initWidgets() {
+	ndr.header = widgets.NewParagraph()
+	ndr.header.Title = "Nodes dashboard"
+
+	ndr.table = newScrollableTable("Nodes", nodesTableHeader)
+}
+
+func (ndr *NodesDashboardRender) setGrid() {
+	grid := ui.NewGrid()
+	grid.Set(
+		ui.NewRow(1.0/6, ndr.header),
+		ui.NewRow(5.0/6, ndr.table.table),
+	)
+
+	ndr.container.SetDrawable(grid)
+}
+
+// RefreshData method is used to prepare data that are displayed on container
+func (ndr *NodesDashboardRender) RefreshData(_ int) {
+	nodes := ndr.nodesHandler.GetMonitoredNodes()
+	majorityNonces := computeMajorityNonces(nodes)
+
+	numPerState := make(map[nodeState]int)
+	rows := make([][]string, 0, len(nodes))
+	rowStyles := make(map[int]ui.Style)
+	for i, node := range nodes {
+		state, behind := ndr.computeNodeState(node, majorityNonces)
+		numPerState[state]++
+
+		rows = append(rows, prepareNodeRow(node, behind))
+		switch state {
+		case nodeStateUnreachable, nodeStateLagging:
+			rowStyles[i] = ui.NewStyle(ui.ColorRed)
+		case nodeStateSyncing:
+			rowStyles[i] = ui.NewStyle(ui.ColorYellow)
+		}
+	}
+
+	summary := fmt.Sprintf("Nodes: %d  lagging: %d  syncing: %d  unreachable: %d  (lagging means more than %d blocks behind the shard's majority)",
+		len(nodes), numPerState[nodeStateLagging], numPerState[nodeStateSyncing], numPerState[nodeStateUnreachable], ndr.lagThreshold)
+	ndr.header.Text = viewsKeysHelp + "\n" + scrollableTableKeysHelp + "\n" + summary
+	ndr.table.setRows(rows, rowStyles)
+}
+
+func (ndr *NodesDashboardRender) computeNodeState(node view.MonitoredNode, majorityNonces map[uint64]uint64) (nodeState, uint64) {
+	if !node.IsReachable {
+		return nodeStateUnreachable, 0
+	}
+
+	behind := uint64(0)
+	nonce := node.Presenter.GetNonce()
+	majorityNonce := majorityNonces[node.Presenter.GetShardId()]
+	if majorityNonce > nonce {
+		behind = majorityNonce - nonce
+	}
+
+	if behind > ndr.lagThreshold {
+		return nodeStateLagging, behind
+	}
+	if node.Presenter.GetIsSyncing() == 1 {
+		return nodeStateSyncing, behind
+	}
+
+	return nodeStateOk, behind
+}
+
+// HandleKey will process the scrolling keys. Returns true if the key was handled
+func (ndr *NodesDashboardRender) HandleKey(key string) bool {
+	return ndr.table.handleKey(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ndr *NodesDashboardRender) IsInterfaceNil() bool {
+	return ndr == nil
+}
+
+// computeMajorityNonces returns, for each shard, the highest nonce reached by at least half of the reachable nodes
+func computeMajorityNonces(nodes []view.MonitoredNode) map[uint64]uint64 {
+	noncesPerShard := make(map[uint64][]uint64)
+	for _, node := range nodes {
+		if !node.IsReachable {
+			continue
+		}
+
+		shardID := node.Presenter.GetShardId()
+		noncesPerShard[shardID] = append(noncesPerShard[shardID], node.Presenter.GetNonce())
+	}
+
+	majorityNonces := make(map[uint64]uint64, len(noncesPerShard))
+	for shardID, nonces := range noncesPerShard {
+		sort.Slice(nonces, func(i, j int) bool {
+			return nonces[i] > nonces[j]
+		})
+		majorityNonces[shardID] = nonces[(len(nonces)-1)/2]
+	}
+
+	return majorityNonces
+}
+
+func prepareNodeRow(node view.MonitoredNode, behind uint64) []string {
+	if !node.IsReachable {
+		return []string{node.Address, statusNotApplicable, "", "", "", "", "unreachable", "", "", "", ""}
+	}
+
+	presenter := node.Presenter
+	syncState := statusSynchronized
+	if presenter.GetIsSyncing() == 1 {
+		syncState = statusSyncing
+	}
+
+	return []string{
+		node.Address,
+		presenter.GetNodeName(),
+		shardIDToString(uint32(presenter.GetShardId())),
+		presenter.GetPeerType(),
+		fmt.Sprintf("%d", presenter.GetNonce()),
+		fmt.Sprintf("%d", behind),
+		syncState,
+		fmt.Sprintf("%d", presenter.GetEpochNumber()),
+		fmt.Sprintf("%d", presenter.GetNumConnectedPeers()),
+		fmt.Sprintf("%d/%d", presenter.GetCountConsensusAcceptedBlocks(), presenter.GetCountConsensus()),
+		fmt.Sprintf("%d/%d", presenter.GetCountAcceptedBlocks(), presenter.GetCountLeader()),
+	}
+}
@@ -0,0 +1,130 @@
+package termuiRenders
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/presenter"
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/view"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type monitoredNodesHandlerStub struct {
+	nodes []view.MonitoredNode
+}
+
+func (mnhs *monitoredNodesHandlerStub) GetMonitoredNodes() []view.MonitoredNode {
+	return mnhs.nodes
+}
+
+func (mnhs *monitoredNodesHandlerStub) IsInterfaceNil() bool {
+	return mnhs == nil
+}
+
+func createMonitoredNode(address string, shardID uint64, nonce uint64, isSyncing bool, isReachable bool) view.MonitoredNode {
+	psh := presenter.NewPresenterStatusHandler()
+	psh.SetUInt64Value(common.MetricShardId, shardID)
+	psh.SetUInt64Value(common.MetricNonce, nonce)
+	if isSyncing {
+		psh.SetUInt64Value(common.MetricIsSyncing, 1)
+	}
+
+	return view.MonitoredNode{
+		Address:     address,
+		IsReachable: isReachable,
+		Presenter:   psh,
+	}
+}
+
+func TestNewNodesDashboardRender(t *testing.T) {
+	t.Parallel()
+
+	ndr, err := NewNodesDashboardRender(nil, NewFullScreenContainer(), 5)
+	assert.Nil(t, ndr)
+	assert.Equal(t, view.ErrNilMonitoredNodesHandler, err)
+
+	ndr, err = NewNodesDashboardRender(&monitoredNodesHandlerStub{}, nil, 5)
+	assert.Nil(t, ndr)
+	assert.Equal(t, view.ErrNilGrid, err)
+
+	ndr, err = NewNodesDashboardRender(&monitoredNodesHandlerStub{}, NewFullScreenContainer(), 0)
+	assert.Nil(t, ndr)
+	assert.Equal(t, view.ErrInvalidLagThreshold, err)
+
+	ndr, err = NewNodesDashboardRender(&monitoredNodesHandlerStub{}, NewFullScreenContainer(), 5)
+	assert.Nil(t, err)
+	assert.False(t, ndr.IsInterfaceNil())
+}
+
+func TestComputeMajorityNonces(t *testing.T) {
+	t.Parallel()
+
+	nodes := []view.MonitoredNode{
+		createMonitoredNode("a", 0, 100, false, true),
+		createMonitoredNode("b", 0, 100, false, true),
+		createMonitoredNode("c", 0, 80, false, true),
+		createMonitoredNode("d", 0, 500, false, false),
+		createMonitoredNode("e", 1, 90, false, true),
+		createMonitoredNode("f", 1, 91, false, true),
+		createMonitoredNode("g", 1, 20, false, true),
+		createMonitoredNode("h", uint64(core.MetachainShardId), 70, false, true),
+	}
+
+	majorityNonces := computeMajorityNonces(nodes)
+	assert.Equal(t, map[uint64]uint64{
+		0:                             100,
+		1:                             90,
+		uint64(core.MetachainShardId): 70,
+	}, majorityNonces)
+}
+
+func TestNodesDashboardRender_ComputeNodeState(t *testing.T) {
+	t.Parallel()
+
+	ndr, _ := NewNodesDashboardRender(&monitoredNodesHandlerStub{}, NewFullScreenContainer(), 5)
+	majorityNonces := map[uint64]uint64{0: 100}
+
+	state, behind := ndr.computeNodeState(createMonitoredNode("a", 0, 100, false, true), majorityNonces)
+	assert.Equal(t, nodeStateOk, state)
+	assert.Equal(t, uint64(0), behind)
+
+	state, behind = ndr.computeNodeState(createMonitoredNode("b", 0, 95, false, true), majorityNonces)
+	assert.Equal(t, nodeStateOk, state)
+	assert.Equal(t, uint64(5), behind)
+
+	state, _ = ndr.computeNodeState(createMonitoredNode("c", 0, 99, true, true), majorityNonces)
+	assert.Equal(t, nodeStateSyncing, state)
+
+	state, behind = ndr.computeNodeState(createMonitoredNode("d", 0, 94, true, true), majorityNonces)
+	assert.Equal(t, nodeStateLagging, state)
+	assert.Equal(t, uint64(6), behind)
+
+	state, _ = ndr.computeNodeState(createMonitoredNode("e", 0, 100, false, false), majorityNonces)
+	assert.Equal(t, nodeStateUnreachable, state)
+}
+
+func TestNodesDashboardRender_RefreshData(t *testing.T) {
+	t.Parallel()
+
+	stub := &monitoredNodesHandlerStub{
+		nodes: []view.MonitoredNode{
+			createMonitoredNode("a", 0, 100, false, true),
+			createMonitoredNode("b", 0, 100, false, true),
+			createMonitoredNode("c", 0, 50, true, true),
+			createMonitoredNode("d", 0, 0, false, false),
+		},
+	}
+	ndr, _ := NewNodesDashboardRender(stub, NewFullScreenContainer(), 5)
+	ndr.RefreshData(0)
+
+	require.Equal(t, 4, len(ndr.table.rows))
+	assert.Equal(t, "50", ndr.table.rows[2][5])
+	assert.Equal(t, "unreachable", ndr.table.rows[3][6])
+	_, isHighlighted := ndr.table.rowStyles[0]
+	assert.False(t, isHighlighted)
+	_, isHighlighted = ndr.table.rowStyles[2]
+	assert.True(t, isHighlighted)
+	assert.Contains(t, ndr.header.Text, "lagging: 1  syncing: 0  unreachable: 1")
+}
@@ -0,0 +1,159 @@
+package termuiRenders
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/termui/view"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+// peersActiveColumn is the index of the "Active" column in the peers table
+const peersActiveColumn = 4
+
+var peersTableHeader = []string{"Name", "Public key", "Shard", "Type", "Active", "Nonce", "Instances", "Version", "Up time"}
+
+// PeersRender will define the termui widgets used to display the heartbeat information of the network's peers
+type PeersRender struct {
+	container   *FullScreenContainer
+	header      *widgets.Paragraph
+	table       *scrollableTable
+	dataHandler view.ExplorerDataHandler
+}
+
+// NewPeersRender method will create a new PeersRender
+func NewPeersRender(dataHandler view.ExplorerDataHandler, container *FullScreenContainer) (*PeersRender, error) {
+	if dataHandler == nil || dataHandler.IsInterfaceNil() {
+		return nil, view.ErrNilExplorerDataHandler
+	}
+	if container == nil {
+		return nil, view.ErrNilGrid
+	}
+
+	pr := &PeersRender{
+		container:   container,
+		dataHandler: dataHandler,
+	}
+	pr.initWidgets()
+	pr.setGrid()
+
+	return pr, nil
+}
+
+func (pr *PeersRender) initWidgets() {
+	pr.header = widgets.NewParagraph()
+	pr.header.Title = "Peers"
+
+	pr.table = newScrollableTable("Heartbeats", peersTableHeader)
+}
+
+func (pr *PeersRender) setGrid() {
+	grid := ui.NewGrid()
+	grid.Set(
+		ui.NewRow(1.0/6, pr.header),
+		ui.NewRow(5.0/6, pr.table.table),
+	)
+
+	pr.container.SetDrawable(grid)
+}
+
+// RefreshData method is used to prepare data that are displayed on container
+func (pr *PeersRender) RefreshData(_ int) {
+	heartbeats := pr.dataHandler.GetHeartbeats()
+
+	rows := preparePeersRows(heartbeats)
+	rowStyles := make(map[int]ui.Style)
+	for i, row := range rows {
+		if row[peersActiveColumn] != boolToYesNo(true) {
+			rowStyles[i] = ui.NewStyle(ui.ColorRed)
+		}
+	}
+
+	pr.header.Text = viewsKeysHelp + "\n" + scrollableTableKeysHelp + "\n" + summarizePeers(heartbeats)
+	pr.table.setRows(rows, rowStyles)
+}
+
+// HandleKey will process the scrolling keys. Returns true if the key was handled
+func (pr *PeersRender) HandleKey(key string) bool {
+	return pr.table.handleKey(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *PeersRender) IsInterfaceNil() bool {
+	return pr == nil
+}
+
+// preparePeersRows returns the table rows of the provided heartbeats, sorted by shard and by node name
+func preparePeersRows(heartbeats []heartbeatData.PubKeyHeartbeat) [][]string {
+	sorted := make([]heartbeatData.PubKeyHeartbeat, len(heartbeats))
+	copy(sorted, heartbeats)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].ComputedShardID != sorted[j].ComputedShardID {
+			return sorted[i].ComputedShardID < sorted[j].ComputedShardID
+		}
+		if sorted[i].NodeDisplayName != sorted[j].NodeDisplayName {
+			return sorted[i].NodeDisplayName < sorted[j].NodeDisplayName
+		}
+		return sorted[i].PublicKey < sorted[j].PublicKey
+	})
+
+	rows := make([][]string, 0, len(sorted))
+	for _, hb := range sorted {
+		rows = append(rows, []string{
+			hb.NodeDisplayName,
+			shortHash(hb.PublicKey),
+			shardIDToString(hb.ComputedShardID),
+			hb.PeerType,
+			boolToYesNo(hb.IsActive),
+			fmt.Sprintf("%d", hb.Nonce),
+			fmt.Sprintf("%d", hb.NumInstances),
+			hb.VersionNumber,
+			(time.Duration(hb.TotalUpTime) * time.Second).String(),
+		})
+	}
+
+	return rows
+}
+
+// summarizePeers returns a line with the number of active peers out of the total, for each shard
+func summarizePeers(heartbeats []heartbeatData.PubKeyHeartbeat) string {
+	numActive := 0
+	numActivePerShard := make(map[uint32]int)
+	numTotalPerShard := make(map[uint32]int)
+	for _, hb := range heartbeats {
+		numTotalPerShard[hb.ComputedShardID]++
+		if hb.IsActive {
+			numActive++
+			numActivePerShard[hb.ComputedShardID]++
+		}
+	}
+
+	shardIDs := make([]uint32, 0, len(numTotalPerShard))
+	for shardID := range numTotalPerShard {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	parts := make([]string, 0, len(shardIDs)+1)
+	parts = append(parts, fmt.Sprintf("Active: %d/%d", numActive, len(heartbeats)))
+	for _, shardID := range shardIDs {
+		parts = append(parts, fmt.Sprintf("shard %s: %d/%d",
+			shardIDToString(shardID), numActivePerShard[shardID], numTotalPerShard[shardID]))
+	}
+
+	return strings.Join(parts, "  ")
+}
+
+func boolToYesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
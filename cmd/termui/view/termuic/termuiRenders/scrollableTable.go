@@ -0,0 +1,109 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+const scrollableTableKeysHelp = "Up/Down, PageUp/PageDown, Home/End: scroll"
+
+// scrollableTable wraps a table widget which displays only the rows fitting its height, keeping a fixed header
+type scrollableTable struct {
+	table     *widgets.Table
+	title     string
+	header    []string
+	rows      [][]string
+	rowStyles map[int]ui.Style
+	firstRow  int
+}
+
+func newScrollableTable(title string, header []string) *scrollableTable {
+	st := &scrollableTable{
+		table:     widgets.NewTable(),
+		title:     title,
+		header:    header,
+		rows:      make([][]string, 0),
+		rowStyles: make(map[int]ui.Style),
+	}
+	st.table.Title = title
+	st.table.RowSeparator = false
+	st.table.TextStyle = ui.NewStyle(ui.ColorWhite)
+	st.table.Rows = [][]string{header}
+
+	return st
+}
+
+// setRows sets the table rows along with the styles of the rows, indexed by their position in the provided rows
+func (st *scrollableTable) setRows(rows [][]string, rowStyles map[int]ui.Style) {
+	st.rows = rows
+	st.rowStyles = rowStyles
+	st.prepare()
+}
+
+func (st *scrollableTable) handleKey(key string) bool {
+	switch key {
+	case keyUp, keyVimUp:
+		st.firstRow--
+	case keyDown, keyVimDown:
+		st.firstRow++
+	case keyPageUp:
+		st.firstRow -= st.numVisibleRows()
+	case keyPageDown:
+		st.firstRow += st.numVisibleRows()
+	case keyHome:
+		st.firstRow = 0
+	case keyEnd:
+		st.firstRow = len(st.rows)
+	default:
+		return false
+	}
+
+	st.prepare()
+
+	return true
+}
+
+func (st *scrollableTable) numVisibleRows() int {
+	// one line is used by the table header
+	numVisible := st.table.Inner.Dy() - 1
+	if numVisible < 1 {
+		return 1
+	}
+
+	return numVisible
+}
+
+func (st *scrollableTable) prepare() {
+	numVisible := st.numVisibleRows()
+	maxFirstRow := len(st.rows) - numVisible
+	if st.firstRow > maxFirstRow {
+		st.firstRow = maxFirstRow
+	}
+	if st.firstRow < 0 {
+		st.firstRow = 0
+	}
+
+	lastRow := st.firstRow + numVisible
+	if lastRow > len(st.rows) {
+		lastRow = len(st.rows)
+	}
+
+	rows := make([][]string, 0, lastRow-st.firstRow+1)
+	rows = append(rows, st.header)
+	rows = append(rows, st.rows[st.firstRow:lastRow]...)
+
+	st.table.Rows = rows
+	st.table.RowStyles = map[int]ui.Style{0: ui.NewStyle(ui.ColorYellow)}
+	for i := st.firstRow; i < lastRow; i++ {
+		style, found := st.rowStyles[i]
+		if found {
+			st.table.RowStyles[i-st.firstRow+1] = style
+		}
+	}
+	st.table.Title = fmt.Sprintf("%s (%d-%d of %d)", st.title, st.firstRow+1, lastRow, len(st.rows))
+	if len(st.rows) == 0 {
+		st.table.Title = fmt.Sprintf("%s (0)", st.title)
+	}
+}
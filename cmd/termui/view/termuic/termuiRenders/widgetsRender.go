@@ -318,7 +318,7 @@ func (wr *WidgetsRender) prepareBlockInfo() {
 }
 
 func (wr *WidgetsRender) prepareListWithLogsForDisplay() {
-	wr.lLog.Title = "Log info"
+	wr.lLog.Title = "Log info (" + viewsKeysHelp + ")"
 	wr.lLog.TextStyle = ui.NewStyle(ui.ColorWhite)
 
 	logData := wr.presenter.GetLogLines()
@@ -0,0 +1,24 @@
+package inspection
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilTrieWalker signals that a nil trie walker has been provided
+var ErrNilTrieWalker = errors.New("nil trie walker")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrNoPersisterProvided signals that no persister has been provided
+var ErrNoPersisterProvided = errors.New("no persister provided")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrReadOnlyDB signals that a write operation has been attempted on a read only database
+var ErrReadOnlyDB = errors.New("read only database")
+
+// ErrKeyNotFound signals that the key was not found in any of the persisters
+var ErrKeyNotFound = errors.New("key not found")
@@ -0,0 +1,72 @@
+package inspection
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// multiPersisterReader exposes a set of persisters as a single read only database. The trie nodes of a node are
+// spread over the persisters of all the epochs, so a node is searched in each of them, in the provided order
+type multiPersisterReader struct {
+	persisters []storage.Persister
+}
+
+// NewMultiPersisterReader creates a read only database on top of the provided persisters. The persisters should be
+// provided starting with the most recent epoch, as that is the one most likely to hold the searched nodes
+func NewMultiPersisterReader(persisters []storage.Persister) (*multiPersisterReader, error) {
+	if len(persisters) == 0 {
+		return nil, ErrNoPersisterProvided
+	}
+	for index, persister := range persisters {
+		if check.IfNil(persister) {
+			return nil, fmt.Errorf("%w at index %d", ErrNilPersister, index)
+		}
+	}
+
+	return &multiPersisterReader{
+		persisters: persisters,
+	}, nil
+}
+
+// Get returns the value from the first persister holding the provided key
+func (mpr *multiPersisterReader) Get(key []byte) ([]byte, error) {
+	for _, persister := range mpr.persisters {
+		val, err := persister.Get(key)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, hex.EncodeToString(key))
+}
+
+// Put returns error as the database is read only
+func (mpr *multiPersisterReader) Put(_, _ []byte) error {
+	return ErrReadOnlyDB
+}
+
+// Remove returns error as the database is read only
+func (mpr *multiPersisterReader) Remove(_ []byte) error {
+	return ErrReadOnlyDB
+}
+
+// Close closes all the underlying persisters, returning the last encountered error
+func (mpr *multiPersisterReader) Close() error {
+	var lastErr error
+	for _, persister := range mpr.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mpr *multiPersisterReader) IsInterfaceNil() bool {
+	return mpr == nil
+}
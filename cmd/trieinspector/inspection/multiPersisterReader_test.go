@@ -0,0 +1,95 @@
+package inspection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultiPersisterReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no persister should error", func(t *testing.T) {
+		mpr, err := NewMultiPersisterReader(nil)
+		assert.True(t, check.IfNil(mpr))
+		assert.Equal(t, ErrNoPersisterProvided, err)
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		mpr, err := NewMultiPersisterReader([]storage.Persister{testscommon.NewMemDbMock(), nil})
+		assert.True(t, check.IfNil(mpr))
+		assert.True(t, errors.Is(err, ErrNilPersister))
+	})
+	t.Run("should work", func(t *testing.T) {
+		mpr, err := NewMultiPersisterReader([]storage.Persister{testscommon.NewMemDbMock()})
+		assert.False(t, check.IfNil(mpr))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMultiPersisterReader_Get(t *testing.T) {
+	t.Parallel()
+
+	newest := testscommon.NewMemDbMock()
+	_ = newest.Put([]byte("key1"), []byte("newest value"))
+	oldest := testscommon.NewMemDbMock()
+	_ = oldest.Put([]byte("key1"), []byte("oldest value"))
+	_ = oldest.Put([]byte("key2"), []byte("value2"))
+
+	mpr, _ := NewMultiPersisterReader([]storage.Persister{newest, oldest})
+
+	val, err := mpr.Get([]byte("key1"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("newest value"), val)
+
+	val, err = mpr.Get([]byte("key2"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value2"), val)
+
+	val, err = mpr.Get([]byte("key3"))
+	assert.Nil(t, val)
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+}
+
+func TestMultiPersisterReader_IsReadOnly(t *testing.T) {
+	t.Parallel()
+
+	db := testscommon.NewMemDbMock()
+	mpr, _ := NewMultiPersisterReader([]storage.Persister{db})
+
+	assert.Equal(t, ErrReadOnlyDB, mpr.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, ErrReadOnlyDB, mpr.Remove([]byte("key")))
+	_, err := db.Get([]byte("key"))
+	assert.NotNil(t, err)
+}
+
+func TestMultiPersisterReader_CloseShouldCloseAllPersisters(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numClosed := 0
+	persisters := []storage.Persister{
+		&mock.PersisterStub{
+			CloseCalled: func() error {
+				numClosed++
+				return expectedErr
+			},
+		},
+		&mock.PersisterStub{
+			CloseCalled: func() error {
+				numClosed++
+				return nil
+			},
+		},
+	}
+	mpr, _ := NewMultiPersisterReader(persisters)
+
+	err := mpr.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numClosed)
+}
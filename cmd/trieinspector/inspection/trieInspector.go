@@ -0,0 +1,202 @@
+package inspection
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+)
+
+var log = logger.GetOrCreate("trieinspector/inspection")
+
+type trieWalker interface {
+	Walk(rootHash []byte, handler common.TrieStatisticsHandler) error
+	IsInterfaceNil() bool
+}
+
+// ArgsTrieInspector is the DTO used to create a new instance of the trie inspector
+type ArgsTrieInspector struct {
+	Walker              trieWalker
+	Marshalizer         marshal.Marshalizer
+	InspectDataTries    bool
+	NumLargestDataTries int
+}
+
+// DataTrieStats holds the statistics of the data trie of one account
+type DataTrieStats struct {
+	Address  []byte
+	RootHash []byte
+	Stats    *statistics.TrieStatsDTO
+}
+
+// InspectionResult holds the outcome of a trie inspection
+type InspectionResult struct {
+	MainTrie         *statistics.TrieStatsDTO
+	NumDataTries     int
+	DataTries        *statistics.TrieStatsDTO
+	LargestDataTries []DataTrieStats
+}
+
+type trieInspector struct {
+	walker              trieWalker
+	marshalizer         marshal.Marshalizer
+	inspectDataTries    bool
+	numLargestDataTries int
+}
+
+// NewTrieInspector creates a new instance able to compute the statistics of an accounts trie and of the
+// data tries of its accounts
+func NewTrieInspector(args ArgsTrieInspector) (*trieInspector, error) {
+	if check.IfNil(args.Walker) {
+		return nil, ErrNilTrieWalker
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &trieInspector{
+		walker:              args.Walker,
+		marshalizer:         args.Marshalizer,
+		inspectDataTries:    args.InspectDataTries,
+		numLargestDataTries: args.NumLargestDataTries,
+	}, nil
+}
+
+// Inspect walks the trie with the provided root hash and, if enabled, the data tries of all the accounts found in it.
+// Missing nodes do not stop the inspection, they are reported in the result
+func (ti *trieInspector) Inspect(rootHash []byte) (*InspectionResult, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	mainTrieStats := statistics.NewTrieStatistics()
+	collector := &accountsCollector{
+		TrieStatisticsHandler: mainTrieStats,
+		marshalizer:           ti.marshalizer,
+		accounts:              make([]DataTrieStats, 0),
+	}
+
+	var handler common.TrieStatisticsHandler = mainTrieStats
+	if ti.inspectDataTries {
+		handler = collector
+	}
+
+	log.Info("walking the main trie", "root hash", rootHash)
+	err := ti.walker.Walk(rootHash, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &InspectionResult{
+		MainTrie:         mainTrieStats.GetTrieStats(),
+		NumDataTries:     len(collector.accounts),
+		LargestDataTries: make([]DataTrieStats, 0),
+	}
+
+	dataTriesStats := statistics.NewTrieStatistics()
+	for index, account := range collector.accounts {
+		if index%1000 == 0 {
+			log.Info("walking the data tries", "progress", index, "total", len(collector.accounts))
+		}
+
+		accountStats := statistics.NewTrieStatistics()
+		err = ti.walker.Walk(account.RootHash, &statisticsHandlers{accountStats, dataTriesStats})
+		if err != nil {
+			return nil, err
+		}
+
+		account.Stats = accountStats.GetTrieStats()
+		result.LargestDataTries = ti.keepLargest(result.LargestDataTries, account)
+	}
+	result.DataTries = dataTriesStats.GetTrieStats()
+
+	return result, nil
+}
+
+func (ti *trieInspector) keepLargest(largest []DataTrieStats, dataTrie DataTrieStats) []DataTrieStats {
+	if ti.numLargestDataTries <= 0 {
+		return largest
+	}
+
+	largest = append(largest, dataTrie)
+	sort.SliceStable(largest, func(i, j int) bool {
+		return largest[i].Stats.TotalSizeInBytes > largest[j].Stats.TotalSizeInBytes
+	})
+	if len(largest) > ti.numLargestDataTries {
+		largest = largest[:ti.numLargestDataTries]
+	}
+
+	return largest
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *trieInspector) IsInterfaceNil() bool {
+	return ti == nil
+}
+
+// accountsCollector decodes the leaves of the main trie as accounts and keeps the ones having a data trie
+type accountsCollector struct {
+	common.TrieStatisticsHandler
+	marshalizer marshal.Marshalizer
+	accounts    []DataTrieStats
+}
+
+// AddLeafNode accounts the leaf node and records the data trie root hash of the account it holds
+func (ac *accountsCollector) AddLeafNode(level int, size uint64, key []byte, value []byte) {
+	ac.TrieStatisticsHandler.AddLeafNode(level, size, key, value)
+
+	account := &state.UserAccountData{}
+	err := ac.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		log.Trace("leaf is not an user account", "key", key, "error", err)
+		return
+	}
+	if len(account.RootHash) == 0 {
+		return
+	}
+
+	ac.accounts = append(ac.accounts, DataTrieStats{
+		Address:  key,
+		RootHash: account.RootHash,
+	})
+}
+
+// statisticsHandlers forwards every node to all the contained handlers
+type statisticsHandlers []common.TrieStatisticsHandler
+
+// AddBranchNode forwards the branch node to all the handlers
+func (sh statisticsHandlers) AddBranchNode(level int, size uint64) {
+	for _, handler := range sh {
+		handler.AddBranchNode(level, size)
+	}
+}
+
+// AddExtensionNode forwards the extension node to all the handlers
+func (sh statisticsHandlers) AddExtensionNode(level int, size uint64) {
+	for _, handler := range sh {
+		handler.AddExtensionNode(level, size)
+	}
+}
+
+// AddLeafNode forwards the leaf node to all the handlers
+func (sh statisticsHandlers) AddLeafNode(level int, size uint64, key []byte, value []byte) {
+	for _, handler := range sh {
+		handler.AddLeafNode(level, size, key, value)
+	}
+}
+
+// AddMissingNode forwards the missing node to all the handlers
+func (sh statisticsHandlers) AddMissingNode(level int, hash []byte) {
+	for _, handler := range sh {
+		handler.AddMissingNode(level, hash)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *statisticsHandlers) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,159 @@
+package inspection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trieWalkerStub struct {
+	WalkCalled func(rootHash []byte, handler common.TrieStatisticsHandler) error
+}
+
+func (tws *trieWalkerStub) Walk(rootHash []byte, handler common.TrieStatisticsHandler) error {
+	if tws.WalkCalled != nil {
+		return tws.WalkCalled(rootHash, handler)
+	}
+
+	return nil
+}
+
+func (tws *trieWalkerStub) IsInterfaceNil() bool {
+	return tws == nil
+}
+
+func createMockArgsTrieInspector() ArgsTrieInspector {
+	return ArgsTrieInspector{
+		Walker:              &trieWalkerStub{},
+		Marshalizer:         &testscommon.ProtobufMarshalizerMock{},
+		InspectDataTries:    true,
+		NumLargestDataTries: 2,
+	}
+}
+
+func TestNewTrieInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil walker should error", func(t *testing.T) {
+		args := createMockArgsTrieInspector()
+		args.Walker = nil
+		ti, err := NewTrieInspector(args)
+		assert.True(t, check.IfNil(ti))
+		assert.Equal(t, ErrNilTrieWalker, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsTrieInspector()
+		args.Marshalizer = nil
+		ti, err := NewTrieInspector(args)
+		assert.True(t, check.IfNil(ti))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ti, err := NewTrieInspector(createMockArgsTrieInspector())
+		assert.False(t, check.IfNil(ti))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTrieInspector_InspectEmptyRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ti, _ := NewTrieInspector(createMockArgsTrieInspector())
+
+	result, err := ti.Inspect(nil)
+	assert.Nil(t, result)
+	assert.Equal(t, ErrEmptyRootHash, err)
+}
+
+func TestTrieInspector_InspectWalkErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsTrieInspector()
+	args.Walker = &trieWalkerStub{
+		WalkCalled: func(_ []byte, _ common.TrieStatisticsHandler) error {
+			return expectedErr
+		},
+	}
+	ti, _ := NewTrieInspector(args)
+
+	result, err := ti.Inspect([]byte("root hash"))
+	assert.Nil(t, result)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTrieInspector_Inspect(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtobufMarshalizerMock{}
+	mainRootHash := []byte("main root hash")
+	accountWithoutDataTrie, _ := marshalizer.Marshal(&state.UserAccountData{})
+	dataTrieSizes := map[string]uint64{
+		"small data trie":  10,
+		"large data trie":  1000,
+		"medium data trie": 100,
+	}
+	walker := &trieWalkerStub{
+		WalkCalled: func(rootHash []byte, handler common.TrieStatisticsHandler) error {
+			if string(rootHash) != string(mainRootHash) {
+				handler.AddLeafNode(1, dataTrieSizes[string(rootHash)], []byte("key"), []byte("value"))
+				return nil
+			}
+
+			handler.AddBranchNode(1, 100)
+			handler.AddLeafNode(2, 50, []byte("address 0"), accountWithoutDataTrie)
+			handler.AddLeafNode(2, 50, []byte("address 1"), []byte("not an account"))
+			for dataTrieRootHash := range dataTrieSizes {
+				account, _ := marshalizer.Marshal(&state.UserAccountData{RootHash: []byte(dataTrieRootHash)})
+				handler.AddLeafNode(2, 50, []byte("address of "+dataTrieRootHash), account)
+			}
+			handler.AddMissingNode(2, []byte("missing"))
+
+			return nil
+		},
+	}
+
+	t.Run("with data tries", func(t *testing.T) {
+		args := createMockArgsTrieInspector()
+		args.Walker = walker
+		ti, _ := NewTrieInspector(args)
+
+		result, err := ti.Inspect(mainRootHash)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(1), result.MainTrie.NumBranches)
+		assert.Equal(t, uint64(5), result.MainTrie.NumLeaves)
+		assert.Equal(t, uint64(350), result.MainTrie.TotalSizeInBytes)
+		assert.Equal(t, [][]byte{[]byte("missing")}, result.MainTrie.MissingNodes)
+
+		assert.Equal(t, 3, result.NumDataTries)
+		assert.Equal(t, uint64(3), result.DataTries.NumLeaves)
+		assert.Equal(t, uint64(1110), result.DataTries.TotalSizeInBytes)
+
+		require.Equal(t, 2, len(result.LargestDataTries))
+		assert.Equal(t, []byte("address of large data trie"), result.LargestDataTries[0].Address)
+		assert.Equal(t, []byte("large data trie"), result.LargestDataTries[0].RootHash)
+		assert.Equal(t, uint64(1000), result.LargestDataTries[0].Stats.TotalSizeInBytes)
+		assert.Equal(t, []byte("address of medium data trie"), result.LargestDataTries[1].Address)
+	})
+	t.Run("without data tries", func(t *testing.T) {
+		args := createMockArgsTrieInspector()
+		args.Walker = walker
+		args.InspectDataTries = false
+		ti, _ := NewTrieInspector(args)
+
+		result, err := ti.Inspect(mainRootHash)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(5), result.MainTrie.NumLeaves)
+		assert.Equal(t, 0, result.NumDataTries)
+		assert.Equal(t, uint64(0), result.DataTries.NumNodes())
+		assert.Empty(t, result.LargestDataTries)
+	})
+}
@@ -0,0 +1,85 @@
+package inspection
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbmigrator/migration"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// ArgsOpenTrieStorage is the DTO used to open the trie storage of a stopped node
+type ArgsOpenTrieStorage struct {
+	DBPath       string
+	DBType       string
+	ShardID      string
+	StorerName   string
+	MaxOpenFiles int
+}
+
+// OpenTrieStorage opens, from all the epochs, the persisters of the provided storer and shard. The most recent
+// epochs are searched first when reading a trie node
+func OpenTrieStorage(args ArgsOpenTrieStorage) (common.DBWriteCacher, error) {
+	storerDirectories, err := migration.FindStorerDirectories(args.DBPath, storageUnit.DBType(args.DBType))
+	if err != nil {
+		return nil, err
+	}
+
+	trieDirectories := make([]migration.StorerDirectory, 0)
+	for _, directory := range storerDirectories {
+		if directory.ShardID != args.ShardID || !isStorerDirectory(directory, args.StorerName) {
+			continue
+		}
+
+		trieDirectories = append(trieDirectories, directory)
+	}
+	sort.SliceStable(trieDirectories, func(i, j int) bool {
+		if trieDirectories[i].IsStatic != trieDirectories[j].IsStatic {
+			return !trieDirectories[i].IsStatic
+		}
+
+		return trieDirectories[i].Epoch > trieDirectories[j].Epoch
+	})
+
+	persisterFactory := factory.NewPersisterFactory(config.DBConfig{
+		Type:              args.DBType,
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      args.MaxOpenFiles,
+	})
+	persisters := make([]storage.Persister, 0, len(trieDirectories))
+	for _, directory := range trieDirectories {
+		log.Debug("opening trie storer", "directory", directory.RelativePath)
+
+		persister, errCreate := persisterFactory.Create(filepath.Join(args.DBPath, directory.RelativePath))
+		if errCreate != nil {
+			closePersisters(persisters)
+			return nil, fmt.Errorf("%w while opening %s", errCreate, directory.RelativePath)
+		}
+
+		persisters = append(persisters, persister)
+	}
+
+	log.Info("opened trie storers", "storer", args.StorerName, "shard", args.ShardID, "num", len(persisters))
+
+	return NewMultiPersisterReader(persisters)
+}
+
+// isStorerDirectory returns true if the directory belongs to the provided storer: Epoch_X/Shard_Y/<storer>/...
+func isStorerDirectory(directory migration.StorerDirectory, storerName string) bool {
+	pathComponents := strings.Split(filepath.ToSlash(directory.RelativePath), "/")
+
+	return len(pathComponents) > 2 && pathComponents[2] == storerName
+}
+
+func closePersisters(persisters []storage.Persister) {
+	for _, persister := range persisters {
+		_ = persister.Close()
+	}
+}
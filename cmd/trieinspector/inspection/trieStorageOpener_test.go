@@ -0,0 +1,64 @@
+package inspection
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbmigrator/migration"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPersisterWithData(tb testing.TB, path string, key string, value string) {
+	persisterFactory := factory.NewPersisterFactory(config.DBConfig{
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	})
+	persister, err := persisterFactory.Create(path)
+	require.Nil(tb, err)
+	require.Nil(tb, persister.Put([]byte(key), []byte(value)))
+	require.Nil(tb, persister.Close())
+}
+
+func TestOpenTrieStorage(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	createPersisterWithData(t, filepath.Join(dbPath, "Epoch_1", "Shard_0", "AccountsTrie", "MainDB"), "key", "epoch 1")
+	createPersisterWithData(t, filepath.Join(dbPath, "Epoch_2", "Shard_0", "AccountsTrie", "MainDB"), "key", "epoch 2")
+	createPersisterWithData(t, filepath.Join(dbPath, "Epoch_2", "Shard_1", "AccountsTrie", "MainDB"), "key", "other shard")
+	createPersisterWithData(t, filepath.Join(dbPath, "Epoch_2", "Shard_0", "PeerAccountsTrie", "MainDB"), "peer key", "peer")
+
+	db, err := OpenTrieStorage(ArgsOpenTrieStorage{
+		DBPath:       dbPath,
+		DBType:       string(storageUnit.LvlDBSerial),
+		ShardID:      "0",
+		StorerName:   "AccountsTrie",
+		MaxOpenFiles: 10,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	value, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("epoch 2"), value)
+
+	_, err = db.Get([]byte("peer key"))
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+}
+
+func TestIsStorerDirectory(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isStorerDirectory(migration.StorerDirectory{RelativePath: "Epoch_1/Shard_0/AccountsTrie/MainDB"}, "AccountsTrie"))
+	assert.False(t, isStorerDirectory(migration.StorerDirectory{RelativePath: "Epoch_1/Shard_0/PeerAccountsTrie/MainDB"}, "AccountsTrie"))
+	assert.False(t, isStorerDirectory(migration.StorerDirectory{RelativePath: "Epoch_1/Shard_0"}, "AccountsTrie"))
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/trieinspector/inspection"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+	"github.com/urfave/cli"
+)
+
+const addressLength = 32
+
+type cfg struct {
+	dbPath              string
+	dbType              string
+	shardID             string
+	storerName          string
+	rootHash            string
+	skipDataTries       bool
+	numLargestDataTries int
+	maxOpenFiles        int
+	logLevel            string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the databases holding the trie
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The path of the node's databases directory, the one containing the Epoch_X and Static " +
+			"directories. Example: ./db/1",
+		Destination: &argsConfig.dbPath,
+	}
+	// dbType defines a flag for the persister type of the databases
+	dbType = cli.StringFlag{
+		Name: "db-type",
+		Usage: fmt.Sprintf("The persister type of the databases. Available options: %s, %s, %s",
+			storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BoltDB),
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &argsConfig.dbType,
+	}
+	// shardID defines a flag for the shard of the inspected trie
+	shardID = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the inspected trie, as found in the Shard_X directory names. Example: 0, metachain",
+		Value:       "0",
+		Destination: &argsConfig.shardID,
+	}
+	// storerName defines a flag for the name of the trie storer
+	storerName = cli.StringFlag{
+		Name:        "storer",
+		Usage:       "The name of the storer holding the trie nodes. Example: AccountsTrie, PeerAccountsTrie",
+		Value:       "AccountsTrie",
+		Destination: &argsConfig.storerName,
+	}
+	// rootHash defines a flag for the root hash of the inspected trie
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the trie to be inspected",
+		Destination: &argsConfig.rootHash,
+	}
+	// skipDataTries defines a flag that disables the inspection of the accounts data tries
+	skipDataTries = cli.BoolFlag{
+		Name:        "skip-data-tries",
+		Usage:       "If set, only the main trie will be inspected, without the data tries of the accounts",
+		Destination: &argsConfig.skipDataTries,
+	}
+	// numLargestDataTries defines a flag for the number of largest data tries to be reported
+	numLargestDataTries = cli.IntFlag{
+		Name:        "num-largest-data-tries",
+		Usage:       "The number of largest data tries to be reported, along with their accounts",
+		Value:       10,
+		Destination: &argsConfig.numLargestDataTries,
+	}
+	// maxOpenFiles defines a flag for the maximum number of files a LevelDB persister can keep open
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files a LevelDB persister can keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("trieinspector")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Elrond Trie inspector Tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "This binary will open the trie storage of a stopped node and report statistics about the trie with " +
+		"the provided root hash: node counts by type, depth histogram, total size, largest data tries and missing nodes"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		dbType,
+		shardID,
+		storerName,
+		rootHash,
+		skipDataTries,
+		numLargestDataTries,
+		maxOpenFiles,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	db, err := inspection.OpenTrieStorage(inspection.ArgsOpenTrieStorage{
+		DBPath:       argsConfig.dbPath,
+		DBType:       argsConfig.dbType,
+		ShardID:      argsConfig.shardID,
+		StorerName:   argsConfig.storerName,
+		MaxOpenFiles: argsConfig.maxOpenFiles,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	walker, err := trie.NewStatisticsWalker(trie.ArgsStatisticsWalker{
+		DB:          db,
+		Marshalizer: marshalizer,
+		Hasher:      blake2b.NewBlake2b(),
+	})
+	if err != nil {
+		return err
+	}
+
+	inspector, err := inspection.NewTrieInspector(inspection.ArgsTrieInspector{
+		Walker:              walker,
+		Marshalizer:         marshalizer,
+		InspectDataTries:    !argsConfig.skipDataTries,
+		NumLargestDataTries: argsConfig.numLargestDataTries,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := inspector.Inspect(rootHashBytes)
+	if err != nil {
+		return err
+	}
+
+	printResult(result)
+
+	return nil
+}
+
+func printResult(result *inspection.InspectionResult) {
+	fmt.Println("main trie:")
+	printTrieStats(result.MainTrie)
+
+	if argsConfig.skipDataTries {
+		return
+	}
+
+	fmt.Printf("\ndata tries (%d):\n", result.NumDataTries)
+	printTrieStats(result.DataTries)
+
+	if len(result.LargestDataTries) == 0 {
+		return
+	}
+
+	addressConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+	fmt.Printf("\nlargest %d data tries:\n", len(result.LargestDataTries))
+	for index, dataTrie := range result.LargestDataTries {
+		address := hex.EncodeToString(dataTrie.Address)
+		if len(dataTrie.Address) == addressLength {
+			address = addressConverter.Encode(dataTrie.Address)
+		}
+
+		fmt.Printf("  %2d. %s: %d bytes, %d nodes, %d leaves, max level %d, %d missing nodes\n",
+			index+1,
+			address,
+			dataTrie.Stats.TotalSizeInBytes,
+			dataTrie.Stats.NumNodes(),
+			dataTrie.Stats.NumLeaves,
+			dataTrie.Stats.MaxLevel,
+			len(dataTrie.Stats.MissingNodes),
+		)
+	}
+}
+
+func printTrieStats(stats *statistics.TrieStatsDTO) {
+	for _, line := range stats.ToString() {
+		fmt.Println("  " + line)
+	}
+	for _, missingNode := range stats.MissingNodes {
+		fmt.Println("  missing node: " + hex.EncodeToString(missingNode))
+	}
+}
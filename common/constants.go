@@ -78,6 +78,18 @@ const MetricCurrentRound = "erd_current_round"
 // MetricNonce is the metric for monitoring the nonce of a node
 const MetricNonce = "erd_nonce"
 
+// MetricOutportQueueLag is the prefix of the metrics holding the number of entries not yet delivered to each
+// queued outport driver. The driver name is appended to the prefix
+const MetricOutportQueueLag = "erd_outport_queue_lag"
+
+// MetricOutportQueueDropped is the prefix of the metrics counting the entries dropped because the queue of an
+// outport driver was full. The driver name is appended to the prefix
+const MetricOutportQueueDropped = "erd_outport_queue_dropped"
+
+// MetricOutportQueueUndecodable is the prefix of the metrics counting the queued entries that could not be
+// decoded and were skipped. The driver name is appended to the prefix
+const MetricOutportQueueUndecodable = "erd_outport_queue_undecodable"
+
 // MetricProbableHighestNonce is the metric for monitoring the max speculative nonce received by the node by listening on the network
 const MetricProbableHighestNonce = "erd_probable_highest_nonce"
 
@@ -105,6 +117,51 @@ const MetricNumShardsWithoutMetachain = "erd_num_shards_without_meta"
 // MetricTxPoolLoad is the metric for monitoring number of transactions from pool of a node
 const MetricTxPoolLoad = "erd_tx_pool_load"
 
+// MetricTxPoolNumReplacedTxs is the metric for monitoring the number of pending transactions replaced by others with the
+// same sender and nonce, but with a higher gas price
+const MetricTxPoolNumReplacedTxs = "erd_tx_pool_num_replaced_txs"
+
+// MetricTxPoolNumRejectedReplacements is the metric for monitoring the number of transactions rejected from the pool
+// because of an insufficient gas price bump over the pending transaction with the same sender and nonce
+const MetricTxPoolNumRejectedReplacements = "erd_tx_pool_num_rejected_replacements"
+
+// MetricTxCacheNumTxs is the metric prefix for monitoring the number of transactions held by each of the pool's caches.
+// The cache identifier is appended to the metric name
+const MetricTxCacheNumTxs = "erd_tx_cache_num_txs"
+
+// MetricTxCacheNumBytes is the metric prefix for monitoring the size in bytes of each of the pool's caches.
+// The cache identifier is appended to the metric name
+const MetricTxCacheNumBytes = "erd_tx_cache_num_bytes"
+
+// MetricTxCacheNumSenders is the metric prefix for monitoring the number of senders held by each of the pool's caches.
+// The cache identifier is appended to the metric name
+const MetricTxCacheNumSenders = "erd_tx_cache_num_senders"
+
+// MetricTxCacheNumReplacedTxs is the metric prefix for monitoring the number of replaced transactions in each of the
+// pool's caches. The cache identifier is appended to the metric name
+const MetricTxCacheNumReplacedTxs = "erd_tx_cache_num_replaced_txs"
+
+// MetricTxCacheNumRejectedReplacements is the metric prefix for monitoring the number of rejected replacements in each
+// of the pool's caches. The cache identifier is appended to the metric name
+const MetricTxCacheNumRejectedReplacements = "erd_tx_cache_num_rejected_replacements"
+
+// MetricBlockProcessingDuration is the metric prefix for the duration in milliseconds of the last block operation.
+// One of the BlockOperation* identifiers is appended to the metric name
+const MetricBlockProcessingDuration = "erd_block_processing_duration_ms"
+
+// MetricConsensusSubroundDuration is the metric prefix for the duration in milliseconds of the last consensus subround.
+// The subround identifier is appended to the metric name
+const MetricConsensusSubroundDuration = "erd_consensus_subround_duration_ms"
+
+// BlockOperationCreate is the identifier of the block creation operation, used in the block processing metrics
+const BlockOperationCreate = "create"
+
+// BlockOperationProcess is the identifier of the block processing operation, used in the block processing metrics
+const BlockOperationProcess = "process"
+
+// BlockOperationCommit is the identifier of the block commit operation, used in the block processing metrics
+const BlockOperationCommit = "commit"
+
 // MetricCountLeader is the metric for monitoring number of rounds when a node was leader
 const MetricCountLeader = "erd_count_leader"
 
@@ -512,6 +569,12 @@ const (
 	NsNotCalculated
 )
 
+// HealthStatusUp is the status reported for a healthy node or component
+const HealthStatusUp = "up"
+
+// HealthStatusDown is the status reported for a node or a component that failed its health check
+const HealthStatusDown = "down"
+
 // MetricP2PPeerInfo is the metric for the node's p2p info
 const MetricP2PPeerInfo = "erd_p2p_peer_info"
 
@@ -697,3 +760,41 @@ const (
 	// TrieSyncedVal is the value that will be saved at TrieSyncedKey
 	TrieSyncedVal = "yes"
 )
+
+const (
+	// TxPoolFieldHash is the transaction hash field, as selected when inspecting the transactions pool
+	TxPoolFieldHash = "hash"
+
+	// TxPoolFieldNonce is the transaction nonce field, as selected when inspecting the transactions pool
+	TxPoolFieldNonce = "nonce"
+
+	// TxPoolFieldReceiver is the transaction receiver field, as selected when inspecting the transactions pool
+	TxPoolFieldReceiver = "receiver"
+
+	// TxPoolFieldValue is the transaction value field, as selected when inspecting the transactions pool
+	TxPoolFieldValue = "value"
+
+	// TxPoolFieldGasPrice is the transaction gas price field, as selected when inspecting the transactions pool
+	TxPoolFieldGasPrice = "gasPrice"
+
+	// TxPoolFieldGasLimit is the transaction gas limit field, as selected when inspecting the transactions pool
+	TxPoolFieldGasLimit = "gasLimit"
+
+	// TxPoolFieldData is the transaction data field, as selected when inspecting the transactions pool
+	TxPoolFieldData = "data"
+
+	// TxPoolFieldSize is the size in bytes of the transaction, as selected when inspecting the transactions pool
+	TxPoolFieldSize = "size"
+)
+
+// TxPoolFields holds all the fields that can be selected when inspecting the transactions pool
+var TxPoolFields = []string{
+	TxPoolFieldHash,
+	TxPoolFieldNonce,
+	TxPoolFieldReceiver,
+	TxPoolFieldValue,
+	TxPoolFieldGasPrice,
+	TxPoolFieldGasLimit,
+	TxPoolFieldData,
+	TxPoolFieldSize,
+}
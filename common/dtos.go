@@ -1,8 +1,269 @@
 package common
 
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+)
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
 	Value    []byte
 	RootHash string
 }
+
+// GetMultiProofResponse is a struct that stores the response of a multi-key proof API request. The values are in the
+// same order as the requested keys, an empty value meaning the key is proven to be absent
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// TrieRangeProof holds the leaves found in a range of trie keys, along with the trie nodes proving that no other leaf
+// exists in that range. When HasMore is set, the range was shortened to end at EndKey, the last returned key. The range
+// and the order of the keys follow the trie order, not the byte order of the keys: the keys are compared nibble by
+// nibble, starting with the last key byte, low nibble first
+type TrieRangeProof struct {
+	Proof   [][]byte
+	Keys    [][]byte
+	Values  [][]byte
+	EndKey  []byte
+	HasMore bool
+}
+
+// GetRangeProofResponse is a struct that stores the response of a range proof API request. The keys are in trie order,
+// as described for TrieRangeProof
+type GetRangeProofResponse struct {
+	TrieRangeProof
+	RootHash string
+}
+
+// AddressTransactionsResponse is a struct that stores the response of a transactions by address API request
+type AddressTransactionsResponse struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	HasMore      bool                                `json:"hasMore"`
+	Cursor       uint64                              `json:"cursor,omitempty"`
+}
+
+// LogsQueryRequest is a struct that stores the filters of a logs API request. The topics are filtered by position,
+// every position holding the accepted alternatives, an empty list matching any topic
+type LogsQueryRequest struct {
+	FromNonce  uint64     `json:"fromNonce"`
+	FromIndex  uint32     `json:"fromIndex"`
+	ToNonce    uint64     `json:"toNonce"`
+	Addresses  []string   `json:"addresses"`
+	Identifier string     `json:"identifier"`
+	Topics     [][][]byte `json:"topics"`
+	MaxResults uint32     `json:"maxResults"`
+}
+
+// LogEventApiResponse is a struct that stores a smart contract event returned by a logs API request
+type LogEventApiResponse struct {
+	TxHash     string   `json:"txHash"`
+	LogAddress string   `json:"logAddress"`
+	EventIndex uint32   `json:"eventIndex"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Round      uint64   `json:"round"`
+	Timestamp  uint64   `json:"timestamp"`
+	Epoch      uint32   `json:"epoch"`
+}
+
+// LogsQueryResponse is a struct that stores the response of a logs API request
+type LogsQueryResponse struct {
+	Events    []*LogEventApiResponse `json:"events"`
+	Truncated bool                   `json:"truncated"`
+	NextNonce uint64                 `json:"nextNonce,omitempty"`
+	NextIndex uint32                 `json:"nextIndex,omitempty"`
+}
+
+// SubscriptionRequest is a struct that stores the filters of an API subscription
+type SubscriptionRequest struct {
+	Blocks          bool
+	FinalizedBlocks bool
+	TxHashes        []string
+	Addresses       []string
+}
+
+// TransactionsPoolRequest is a struct that holds the options of a transactions pool inspection. When no sender is
+// provided, Limit senders are returned starting with the one at Offset, in descending order of their score
+type TransactionsPoolRequest struct {
+	Sender string
+	Fields []string
+	Offset uint32
+	Limit  uint32
+}
+
+// TransactionsPoolResponse is a struct that stores the pending transactions of the pool, grouped by sender, in
+// descending order of the senders' score. Only the requested fields of the transactions are filled. NumSenders holds
+// the number of senders in the pool, regardless of the requested page
+type TransactionsPoolResponse struct {
+	Senders    []*TransactionsPoolSender `json:"senders"`
+	NumSenders int                       `json:"numSenders"`
+}
+
+// TransactionsPoolSender is a struct that stores the pool state of a sender
+type TransactionsPoolSender struct {
+	Sender              string                   `json:"sender"`
+	Score               uint32                   `json:"score"`
+	AccountNonce        uint64                   `json:"accountNonce"`
+	AccountNonceKnown   bool                     `json:"accountNonceKnown"`
+	HasInitialGap       bool                     `json:"hasInitialGap"`
+	NumFailedSelections int64                    `json:"numFailedSelections"`
+	NumTransactions     int                      `json:"numTransactions"`
+	NonceGaps           []*NonceGap              `json:"nonceGaps"`
+	Transactions        []map[string]interface{} `json:"transactions"`
+}
+
+// NonceGap is a struct that stores a range of nonces missing from the pool
+type NonceGap struct {
+	FromNonce uint64 `json:"fromNonce"`
+	ToNonce   uint64 `json:"toNonce"`
+}
+
+// TransactionsPoolCountsResponse is a struct that stores the global counts of the pools
+type TransactionsPoolCountsResponse struct {
+	NumTransactions         int64  `json:"numTransactions"`
+	NumBytes                int64  `json:"numBytes"`
+	NumSmartContractResults int64  `json:"numSmartContractResults"`
+	NumRewardTransactions   int64  `json:"numRewardTransactions"`
+	NumSelfShardSenders     uint64 `json:"numSelfShardSenders"`
+	NumSelfShardTxs         uint64 `json:"numSelfShardTransactions"`
+	NumReplacedTxs          uint64 `json:"numReplacedTransactions"`
+	NumRejectedReplacements uint64 `json:"numRejectedReplacements"`
+}
+
+// TxCacheStatistics is a struct that stores the statistics of one of the transactions pool's caches
+type TxCacheStatistics struct {
+	NumTxs                  uint64
+	NumBytes                uint64
+	NumSenders              uint64
+	NumReplacedTxs          uint64
+	NumRejectedReplacements uint64
+}
+
+// OptionalUint64 holds an uint64 value which might be missing
+type OptionalUint64 struct {
+	Value    uint64
+	HasValue bool
+}
+
+// AccountQueryOptions holds the block the accounts are queried at: by nonce, by hash or directly by the state root
+// hash. At most one of them should be set; when none is set, the accounts are queried at the current state
+type AccountQueryOptions struct {
+	BlockNonce    OptionalUint64
+	BlockHash     []byte
+	BlockRootHash []byte
+}
+
+// IsHistorical returns true if the options point to a specific block, instead of the current state
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.BlockNonce.HasValue || len(options.BlockHash) > 0 || len(options.BlockRootHash) > 0
+}
+
+// HealthReport holds the overall status of the node along with the status of each of the registered components
+type HealthReport struct {
+	Status     string                  `json:"status"`
+	Components []ComponentHealthStatus `json:"components"`
+}
+
+// ComponentHealthStatus holds the status of a component, as reported by its health probe
+type ComponentHealthStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// HealthProfileInfo holds the details of a profile file written by the health service
+type HealthProfileInfo struct {
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"sizeInBytes"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// SCQueriesBatchResponse holds the results of a batch of VM queries, in the order of the requested queries, along with
+// the root hash all the queries were executed on
+type SCQueriesBatchResponse struct {
+	RootHash string                `json:"rootHash"`
+	Results  []*SCQueryBatchResult `json:"results"`
+}
+
+// SCQueryBatchResult holds the output or the error of a query executed in a batch
+type SCQueryBatchResult struct {
+	Data  *vm.VMOutputApi `json:"data"`
+	Error string          `json:"error,omitempty"`
+}
+
+// GovernanceProposal holds a governance proposal read through the governance contract view functions, along with its
+// current tally. Quorum is nil if the governance contract was not yet configured
+type GovernanceProposal struct {
+	Reference      string                  `json:"reference"`
+	Issuer         string                  `json:"issuer"`
+	StartVoteNonce uint64                  `json:"startVoteNonce"`
+	EndVoteNonce   uint64                  `json:"endVoteNonce"`
+	Status         string                  `json:"status"`
+	Closed         bool                    `json:"closed"`
+	Passed         bool                    `json:"passed"`
+	NumVoters      int                     `json:"numVoters"`
+	Tally          *GovernanceTally        `json:"tally"`
+	Quorum         *GovernanceQuorumStatus `json:"quorum,omitempty"`
+}
+
+// GovernanceTally holds the voting power cast on each option of a governance proposal. The governance contract accepts
+// only the yes, no and veto options, so there is no abstain tally
+type GovernanceTally struct {
+	Yes   string `json:"yes"`
+	No    string `json:"no"`
+	Veto  string `json:"veto"`
+	Total string `json:"total"`
+}
+
+// GovernanceQuorumStatus holds the thresholds a governance proposal is checked against when closed, along with the
+// outcome it would have if it were closed on the current tally
+type GovernanceQuorumStatus struct {
+	MinQuorum        string `json:"minQuorum"`
+	MinPassThreshold string `json:"minPassThreshold"`
+	MinVetoThreshold string `json:"minVetoThreshold"`
+	QuorumReached    bool   `json:"quorumReached"`
+	Vetoed           bool   `json:"vetoed"`
+	Passing          bool   `json:"passing"`
+}
+
+// GovernanceVote holds a vote cast by an address on a governance proposal
+type GovernanceVote struct {
+	Proposal    string `json:"proposal"`
+	Value       string `json:"value"`
+	Power       string `json:"power"`
+	Balance     string `json:"balance"`
+	DelegatedTo string `json:"delegatedTo,omitempty"`
+	WithFunds   bool   `json:"withFunds"`
+}
+
+// DelegationPortfolio holds the positions of an address in all the delegation contracts it participates in
+type DelegationPortfolio struct {
+	Address               string                        `json:"address"`
+	TotalActiveStake      string                        `json:"totalActiveStake"`
+	TotalUnStaked         string                        `json:"totalUnStaked"`
+	TotalClaimableRewards string                        `json:"totalClaimableRewards"`
+	Contracts             []*DelegationContractPosition `json:"contracts"`
+}
+
+// DelegationContractPosition holds the position of a delegator in a delegation contract
+type DelegationContractPosition struct {
+	DelegationScAddress string                    `json:"delegationScAddress"`
+	ActiveStake         string                    `json:"activeStake"`
+	UnStakedFunds       []*DelegationUnStakedFund `json:"unStakedFunds"`
+	TotalUnStaked       string                    `json:"totalUnStaked"`
+	ClaimableRewards    string                    `json:"claimableRewards"`
+}
+
+// DelegationUnStakedFund holds an unstaked fund along with the number of epochs left until it can be withdrawn
+type DelegationUnStakedFund struct {
+	Value                 string `json:"value"`
+	RemainingUnBondEpochs uint32 `json:"remainingUnBondEpochs"`
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 )
@@ -33,6 +35,8 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*TrieRangeProof, error)
 	GetStorageManager() StorageManager
 	Close() error
 	IsInterfaceNil() bool
@@ -112,6 +116,13 @@ type SizeSyncStatisticsHandler interface {
 	AddNumBytesReceived(bytes uint64)
 	NumBytesReceived() uint64
 	NumTries() int
+	AddBatchResponse(latency time.Duration)
+	AddNumBatchesTimedOut(value int)
+	NumBatchesCompleted() int
+	NumBatchesTimedOut() int
+	AverageBatchLatency() time.Duration
+	NodesThroughput() float64
+	BytesThroughput() float64
 }
 
 // SnapshotStatisticsHandler is used to measure different statistics for the trie snapshot
@@ -123,6 +134,23 @@ type SnapshotStatisticsHandler interface {
 	WaitForSnapshotsToFinish()
 }
 
+// TrieStatisticsHandler is used to collect statistics about the nodes of a trie while walking it. The size
+// provided for each node is the number of bytes the node occupies in the storage, key included
+type TrieStatisticsHandler interface {
+	AddBranchNode(level int, size uint64)
+	AddExtensionNode(level int, size uint64)
+	AddLeafNode(level int, size uint64, key []byte, value []byte)
+	AddMissingNode(level int, hash []byte)
+	IsInterfaceNil() bool
+}
+
+// TrieNodesHandler is used to receive the nodes of a trie, in their encoded form, while walking it
+type TrieNodesHandler interface {
+	HandleNode(hash []byte, encodedNode []byte) error
+	HandleLeaf(key []byte, value []byte) error
+	IsInterfaceNil() bool
+}
+
 // ProcessStatusHandler defines the behavior of a component able to hold the current status of the node and
 // able to tell if the node is idle or processing/committing a block
 type ProcessStatusHandler interface {
@@ -39,3 +39,11 @@ const MetricP2PNumReceiverPeers = "erd_p2p_num_receiver_peers"
 // MetricP2PPeakNumReceiverPeers represents the peak number of connected peer sent messages to the current peer
 // (and have been received by the current peer) in the amount of time
 const MetricP2PPeakNumReceiverPeers = "erd_p2p_peak_num_receiver_peers"
+
+// MetricP2PTopicNumReceivedMessages represents the total number of messages received on a topic. The topic is appended
+// to the metric name
+const MetricP2PTopicNumReceivedMessages = "erd_p2p_topic_num_received_messages"
+
+// MetricP2PTopicSizeReceivedMessages represents the total size of the messages received on a topic. The topic is
+// appended to the metric name
+const MetricP2PTopicSizeReceivedMessages = "erd_p2p_topic_size_received_messages"
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// AllowAllOrigins is the value that, when present in the allowed origins list, accepts web socket connections from
+// any origin
+const AllowAllOrigins = "*"
+
+// NewWebSocketOriginChecker returns the function used by the web socket upgraders to validate the Origin header.
+// Requests without an Origin header (non-browser clients) and same-origin requests are always accepted, while the
+// cross-origin ones are accepted only if their origin is in the provided allow-list
+func NewWebSocketOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	allowed := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.ToLower(strings.TrimSpace(origin))] = struct{}{}
+	}
+	_, allowAll := allowed[AllowAllOrigins]
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 || allowAll {
+			return true
+		}
+
+		originURL, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(originURL.Host, r.Host) {
+			return true
+		}
+
+		_, found := allowed[strings.ToLower(origin)]
+		return found
+	}
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebSocketOriginChecker(t *testing.T) {
+	t.Parallel()
+
+	createRequest := func(origin string) *http.Request {
+		req := httptest.NewRequest("GET", "http://localhost:8080/ws", nil)
+		if len(origin) > 0 {
+			req.Header.Set("Origin", origin)
+		}
+
+		return req
+	}
+
+	t.Run("no origin header should accept", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewWebSocketOriginChecker(nil)
+		assert.True(t, checker(createRequest("")))
+	})
+	t.Run("same origin should accept", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewWebSocketOriginChecker(nil)
+		assert.True(t, checker(createRequest("http://localhost:8080")))
+	})
+	t.Run("cross origin not in allow-list should reject", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewWebSocketOriginChecker([]string{"https://explorer.local"})
+		assert.False(t, checker(createRequest("https://attacker.example")))
+		assert.False(t, checker(createRequest("://invalid")))
+	})
+	t.Run("cross origin in allow-list should accept", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewWebSocketOriginChecker([]string{" https://Explorer.local "})
+		assert.True(t, checker(createRequest("https://explorer.local")))
+	})
+	t.Run("allow all should accept any origin", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewWebSocketOriginChecker([]string{AllowAllOrigins})
+		assert.True(t, checker(createRequest("https://attacker.example")))
+	})
+}
@@ -2,13 +2,14 @@ package config
 
 // CacheConfig will map the cache configuration
 type CacheConfig struct {
-	Name                 string
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Name                      string
+	Type                      string
+	Capacity                  uint32
+	SizePerSender             uint32
+	SizeInBytes               uint64
+	SizeInBytesPerSender      uint32
+	Shards                    uint32
+	MinGasPriceBumpPercentage uint32
 }
 
 // HeadersPoolConfig will map the headers cache configuration
@@ -182,6 +183,8 @@ type Config struct {
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	OutportQueue          OutportQueueConfig
+	Subscriptions         SubscriptionsConfig
 	Versions              VersionsConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
@@ -372,7 +375,8 @@ type ArwenVersionByEpoch struct {
 // QueryVirtualMachineConfig holds the configuration for the virtual machine(s) used in query process
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
-	NumConcurrentVMs int
+	NumConcurrentVMs  int
+	MaxQueriesInBatch int
 }
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
@@ -417,6 +421,25 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressTransactionsEnabled         bool
+	AddressTransactionsStorageConfig   StorageConfig
+	LogsIndexEnabled                   bool
+	LogsIndexStorageConfig             StorageConfig
+}
+
+// OutportQueueConfig holds the configuration for the durable queues placed in front of the outport drivers
+type OutportQueueConfig struct {
+	Enabled               bool
+	MaxQueueSize          uint64
+	MaxDrainWaitInSeconds uint32
+	DB                    DBConfig
+}
+
+// SubscriptionsConfig holds the configuration for the blocks, transactions and events subscriptions served by the API
+type SubscriptionsConfig struct {
+	Enabled              bool
+	MaxSubscribers       int
+	SubscriberBufferSize int
 }
 
 // DebugConfig will hold debugging configuration
@@ -435,6 +458,14 @@ type HealthServiceConfig struct {
 	MemoryUsageToCreateProfiles               int
 	NumMemoryUsageRecordsToKeep               int
 	FolderPath                                string
+	NumRoundsWithoutProgressToReportNotLive   uint32
+	OutportRetryDurationToReportNotReadyInSec int
+	NumProfileCapturesToKeep                  int
+	CPUProfileDurationInSeconds               int
+	MinIntervalBetweenCapturesInSeconds       int
+	GoroutinesSpikePercentToCreateProfiles    int
+	MinNumGoroutinesToCreateProfiles          int
+	BlockProcessingDurationToCreateProfilesMs uint64
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -480,9 +511,11 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
-// APIPackageConfig holds the configuration for the routes of each package
+// APIPackageConfig holds the configuration for the routes of each package. AllowedOrigins is used only by the
+// packages serving web socket routes and lists the cross origins allowed to connect
 type APIPackageConfig struct {
-	Routes []RouteConfig
+	Routes         []RouteConfig
+	AllowedOrigins []string
 }
 
 // RouteConfig holds the configuration for a single route
@@ -545,6 +578,7 @@ type TrieSyncConfig struct {
 	NumConcurrentTrieSyncers  int
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	StateArchivePath          string
 }
 
 // ResolverConfig represents the config options to be used when setting up the resolver instances
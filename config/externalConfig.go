@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	WebSocketConnector     WebSocketConnectorConfig
+	FileExportConnector    FileExportConnectorConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -34,3 +36,22 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// WebSocketConnectorConfig will hold the configuration for the web socket outport driver
+type WebSocketConnectorConfig struct {
+	Enabled               bool
+	URL                   string
+	Route                 string
+	BufferSize            int
+	MaxUnackedEvents      int
+	WriteTimeoutInSeconds int
+	AllowedOrigins        []string
+}
+
+// FileExportConnectorConfig will hold the configuration for the file outport driver
+type FileExportConnectorConfig struct {
+	Enabled               bool
+	Path                  string
+	MaxFileSizeInMB       uint64
+	NumWritesBetweenSyncs uint32
+}
@@ -2,10 +2,12 @@ package spos
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
@@ -31,6 +33,7 @@ type Subround struct {
 	consensusStateChangedChannel chan bool
 	executeStoredMessages        func()
 	appStatusHandler             core.AppStatusHandler
+	durationMetric               string
 
 	Job    func(ctx context.Context) bool // method does the Subround Job and send the result to the peers
 	Check  func() bool                    // method checks if the consensus of the Subround is done
@@ -82,6 +85,7 @@ func NewSubround(
 		Extend:                       nil,
 		appStatusHandler:             appStatusHandler,
 		currentPid:                   currentPid,
+		durationMetric:               computeSubroundDurationMetric(name),
 	}
 
 	return &sr, nil
@@ -126,6 +130,8 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		return false
 	}
 
+	defer sr.setDuration(time.Now())
+
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
@@ -154,6 +160,19 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 	}
 }
 
+func (sr *Subround) setDuration(startTime time.Time) {
+	sr.appStatusHandler.SetUInt64Value(sr.durationMetric, uint64(time.Since(startTime).Milliseconds()))
+}
+
+// computeSubroundDurationMetric builds the duration metric of a subround from its name, for example (START_ROUND)
+// becomes start_round
+func computeSubroundDurationMetric(name string) string {
+	subroundIdentifier := strings.ToLower(strings.Trim(name, "()"))
+	subroundIdentifier = strings.ReplaceAll(subroundIdentifier, " ", "_")
+
+	return common.MetricConsensusSubroundDuration + "_" + subroundIdentifier
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
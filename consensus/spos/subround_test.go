@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
@@ -681,6 +682,46 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 	assert.Equal(t, shouldWork, r)
 }
 
+func TestSubround_DoWorkShouldSetDurationMetric(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	setKey := ""
+	appStatusHandler := &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			setKey = key
+		},
+	}
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+		appStatusHandler,
+	)
+	sr.Job = func(_ context.Context) bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return true
+	}
+
+	r := sr.DoWork(context.Background(), &mock.RoundHandlerMock{})
+	assert.True(t, r)
+	assert.Equal(t, common.MetricConsensusSubroundDuration+"_start_round", setKey)
+}
+
 func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhile(t *testing.T) {
 	t.Parallel()
 
@@ -45,6 +45,10 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
+	case LogsIndexUnit:
+		return "LogsIndexUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +109,10 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AddressTransactionsUnit is the transactions by address storage unit identifier
+	AddressTransactionsUnit UnitType = 25
+	// LogsIndexUnit is the smart contract logs index storage unit identifier
+	LogsIndexUnit UnitType = 26
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -461,6 +461,43 @@ func (rrh *resolverRequestHandler) RequestTrieNodes(destShardID uint32, hashes [
 	rrh.trieHashesAccumulator = make(map[string]struct{})
 }
 
+// RequestTrieNodesBatch method asks for a batch of trie nodes from the connected peers. Unlike RequestTrieNodes, the
+// hashes are not accumulated with the ones of other calls and are sent before returning, so that each batch is a
+// separate request and the caller can measure the time it takes for the batch to be received. It does not filter
+// the hashes requested recently, as the caller is expected to request again only the batches not received in time
+func (rrh *resolverRequestHandler) RequestTrieNodesBatch(destShardID uint32, hashes [][]byte, topic string) {
+	if len(hashes) == 0 {
+		return
+	}
+
+	resolver, err := rrh.resolversFinder.MetaCrossShardResolver(topic, destShardID)
+	if err != nil {
+		log.Error("RequestTrieNodesBatch.Resolver",
+			"error", err.Error(),
+			"topic", topic,
+			"shard", destShardID,
+		)
+		return
+	}
+
+	trieResolver, ok := resolver.(dataRetriever.TrieNodesResolver)
+	if !ok {
+		log.Warn("wrong assertion type when creating a trie nodes resolver")
+		return
+	}
+
+	log.Trace("requesting trie nodes batch from network",
+		"topic", topic,
+		"shard", destShardID,
+		"num nodes", len(hashes),
+		"firstHash", hashes[0],
+	)
+
+	rrh.whiteList.Add(hashes)
+	rrh.requestHashesWithDataSplit(hashes, trieResolver)
+	rrh.addRequestedItems(hashes, uniqueTrieNodesSuffix)
+}
+
 // CreateTrieNodeIdentifier returns the requested trie node identifier that will be whitelisted
 func (rrh *resolverRequestHandler) CreateTrieNodeIdentifier(requestHash []byte, chunkIndex uint32) []byte {
 	chunkBuffer := make([]byte, bytesInUint32)
@@ -966,6 +966,45 @@ func TestRequestTrieNodes_ShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func TestRequestTrieNodesBatch_ShouldSendEachBatchRightAway(t *testing.T) {
+	t.Parallel()
+
+	requestedBatches := make([][][]byte, 0)
+	resolverMock := &mock.HashSliceResolverStub{
+		RequestDataFromHashArrayCalled: func(hashes [][]byte, epoch uint32) error {
+			requestedBatches = append(requestedBatches, hashes)
+			return nil
+		},
+	}
+
+	whiteListed := make([][]byte, 0)
+	rrh, _ := NewResolverRequestHandler(
+		&mock.ResolversFinderStub{
+			MetaCrossShardResolverCalled: func(baseTopic string, crossShard uint32) (dataRetriever.Resolver, error) {
+				return resolverMock, nil
+			},
+		},
+		&mock.RequestedItemsHandlerStub{},
+		&mock.WhiteListHandlerStub{
+			AddCalled: func(keys [][]byte) {
+				whiteListed = append(whiteListed, keys...)
+			},
+		},
+		100,
+		0,
+		time.Second,
+	)
+
+	firstBatch := [][]byte{[]byte("hash1"), []byte("hash2")}
+	secondBatch := [][]byte{[]byte("hash3")}
+	rrh.RequestTrieNodesBatch(0, firstBatch, "topic")
+	rrh.RequestTrieNodesBatch(0, secondBatch, "topic")
+	rrh.RequestTrieNodesBatch(0, nil, "topic")
+
+	assert.Equal(t, [][][]byte{firstBatch, secondBatch}, requestedBatches)
+	assert.Equal(t, append(firstBatch, secondBatch...), whiteListed)
+}
+
 func TestRequestTrieNodes_NilResolver(t *testing.T) {
 	t.Parallel()
 
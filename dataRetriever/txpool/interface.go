@@ -16,3 +16,12 @@ type txCache interface {
 	NumBytes() int
 	Diagnose(deep bool)
 }
+
+type txReplacementsCounter interface {
+	NumReplacedTxs() uint64
+	NumRejectedReplacements() uint64
+}
+
+type txSendersCounter interface {
+	CountSenders() uint64
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/counting"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -56,6 +57,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		MinGasPriceBumpPercentage:     args.Config.MinGasPriceBumpPercentage,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -327,6 +329,55 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// GetReplacementCounts returns the number of transactions replaced by others with the same sender and nonce and the
+// number of replacements rejected due to an insufficient gas price bump
+func (txPool *shardedTxPool) GetReplacementCounts() (numReplaced uint64, numRejected uint64) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		counter, ok := shard.Cache.(txReplacementsCounter)
+		if !ok {
+			continue
+		}
+
+		numReplaced += counter.NumReplacedTxs()
+		numRejected += counter.NumRejectedReplacements()
+	}
+
+	return numReplaced, numRejected
+}
+
+// GetCachesStatistics returns the statistics of each of the internal caches, indexed by the cache identifier. The
+// number of senders and the replacement counts are only available for the caches holding sender-ordered transactions
+func (txPool *shardedTxPool) GetCachesStatistics() map[string]common.TxCacheStatistics {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	statistics := make(map[string]common.TxCacheStatistics, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		cacheStatistics := common.TxCacheStatistics{
+			NumTxs:   uint64(shard.Cache.Len()),
+			NumBytes: uint64(shard.Cache.NumBytes()),
+		}
+
+		sendersCounter, ok := shard.Cache.(txSendersCounter)
+		if ok {
+			cacheStatistics.NumSenders = sendersCounter.CountSenders()
+		}
+
+		replacementsCounter, ok := shard.Cache.(txReplacementsCounter)
+		if ok {
+			cacheStatistics.NumReplacedTxs = replacementsCounter.NumReplacedTxs()
+			cacheStatistics.NumRejectedReplacements = replacementsCounter.NumRejectedReplacements()
+		}
+
+		statistics[cacheID] = cacheStatistics
+	}
+
+	return statistics
+}
+
 // Diagnose diagnoses the internal caches
 func (txPool *shardedTxPool) Diagnose(deep bool) {
 	log.Trace("shardedTxPool.Diagnose()", "counts", txPool.GetCounts().String())
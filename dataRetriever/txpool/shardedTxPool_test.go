@@ -96,7 +96,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, MinGasPriceBumpPercentage: 10}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -116,6 +116,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.MinGasPriceBumpPercentage))
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -333,6 +334,50 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetReplacementCounts(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.configPrototypeSourceMe.MinGasPriceBumpPercentage = 10
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 1000}, 0, "0")
+	pool.AddData([]byte("hash-y"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 1050}, 0, "0")
+	pool.AddData([]byte("hash-z"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 1100}, 0, "0")
+	pool.AddData([]byte("hash-w"), createTx("bob", 15), 0, "0_1")
+
+	numReplaced, numRejected := pool.GetReplacementCounts()
+	require.Equal(t, uint64(1), numReplaced)
+	require.Equal(t, uint64(1), numRejected)
+
+	_, ok := pool.getTxCache("0").GetByTxHash([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = pool.getTxCache("0").GetByTxHash([]byte("hash-z"))
+	require.True(t, ok)
+}
+
+func Test_GetCachesStatistics(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.configPrototypeSourceMe.MinGasPriceBumpPercentage = 10
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 1000}, 0, "0")
+	pool.AddData([]byte("hash-y"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 1100}, 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "0")
+	pool.AddData([]byte("hash-w"), createTx("carol", 15), 0, "1_0")
+
+	statistics := pool.GetCachesStatistics()
+	require.Equal(t, 2, len(statistics))
+
+	selfShardStatistics := statistics["0"]
+	require.Equal(t, uint64(2), selfShardStatistics.NumTxs)
+	require.Equal(t, uint64(2), selfShardStatistics.NumSenders)
+	require.Equal(t, uint64(1), selfShardStatistics.NumReplacedTxs)
+	require.Equal(t, uint64(0), selfShardStatistics.NumRejectedReplacements)
+	require.Equal(t, uint64(pool.getTxCache("0").NumBytes()), selfShardStatistics.NumBytes)
+
+	crossShardStatistics := statistics["1_0"]
+	require.Equal(t, uint64(1), crossShardStatistics.NumTxs)
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -0,0 +1,116 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// AddressTransactionRole holds the roles an address has in a transaction, as bit flags
+type AddressTransactionRole uint8
+
+const (
+	// RoleSender marks the address as the sender of the transaction
+	RoleSender AddressTransactionRole = 1 << iota
+	// RoleReceiver marks the address as the receiver of the transaction
+	RoleReceiver
+)
+
+// IsSender returns true if the sender flag is set
+func (role AddressTransactionRole) IsSender() bool {
+	return role&RoleSender != 0
+}
+
+// IsReceiver returns true if the receiver flag is set
+func (role AddressTransactionRole) IsReceiver() bool {
+	return role&RoleReceiver != 0
+}
+
+// AddressTransaction is an entry of the transactions history of an address
+type AddressTransaction struct {
+	TxHash        []byte
+	MiniblockType int32
+	Role          AddressTransactionRole
+	BlockNonce    uint64
+	Round         uint64
+	Timestamp     uint64
+	Epoch         uint32
+}
+
+// AddressTransactionsQuery holds the filters applied when fetching the transactions history of an address. The
+// bounds are inclusive, a zero upper bound meaning no bound. The transactions are returned newest first, at most
+// Size per page, a page being able to end in the middle of a block. The next page is fetched setting the Cursor to
+// the one returned with the previous page, a zero cursor starting from the newest transaction
+type AddressTransactionsQuery struct {
+	FromNonce     uint64
+	ToNonce       uint64
+	FromTimestamp uint64
+	ToTimestamp   uint64
+	Size          uint32
+	Cursor        uint64
+}
+
+// AddressTransactionsPage holds a page of the transactions history of an address. The cursor is set only if
+// there are more transactions to fetch and holds the position of the oldest returned transaction
+type AddressTransactionsPage struct {
+	Transactions []*AddressTransaction
+	HasMore      bool
+	Cursor       uint64
+}
+
+// encoded layout: txHash length (1 byte) | txHash | miniblock type (4) | role (1) | nonce (8) | round (8) |
+// timestamp (8) | epoch (4)
+const addressTransactionFixedSize = 1 + 4 + 1 + 8 + 8 + 8 + 4
+
+var errInvalidAddressTransactionBytes = errors.New("invalid address transaction bytes")
+
+func (at *AddressTransaction) encode() []byte {
+	buff := make([]byte, 0, addressTransactionFixedSize+len(at.TxHash))
+	buff = append(buff, byte(len(at.TxHash)))
+	buff = append(buff, at.TxHash...)
+	buff = appendUint32(buff, uint32(at.MiniblockType))
+	buff = append(buff, byte(at.Role))
+	buff = appendUint64(buff, at.BlockNonce)
+	buff = appendUint64(buff, at.Round)
+	buff = appendUint64(buff, at.Timestamp)
+	buff = appendUint32(buff, at.Epoch)
+
+	return buff
+}
+
+func decodeAddressTransaction(buff []byte) (*AddressTransaction, error) {
+	if len(buff) < addressTransactionFixedSize {
+		return nil, errInvalidAddressTransactionBytes
+	}
+
+	hashLen := int(buff[0])
+	if len(buff) != addressTransactionFixedSize+hashLen {
+		return nil, errInvalidAddressTransactionBytes
+	}
+
+	at := &AddressTransaction{
+		TxHash: append([]byte{}, buff[1:1+hashLen]...),
+	}
+	buff = buff[1+hashLen:]
+	at.MiniblockType = int32(binary.BigEndian.Uint32(buff))
+	at.Role = AddressTransactionRole(buff[4])
+	at.BlockNonce = binary.BigEndian.Uint64(buff[5:])
+	at.Round = binary.BigEndian.Uint64(buff[13:])
+	at.Timestamp = binary.BigEndian.Uint64(buff[21:])
+	at.Epoch = binary.BigEndian.Uint32(buff[29:])
+
+	return at, nil
+}
+
+func appendUint64(buff []byte, value uint64) []byte {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, value)
+
+	return append(buff, encoded...)
+}
+
+func appendUint32(buff []byte, value uint32) []byte {
+	encoded := make([]byte, 4)
+	binary.BigEndian.PutUint32(encoded, value)
+
+	return append(buff, encoded...)
+}
@@ -0,0 +1,366 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	addressCounterPrefix      = 'c'
+	addressEntryPrefix        = 'e'
+	addressesByBlockPrefix    = 'r'
+	maxAddressesByBlockLength = 255
+)
+
+// addressTransactionsIndex keeps, for every address, the list of the transactions it sent or received, in the order
+// of the committed blocks. Each address has a counter and the entries are stored under address + position keys,
+// so a page is fetched without scanning the storer. For every block, the touched addresses are also saved so the
+// entries can be removed when the block is reverted. Recording a block first removes the entries previously recorded
+// for the block's nonce, including the ones of addresses missing from the new block, so replaying blocks or
+// recording another block of a fork does not duplicate or keep stale entries
+type addressTransactionsIndex struct {
+	mut    sync.RWMutex
+	storer storage.Storer
+}
+
+func newAddressTransactionsIndex(storer storage.Storer) *addressTransactionsIndex {
+	return &addressTransactionsIndex{
+		storer: storer,
+	}
+}
+
+func (ati *addressTransactionsIndex) recordBlock(
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) error {
+	addresses := make([]string, 0)
+	entriesByAddress := make(map[string][]*AddressTransaction)
+	addEntry := func(address []byte, entry *AddressTransaction) {
+		if len(address) == 0 {
+			return
+		}
+
+		key := string(address)
+		entries, found := entriesByAddress[key]
+		if !found {
+			addresses = append(addresses, key)
+		}
+		entriesByAddress[key] = append(entries, entry)
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		if !isIndexedMiniblockType(miniblock.Type) {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			tx, found := txsFromPool[string(txHash)]
+			if !found {
+				tx, found = scrResultsFromPool[string(txHash)]
+			}
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			newEntry := func(role AddressTransactionRole) *AddressTransaction {
+				return &AddressTransaction{
+					TxHash:        txHash,
+					MiniblockType: int32(miniblock.Type),
+					Role:          role,
+					BlockNonce:    blockHeader.GetNonce(),
+					Round:         blockHeader.GetRound(),
+					Timestamp:     blockHeader.GetTimeStamp(),
+					Epoch:         blockHeader.GetEpoch(),
+				}
+			}
+
+			sender, receiver := tx.GetSndAddr(), tx.GetRcvAddr()
+			if string(sender) == string(receiver) {
+				addEntry(sender, newEntry(RoleSender|RoleReceiver))
+				continue
+			}
+
+			addEntry(sender, newEntry(RoleSender))
+			addEntry(receiver, newEntry(RoleReceiver))
+		}
+	}
+
+	ati.mut.Lock()
+	defer ati.mut.Unlock()
+
+	nonce := blockHeader.GetNonce()
+	err := ati.removeEntriesOfBlock(nonce)
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	err = ati.saveAddressesOfBlock(nonce, addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = ati.writeEntries([]byte(address), nonce, entriesByAddress[address])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isIndexedMiniblockType(miniblockType block.Type) bool {
+	switch miniblockType {
+	case block.TxBlock, block.SmartContractResultBlock, block.RewardsBlock, block.InvalidBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeEntries places the entries of the block with the provided nonce right after the entries of the older blocks
+func (ati *addressTransactionsIndex) writeEntries(address []byte, nonce uint64, entries []*AddressTransaction) error {
+	count, err := ati.truncateFromNonce(address, nonce)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err = ati.storer.Put(entryKey(address, count), entry.encode())
+		if err != nil {
+			return err
+		}
+		count++
+	}
+
+	return ati.storer.Put(counterKey(address), encodeUint64(count))
+}
+
+// saveAddressesOfBlock replaces the addresses saved for the provided nonce
+func (ati *addressTransactionsIndex) saveAddressesOfBlock(nonce uint64, addresses []string) error {
+	buff := make([]byte, 0)
+	for _, address := range addresses {
+		if len(address) > maxAddressesByBlockLength {
+			continue
+		}
+
+		buff = append(buff, byte(len(address)))
+		buff = append(buff, address...)
+	}
+
+	return ati.storer.Put(addressesByBlockKey(nonce), buff)
+}
+
+func (ati *addressTransactionsIndex) getAddressesOfBlock(nonce uint64) ([]string, error) {
+	key := addressesByBlockKey(nonce)
+	if ati.storer.Has(key) != nil {
+		return make([]string, 0), nil
+	}
+
+	buff, err := ati.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0)
+	for len(buff) > 0 {
+		addressLen := int(buff[0])
+		if len(buff) < 1+addressLen {
+			return nil, errInvalidAddressTransactionBytes
+		}
+
+		addresses = append(addresses, string(buff[1:1+addressLen]))
+		buff = buff[1+addressLen:]
+	}
+
+	return addresses, nil
+}
+
+// revertBlock removes the entries of the blocks with nonces greater or equal to the reverted block's nonce
+func (ati *addressTransactionsIndex) revertBlock(blockHeader data.HeaderHandler) error {
+	ati.mut.Lock()
+	defer ati.mut.Unlock()
+
+	return ati.removeEntriesOfBlock(blockHeader.GetNonce())
+}
+
+// removeEntriesOfBlock removes, for every address saved for the provided nonce, the entries with nonces greater or
+// equal to it, together with the saved addresses
+func (ati *addressTransactionsIndex) removeEntriesOfBlock(nonce uint64) error {
+	if ati.storer.Has(addressesByBlockKey(nonce)) != nil {
+		return nil
+	}
+
+	addresses, err := ati.getAddressesOfBlock(nonce)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = ati.removeEntriesFromNonce([]byte(address), nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ati.storer.Remove(addressesByBlockKey(nonce))
+}
+
+func (ati *addressTransactionsIndex) removeEntriesFromNonce(address []byte, nonce uint64) error {
+	count, err := ati.truncateFromNonce(address, nonce)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(counterKey(address), encodeUint64(count))
+}
+
+// truncateFromNonce removes the entries with nonces greater or equal to the provided one and returns the number of
+// remaining entries. The counter is left for the caller to update
+func (ati *addressTransactionsIndex) truncateFromNonce(address []byte, nonce uint64) (uint64, error) {
+	count, err := ati.getCount(address)
+	if err != nil {
+		return 0, err
+	}
+
+	for count > 0 {
+		entry, errGet := ati.getEntry(address, count-1)
+		if errGet != nil {
+			return 0, errGet
+		}
+		if entry.BlockNonce < nonce {
+			break
+		}
+
+		count--
+		err = ati.storer.Remove(entryKey(address, count))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+func (ati *addressTransactionsIndex) getTransactions(address []byte, query AddressTransactionsQuery) (*AddressTransactionsPage, error) {
+	ati.mut.RLock()
+	defer ati.mut.RUnlock()
+
+	page := &AddressTransactionsPage{
+		Transactions: make([]*AddressTransaction, 0),
+	}
+
+	count, err := ati.getCount(address)
+	if err != nil {
+		return nil, err
+	}
+	if query.Cursor > 0 && query.Cursor < count {
+		count = query.Cursor
+	}
+
+	// the entries are ordered by nonce and timestamp so the newest entry within the upper bounds is found
+	// with a binary search
+	var errSearch error
+	end := sort.Search(int(count), func(i int) bool {
+		entry, errGet := ati.getEntry(address, uint64(i))
+		if errGet != nil {
+			errSearch = errGet
+			return true
+		}
+
+		return !isBelowUpperBounds(entry, query)
+	})
+	if errSearch != nil {
+		return nil, errSearch
+	}
+
+	for i := end - 1; i >= 0; i-- {
+		entry, errGet := ati.getEntry(address, uint64(i))
+		if errGet != nil {
+			return nil, errGet
+		}
+		if entry.BlockNonce < query.FromNonce || entry.Timestamp < query.FromTimestamp {
+			break
+		}
+
+		numTransactions := len(page.Transactions)
+		if numTransactions > 0 && numTransactions >= int(query.Size) {
+			page.HasMore = true
+			break
+		}
+
+		page.Transactions = append(page.Transactions, entry)
+		page.Cursor = uint64(i)
+	}
+	if !page.HasMore {
+		page.Cursor = 0
+	}
+
+	return page, nil
+}
+
+func isBelowUpperBounds(entry *AddressTransaction, query AddressTransactionsQuery) bool {
+	if query.ToNonce > 0 && entry.BlockNonce > query.ToNonce {
+		return false
+	}
+	if query.ToTimestamp > 0 && entry.Timestamp > query.ToTimestamp {
+		return false
+	}
+
+	return true
+}
+
+func (ati *addressTransactionsIndex) getCount(address []byte) (uint64, error) {
+	key := counterKey(address)
+	if ati.storer.Has(key) != nil {
+		return 0, nil
+	}
+
+	buff, err := ati.storer.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(buff) != 8 {
+		return 0, errInvalidAddressTransactionBytes
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func (ati *addressTransactionsIndex) getEntry(address []byte, position uint64) (*AddressTransaction, error) {
+	buff, err := ati.storer.Get(entryKey(address, position))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAddressTransaction(buff)
+}
+
+func counterKey(address []byte) []byte {
+	return append([]byte{addressCounterPrefix}, address...)
+}
+
+func entryKey(address []byte, position uint64) []byte {
+	key := append([]byte{addressEntryPrefix}, address...)
+
+	return appendUint64(key, position)
+}
+
+func addressesByBlockKey(nonce uint64) []byte {
+	return appendUint64([]byte{addressesByBlockPrefix}, nonce)
+}
+
+func encodeUint64(value uint64) []byte {
+	return appendUint64(make([]byte, 0, 8), value)
+}
@@ -0,0 +1,249 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordTransfers(t *testing.T, index *addressTransactionsIndex, nonce uint64, transfers map[string][2]string) {
+	txs := make(map[string]data.TransactionHandler)
+	txHashes := make([][]byte, 0, len(transfers))
+	for txHash, transfer := range transfers {
+		txs[txHash] = &transaction.Transaction{SndAddr: []byte(transfer[0]), RcvAddr: []byte(transfer[1])}
+		txHashes = append(txHashes, []byte(txHash))
+	}
+
+	header := &block.Header{Nonce: nonce, Round: nonce + 1, TimeStamp: nonce * 10, Epoch: 1}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: txHashes}}}
+
+	err := index.recordBlock(header, body, txs, nil)
+	require.Nil(t, err)
+}
+
+func getTxHashes(page *AddressTransactionsPage) []string {
+	hashes := make([]string, 0, len(page.Transactions))
+	for _, entry := range page.Transactions {
+		hashes = append(hashes, string(entry.TxHash))
+	}
+
+	return hashes
+}
+
+func TestAddressTransaction_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	entry := &AddressTransaction{
+		TxHash:        []byte("txHash"),
+		MiniblockType: int32(block.RewardsBlock),
+		Role:          RoleSender | RoleReceiver,
+		BlockNonce:    37,
+		Round:         38,
+		Timestamp:     1234,
+		Epoch:         2,
+	}
+
+	decoded, err := decodeAddressTransaction(entry.encode())
+	require.Nil(t, err)
+	assert.Equal(t, entry, decoded)
+	assert.True(t, decoded.Role.IsSender())
+	assert.True(t, decoded.Role.IsReceiver())
+
+	_, err = decodeAddressTransaction(entry.encode()[1:])
+	assert.Equal(t, errInvalidAddressTransactionBytes, err)
+}
+
+func TestAddressTransactionsIndex_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+
+	header := &block.Header{Nonce: 5, Round: 6, TimeStamp: 60, Epoch: 1}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx"), []byte("self"), []byte("missing")}},
+			{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+			{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}},
+			{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peer")}},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"tx":     &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"self":   &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+		"reward": &rewardTx.RewardTx{RcvAddr: []byte("alice")},
+		"peer":   &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: []byte("bob"), RcvAddr: []byte("alice")},
+	}
+
+	err := index.recordBlock(header, body, txs, scrs)
+	require.Nil(t, err)
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"reward", "scr", "self", "tx"}, getTxHashes(page))
+	assert.False(t, page.HasMore)
+	assert.Equal(t, RoleReceiver, page.Transactions[0].Role)
+	assert.Equal(t, RoleSender|RoleReceiver, page.Transactions[2].Role)
+	assert.Equal(t, RoleSender, page.Transactions[3].Role)
+	assert.Equal(t, int32(block.SmartContractResultBlock), page.Transactions[1].MiniblockType)
+	assert.Equal(t, uint64(5), page.Transactions[3].BlockNonce)
+	assert.Equal(t, uint64(6), page.Transactions[3].Round)
+	assert.Equal(t, uint64(60), page.Transactions[3].Timestamp)
+	assert.Equal(t, uint32(1), page.Transactions[3].Epoch)
+
+	page, err = index.getTransactions([]byte("bob"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"scr", "tx"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("carol"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Transactions))
+}
+
+func TestAddressTransactionsIndex_PagesSplitBlocksUsingTheCursor(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+	recordTransfers(t, index, 1, map[string][2]string{"a": {"alice", "bob"}})
+	recordTransfers(t, index, 2, map[string][2]string{"b": {"alice", "bob"}, "c": {"alice", "bob"}, "d": {"alice", "bob"}})
+	recordTransfers(t, index, 3, map[string][2]string{"e": {"alice", "bob"}})
+
+	fetched := make([]string, 0)
+	query := AddressTransactionsQuery{Size: 2}
+	for i := 0; i < 3; i++ {
+		page, err := index.getTransactions([]byte("alice"), query)
+		require.Nil(t, err)
+		assert.True(t, len(page.Transactions) <= 2)
+
+		fetched = append(fetched, getTxHashes(page)...)
+		if !page.HasMore {
+			assert.Zero(t, page.Cursor)
+			break
+		}
+
+		require.NotZero(t, page.Cursor)
+		query.Cursor = page.Cursor
+	}
+
+	require.Equal(t, 5, len(fetched))
+	assert.Equal(t, "e", fetched[0])
+	assert.ElementsMatch(t, []string{"b", "c", "d"}, fetched[1:4])
+	assert.Equal(t, "a", fetched[4])
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{ToNonce: 1, Size: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a"}, getTxHashes(page))
+	assert.False(t, page.HasMore)
+}
+
+func TestAddressTransactionsIndex_RecordingTheSameBlockAgainShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+	recordTransfers(t, index, 1, map[string][2]string{"a": {"alice", "bob"}})
+	recordTransfers(t, index, 2, map[string][2]string{"b": {"alice", "bob"}, "c": {"alice", "carol"}})
+	recordTransfers(t, index, 2, map[string][2]string{"b": {"alice", "bob"}, "c": {"alice", "carol"}})
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(page.Transactions))
+	assert.ElementsMatch(t, []string{"b", "c"}, getTxHashes(page)[:2])
+	assert.Equal(t, "a", string(page.Transactions[2].TxHash))
+
+	page, err = index.getTransactions([]byte("carol"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"c"}, getTxHashes(page))
+}
+
+func TestAddressTransactionsIndex_RecordingAnotherBlockWithTheSameNonceShouldRemoveTheOldEntries(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+	recordTransfers(t, index, 1, map[string][2]string{"a": {"alice", "bob"}})
+	recordTransfers(t, index, 2, map[string][2]string{"b": {"alice", "bob"}})
+
+	// the block of the other fork touches disjoint addresses
+	recordTransfers(t, index, 2, map[string][2]string{"c": {"carol", "dave"}})
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("bob"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("carol"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"c"}, getTxHashes(page))
+
+	// the block of the other fork is empty
+	recordTransfers(t, index, 2, map[string][2]string{})
+
+	page, err = index.getTransactions([]byte("carol"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Transactions))
+
+	page, err = index.getTransactions([]byte("dave"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Transactions))
+
+	page, err = index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a"}, getTxHashes(page))
+}
+
+func TestAddressTransactionsIndex_Filters(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+	for nonce, txHash := range []string{"a", "b", "c", "d", "e"} {
+		recordTransfers(t, index, uint64(nonce+1), map[string][2]string{txHash: {"alice", "bob"}})
+	}
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{FromNonce: 2, ToNonce: 4, Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"d", "c", "b"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("bob"), AddressTransactionsQuery{FromTimestamp: 30, ToTimestamp: 40, Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"d", "c"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("bob"), AddressTransactionsQuery{FromNonce: 6, Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Transactions))
+}
+
+func TestAddressTransactionsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(testscommon.CreateMemUnit())
+	recordTransfers(t, index, 1, map[string][2]string{"a": {"alice", "bob"}})
+	recordTransfers(t, index, 2, map[string][2]string{"b": {"alice", "carol"}})
+
+	err := index.revertBlock(&block.Header{Nonce: 2})
+	require.Nil(t, err)
+
+	page, err := index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a"}, getTxHashes(page))
+
+	page, err = index.getTransactions([]byte("carol"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Transactions))
+
+	recordTransfers(t, index, 2, map[string][2]string{"c": {"alice", "bob"}})
+
+	page, err = index.getTransactions([]byte("alice"), AddressTransactionsQuery{Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"c", "a"}, getTxHashes(page))
+}
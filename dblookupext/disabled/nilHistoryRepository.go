@@ -19,7 +19,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
 	return nil
 }
 
@@ -57,6 +57,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetTransactionsByAddress returns the disabled error
+func (nhr *nilHistoryRepository) GetTransactionsByAddress(_ []byte, _ dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetLogs returns the disabled error
+func (nhr *nilHistoryRepository) GetLogs(_ dblookupext.LogsQuery) (*dblookupext.LogsQueryResult, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -10,6 +10,18 @@ var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+// ErrAddressTransactionsIndexDisabled signals that the transactions by address index is not enabled
+var ErrAddressTransactionsIndexDisabled = errors.New("transactions by address index is disabled")
+
+// ErrLogsIndexDisabled signals that the logs index is not enabled
+var ErrLogsIndexDisabled = errors.New("logs index is disabled")
+
+// ErrInvalidLogsQueryRange signals that the end nonce of a logs query is lower than the start nonce
+var ErrInvalidLogsQueryRange = errors.New("invalid logs query range")
+
+// ErrLogsQueryRangeTooLarge signals that a logs query without an address filter spans too many blocks
+var ErrLogsQueryRangeTooLarge = errors.New("logs query range too large, filter by address or reduce the range")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
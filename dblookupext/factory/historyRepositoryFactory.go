@@ -86,6 +86,13 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
 	}
+	if hpf.dbLookupExtensionsConfig.AddressTransactionsEnabled {
+		historyRepArgs.AddressTransactionsStorer = hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
+	}
+	if hpf.dbLookupExtensionsConfig.LogsIndexEnabled {
+		historyRepArgs.LogsIndexStorer = hpf.store.GetStorer(dataRetriever.LogsIndexUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
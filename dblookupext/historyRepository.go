@@ -37,6 +37,8 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressTransactionsStorer   storage.Storer
+	LogsIndexStorer             storage.Storer
 }
 
 type historyRepository struct {
@@ -50,6 +52,8 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressTransactionsIndex   *addressTransactionsIndex
+	logsIndex                  *logsIndex
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -102,6 +106,16 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	// the transactions by address and the logs indexes are optional, being disabled when no storer is provided
+	var addressTxsIndex *addressTransactionsIndex
+	if !check.IfNil(arguments.AddressTransactionsStorer) {
+		addressTxsIndex = newAddressTransactionsIndex(arguments.AddressTransactionsStorer)
+	}
+	var logsIdx *logsIndex
+	if !check.IfNil(arguments.LogsIndexStorer) {
+		logsIdx = newLogsIndex(arguments.LogsIndexStorer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -117,6 +131,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		addressTransactionsIndex:                     addressTxsIndex,
+		logsIndex:                                    logsIdx,
 	}, nil
 }
 
@@ -125,6 +141,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	logs []*data.LogData) error {
@@ -171,6 +188,20 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	if hr.addressTransactionsIndex != nil {
+		err = hr.addressTransactionsIndex.recordBlock(blockHeader, body, txsFromPool, scrResultsFromPool)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.logsIndex != nil {
+		err = hr.logsIndex.recordBlock(blockHeaderHash, blockHeader, logs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -466,9 +497,42 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hr.addressTransactionsIndex != nil {
+		err := hr.addressTransactionsIndex.revertBlock(blockHeader)
+		if err != nil {
+			log.Warn("historyRepository.RevertBlock: cannot revert the transactions by address index",
+				"nonce", blockHeader.GetNonce(), "error", err.Error())
+		}
+	}
+	if hr.logsIndex != nil {
+		err := hr.logsIndex.revertBlock(blockHeader)
+		if err != nil {
+			log.Warn("historyRepository.RevertBlock: cannot revert the logs index",
+				"nonce", blockHeader.GetNonce(), "error", err.Error())
+		}
+	}
+
 	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
 }
 
+// GetTransactionsByAddress returns a page of the transactions sent or received by the provided address
+func (hr *historyRepository) GetTransactionsByAddress(address []byte, query AddressTransactionsQuery) (*AddressTransactionsPage, error) {
+	if hr.addressTransactionsIndex == nil {
+		return nil, ErrAddressTransactionsIndexDisabled
+	}
+
+	return hr.addressTransactionsIndex.getTransactions(address, query)
+}
+
+// GetLogs returns the smart contract events matching the provided query
+func (hr *historyRepository) GetLogs(query LogsQuery) (*LogsQueryResult, error) {
+	if hr.logsIndex == nil {
+		return nil, ErrLogsIndexDisabled
+	}
+
+	return hr.logsIndex.getLogs(query)
+}
+
 // GetESDTSupply will return the supply from the storage for the given token
 func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error) {
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
@@ -8,11 +8,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
@@ -108,7 +110,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +142,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -176,7 +178,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil,
+		nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +216,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +274,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +474,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +482,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +529,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +586,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +599,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +713,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil,
+				}, nil, nil, nil, nil,
 			)
 		}
 
@@ -741,3 +743,86 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+func TestHistoryRepository_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled index should error", func(t *testing.T) {
+		t.Parallel()
+
+		repo, err := NewHistoryRepository(createMockHistoryRepoArgs(0))
+		require.Nil(t, err)
+
+		page, err := repo.GetTransactionsByAddress([]byte("alice"), AddressTransactionsQuery{Size: 10})
+		require.Nil(t, page)
+		require.Equal(t, ErrAddressTransactionsIndexDisabled, err)
+	})
+	t.Run("should record and revert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHistoryRepoArgs(0)
+		args.AddressTransactionsStorer = testscommon.CreateMemUnit()
+		repo, err := NewHistoryRepository(args)
+		require.Nil(t, err)
+
+		header := &block.Header{Nonce: 4}
+		body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}}}}
+		txs := map[string]data.TransactionHandler{
+			"tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		}
+		err = repo.RecordBlock([]byte("headerHash"), header, body, txs, nil, nil, nil)
+		require.Nil(t, err)
+
+		page, err := repo.GetTransactionsByAddress([]byte("bob"), AddressTransactionsQuery{Size: 10})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(page.Transactions))
+		require.Equal(t, []byte("tx"), page.Transactions[0].TxHash)
+
+		// the supplies processor uses a storer stub, only the address index revert is checked
+		_ = repo.RevertBlock(header, body)
+
+		page, err = repo.GetTransactionsByAddress([]byte("bob"), AddressTransactionsQuery{Size: 10})
+		require.Nil(t, err)
+		require.Equal(t, 0, len(page.Transactions))
+	})
+}
+
+func TestHistoryRepository_GetLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled index should error", func(t *testing.T) {
+		t.Parallel()
+
+		repo, err := NewHistoryRepository(createMockHistoryRepoArgs(0))
+		require.Nil(t, err)
+
+		result, err := repo.GetLogs(LogsQuery{FromNonce: 1, ToNonce: 1})
+		require.Nil(t, result)
+		require.Equal(t, ErrLogsIndexDisabled, err)
+	})
+	t.Run("should record logs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHistoryRepoArgs(0)
+		args.LogsIndexStorer = testscommon.CreateMemUnit()
+		repo, err := NewHistoryRepository(args)
+		require.Nil(t, err)
+
+		logs := []*data.LogData{
+			{
+				TxHash: "tx",
+				LogHandler: &transaction.Log{
+					Address: []byte("alice"),
+					Events:  []*transaction.Event{{Address: []byte("sc"), Identifier: []byte("transfer")}},
+				},
+			},
+		}
+		err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 4}, &block.Body{}, nil, nil, nil, logs)
+		require.Nil(t, err)
+
+		result, err := repo.GetLogs(LogsQuery{FromNonce: 4, ToNonce: 4})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(result.Events))
+		require.Equal(t, []byte("headerHash"), result.Events[0].BlockHash)
+	})
+}
@@ -0,0 +1,186 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// LogsQuery holds the filters applied when searching smart contract events, similar to eth_getLogs. The nonce bounds
+// are inclusive. An event matches if its address is one of Addresses (or Addresses is empty), its identifier equals
+// Identifier (or Identifier is empty) and, for every position i of Topics, its i-th topic is one of Topics[i]. An
+// empty alternatives list at a position matches any topic. FromIndex skips the events of the FromNonce block recorded
+// before the provided position, so a truncated query is continued with the NextNonce and NextIndex of its result
+type LogsQuery struct {
+	FromNonce  uint64
+	FromIndex  uint32
+	ToNonce    uint64
+	Addresses  [][]byte
+	Identifier []byte
+	Topics     [][][]byte
+	MaxResults uint32
+}
+
+// LogEvent is a smart contract event together with the block and transaction that generated it
+type LogEvent struct {
+	TxHash     []byte
+	LogAddress []byte
+	EventIndex uint32
+	Address    []byte
+	Identifier []byte
+	Topics     [][]byte
+	Data       []byte
+	BlockHash  []byte
+	BlockNonce uint64
+	Round      uint64
+	Timestamp  uint64
+	Epoch      uint32
+}
+
+// LogsQueryResult holds the events matching a logs query, in the order they were recorded. Truncated is set when
+// more events than MaxResults matched, in which case NextNonce and NextIndex point to the first event not returned:
+// the nonce of its block and its position among the events recorded for that block
+type LogsQueryResult struct {
+	Events    []*LogEvent
+	Truncated bool
+	NextNonce uint64
+	NextIndex uint32
+}
+
+// blockLogEvents holds all the events recorded for a block
+type blockLogEvents struct {
+	blockHash []byte
+	round     uint64
+	timestamp uint64
+	epoch     uint32
+	events    []*LogEvent
+}
+
+var errInvalidLogEventsBytes = errors.New("invalid log events bytes")
+
+// encoded layout: block hash | round (8) | timestamp (8) | epoch (4) | num events (4) | events, each event being
+// tx hash | log address | event index (4) | address | identifier | num topics (4) | topics | data. The byte slices
+// are prefixed with their length (4)
+func (ble *blockLogEvents) encode() []byte {
+	buff := make([]byte, 0)
+	buff = appendBytes(buff, ble.blockHash)
+	buff = appendUint64(buff, ble.round)
+	buff = appendUint64(buff, ble.timestamp)
+	buff = appendUint32(buff, ble.epoch)
+	buff = appendUint32(buff, uint32(len(ble.events)))
+	for _, event := range ble.events {
+		buff = appendBytes(buff, event.TxHash)
+		buff = appendBytes(buff, event.LogAddress)
+		buff = appendUint32(buff, event.EventIndex)
+		buff = appendBytes(buff, event.Address)
+		buff = appendBytes(buff, event.Identifier)
+		buff = appendUint32(buff, uint32(len(event.Topics)))
+		for _, topic := range event.Topics {
+			buff = appendBytes(buff, topic)
+		}
+		buff = appendBytes(buff, event.Data)
+	}
+
+	return buff
+}
+
+func decodeBlockLogEvents(nonce uint64, buff []byte) (*blockLogEvents, error) {
+	reader := &bytesReader{buff: buff}
+	ble := &blockLogEvents{
+		blockHash: reader.readBytes(),
+		round:     reader.readUint64(),
+		timestamp: reader.readUint64(),
+		epoch:     reader.readUint32(),
+	}
+
+	numEvents := reader.readUint32()
+	if reader.err != nil {
+		return nil, reader.err
+	}
+
+	ble.events = make([]*LogEvent, 0, numEvents)
+	for i := uint32(0); i < numEvents && reader.err == nil; i++ {
+		event := &LogEvent{
+			TxHash:     reader.readBytes(),
+			LogAddress: reader.readBytes(),
+			EventIndex: reader.readUint32(),
+			Address:    reader.readBytes(),
+			Identifier: reader.readBytes(),
+			BlockHash:  ble.blockHash,
+			BlockNonce: nonce,
+			Round:      ble.round,
+			Timestamp:  ble.timestamp,
+			Epoch:      ble.epoch,
+		}
+
+		numTopics := reader.readUint32()
+		for j := uint32(0); j < numTopics && reader.err == nil; j++ {
+			event.Topics = append(event.Topics, reader.readBytes())
+		}
+		event.Data = reader.readBytes()
+
+		ble.events = append(ble.events, event)
+	}
+	if reader.err != nil {
+		return nil, reader.err
+	}
+	if len(reader.buff) != 0 {
+		return nil, errInvalidLogEventsBytes
+	}
+
+	return ble, nil
+}
+
+func appendBytes(buff []byte, value []byte) []byte {
+	buff = appendUint32(buff, uint32(len(value)))
+
+	return append(buff, value...)
+}
+
+// bytesReader consumes the values written with the append functions, retaining the first error
+type bytesReader struct {
+	buff []byte
+	err  error
+}
+
+func (br *bytesReader) next(size int) []byte {
+	if br.err != nil {
+		return nil
+	}
+	if size < 0 || len(br.buff) < size {
+		br.err = errInvalidLogEventsBytes
+		return nil
+	}
+
+	value := br.buff[:size]
+	br.buff = br.buff[size:]
+
+	return value
+}
+
+func (br *bytesReader) readUint64() uint64 {
+	value := br.next(8)
+	if value == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(value)
+}
+
+func (br *bytesReader) readUint32() uint32 {
+	value := br.next(4)
+	if value == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(value)
+}
+
+func (br *bytesReader) readBytes() []byte {
+	size := br.readUint32()
+	value := br.next(int(size))
+	if value == nil {
+		return nil
+	}
+
+	return append([]byte{}, value...)
+}
@@ -0,0 +1,396 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	logsBlockPrefix          = 'b'
+	logsAddressCounterPrefix = 'k'
+	logsAddressNoncePrefix   = 'n'
+
+	// maxLogsQueryBlocksRange limits the range of nonces of a query. A query filtered by address loads only the
+	// relevant blocks, but it still has to search the nonces lists of all the provided addresses
+	maxLogsQueryBlocksRange = 10000
+)
+
+// logsIndex keeps the smart contract events of every block under the block's nonce. For every contract address that
+// emitted events, the list of the nonces of those blocks is also kept, so a query filtered by address only loads the
+// relevant blocks instead of the whole range. Recording a block first removes the events previously recorded for the
+// block's nonce, so a block without events of a fork does not keep the events of the replaced block
+type logsIndex struct {
+	mut    sync.RWMutex
+	storer storage.Storer
+}
+
+func newLogsIndex(storer storage.Storer) *logsIndex {
+	return &logsIndex{
+		storer: storer,
+	}
+}
+
+func (li *logsIndex) recordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	sortedLogs := make([]*data.LogData, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+		sortedLogs = append(sortedLogs, logData)
+	}
+
+	// the logs are provided in no particular order, so they are sorted in order to obtain the same index on all nodes
+	sort.SliceStable(sortedLogs, func(i, j int) bool {
+		return sortedLogs[i].TxHash < sortedLogs[j].TxHash
+	})
+
+	ble := &blockLogEvents{
+		blockHash: blockHeaderHash,
+		round:     blockHeader.GetRound(),
+		timestamp: blockHeader.GetTimeStamp(),
+		epoch:     blockHeader.GetEpoch(),
+		events:    make([]*LogEvent, 0),
+	}
+	addresses := make([]string, 0)
+	seenAddresses := make(map[string]struct{})
+	for _, logData := range sortedLogs {
+		for index, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			ble.events = append(ble.events, &LogEvent{
+				TxHash:     []byte(logData.TxHash),
+				LogAddress: logData.GetAddress(),
+				EventIndex: uint32(index),
+				Address:    event.GetAddress(),
+				Identifier: event.GetIdentifier(),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+			})
+
+			_, seen := seenAddresses[string(event.GetAddress())]
+			if !seen {
+				seenAddresses[string(event.GetAddress())] = struct{}{}
+				addresses = append(addresses, string(event.GetAddress()))
+			}
+		}
+	}
+
+	li.mut.Lock()
+	defer li.mut.Unlock()
+
+	nonce := blockHeader.GetNonce()
+	err := li.removeBlock(nonce)
+	if err != nil {
+		return err
+	}
+	if len(ble.events) == 0 {
+		return nil
+	}
+
+	err = li.storer.Put(logsBlockKey(nonce), ble.encode())
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = li.appendNonce([]byte(address), nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (li *logsIndex) appendNonce(address []byte, nonce uint64) error {
+	count, err := li.getCount(address)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		lastNonce, errGet := li.getNonce(address, count-1)
+		if errGet != nil {
+			return errGet
+		}
+		if lastNonce == nonce {
+			return nil
+		}
+	}
+
+	err = li.storer.Put(logsAddressNonceKey(address, count), encodeUint64(nonce))
+	if err != nil {
+		return err
+	}
+
+	return li.storer.Put(logsAddressCounterKey(address), encodeUint64(count+1))
+}
+
+// revertBlock removes the events recorded for the reverted block's nonce
+func (li *logsIndex) revertBlock(blockHeader data.HeaderHandler) error {
+	li.mut.Lock()
+	defer li.mut.Unlock()
+
+	return li.removeBlock(blockHeader.GetNonce())
+}
+
+// removeBlock removes the events recorded for the provided nonce, together with the nonce from the lists of the
+// addresses that emitted them
+func (li *logsIndex) removeBlock(nonce uint64) error {
+	ble, err := li.getBlockLogEvents(nonce)
+	if err != nil || ble == nil {
+		return err
+	}
+
+	seenAddresses := make(map[string]struct{})
+	for _, event := range ble.events {
+		_, seen := seenAddresses[string(event.Address)]
+		if seen {
+			continue
+		}
+		seenAddresses[string(event.Address)] = struct{}{}
+
+		err = li.removeNoncesFrom(event.Address, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return li.storer.Remove(logsBlockKey(nonce))
+}
+
+func (li *logsIndex) removeNoncesFrom(address []byte, nonce uint64) error {
+	count, err := li.getCount(address)
+	if err != nil {
+		return err
+	}
+
+	for count > 0 {
+		lastNonce, errGet := li.getNonce(address, count-1)
+		if errGet != nil {
+			return errGet
+		}
+		if lastNonce < nonce {
+			break
+		}
+
+		count--
+		err = li.storer.Remove(logsAddressNonceKey(address, count))
+		if err != nil {
+			return err
+		}
+	}
+
+	return li.storer.Put(logsAddressCounterKey(address), encodeUint64(count))
+}
+
+func (li *logsIndex) getLogs(query LogsQuery) (*LogsQueryResult, error) {
+	if query.ToNonce < query.FromNonce {
+		return nil, ErrInvalidLogsQueryRange
+	}
+	if query.ToNonce-query.FromNonce >= maxLogsQueryBlocksRange {
+		return nil, ErrLogsQueryRangeTooLarge
+	}
+
+	li.mut.RLock()
+	defer li.mut.RUnlock()
+
+	result := &LogsQueryResult{
+		Events: make([]*LogEvent, 0),
+	}
+
+	nonces, err := li.getCandidateNonces(query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nonce := range nonces {
+		ble, errGet := li.getBlockLogEvents(nonce)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if ble == nil {
+			continue
+		}
+
+		for position, event := range ble.events {
+			if nonce == query.FromNonce && position < int(query.FromIndex) {
+				continue
+			}
+			if !eventMatchesQuery(event, query) {
+				continue
+			}
+			if query.MaxResults > 0 && len(result.Events) >= int(query.MaxResults) {
+				result.Truncated = true
+				result.NextNonce = nonce
+				result.NextIndex = uint32(position)
+				return result, nil
+			}
+
+			result.Events = append(result.Events, event)
+		}
+	}
+
+	return result, nil
+}
+
+// getCandidateNonces returns, in ascending order, the nonces of the blocks that might hold matching events
+func (li *logsIndex) getCandidateNonces(query LogsQuery) ([]uint64, error) {
+	if len(query.Addresses) == 0 {
+		nonces := make([]uint64, 0, query.ToNonce-query.FromNonce+1)
+		for nonce := query.FromNonce; ; nonce++ {
+			nonces = append(nonces, nonce)
+			if nonce == query.ToNonce {
+				break
+			}
+		}
+
+		return nonces, nil
+	}
+
+	uniqueNonces := make(map[uint64]struct{})
+	for _, address := range query.Addresses {
+		err := li.collectNonces(address, query.FromNonce, query.ToNonce, uniqueNonces)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nonces := make([]uint64, 0, len(uniqueNonces))
+	for nonce := range uniqueNonces {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool {
+		return nonces[i] < nonces[j]
+	})
+
+	return nonces, nil
+}
+
+func (li *logsIndex) collectNonces(address []byte, fromNonce uint64, toNonce uint64, nonces map[uint64]struct{}) error {
+	count, err := li.getCount(address)
+	if err != nil {
+		return err
+	}
+
+	var errSearch error
+	start := sort.Search(int(count), func(i int) bool {
+		nonce, errGet := li.getNonce(address, uint64(i))
+		if errGet != nil {
+			errSearch = errGet
+			return true
+		}
+
+		return nonce >= fromNonce
+	})
+	if errSearch != nil {
+		return errSearch
+	}
+
+	for i := uint64(start); i < count; i++ {
+		nonce, errGet := li.getNonce(address, i)
+		if errGet != nil {
+			return errGet
+		}
+		if nonce > toNonce {
+			break
+		}
+
+		nonces[nonce] = struct{}{}
+	}
+
+	return nil
+}
+
+func eventMatchesQuery(event *LogEvent, query LogsQuery) bool {
+	if len(query.Addresses) > 0 && !containsBytes(query.Addresses, event.Address) {
+		return false
+	}
+	if len(query.Identifier) > 0 && string(query.Identifier) != string(event.Identifier) {
+		return false
+	}
+	for position, alternatives := range query.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if position >= len(event.Topics) || !containsBytes(alternatives, event.Topics[position]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsBytes(values [][]byte, value []byte) bool {
+	for _, v := range values {
+		if string(v) == string(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (li *logsIndex) getBlockLogEvents(nonce uint64) (*blockLogEvents, error) {
+	key := logsBlockKey(nonce)
+	if li.storer.Has(key) != nil {
+		return nil, nil
+	}
+
+	buff, err := li.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeBlockLogEvents(nonce, buff)
+}
+
+func (li *logsIndex) getCount(address []byte) (uint64, error) {
+	key := logsAddressCounterKey(address)
+	if li.storer.Has(key) != nil {
+		return 0, nil
+	}
+
+	buff, err := li.storer.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(buff) != 8 {
+		return 0, errInvalidLogEventsBytes
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func (li *logsIndex) getNonce(address []byte, position uint64) (uint64, error) {
+	buff, err := li.storer.Get(logsAddressNonceKey(address, position))
+	if err != nil {
+		return 0, err
+	}
+	if len(buff) != 8 {
+		return 0, errInvalidLogEventsBytes
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func logsBlockKey(nonce uint64) []byte {
+	return appendUint64([]byte{logsBlockPrefix}, nonce)
+}
+
+func logsAddressCounterKey(address []byte) []byte {
+	return append([]byte{logsAddressCounterPrefix}, address...)
+}
+
+func logsAddressNonceKey(address []byte, position uint64) []byte {
+	key := append([]byte{logsAddressNoncePrefix}, address...)
+
+	return appendUint64(key, position)
+}
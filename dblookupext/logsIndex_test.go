@@ -0,0 +1,278 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash: txHash,
+		LogHandler: &transaction.Log{
+			Address: []byte("caller"),
+			Events:  events,
+		},
+	}
+}
+
+func createEvent(address string, identifier string, topics ...string) *transaction.Event {
+	event := &transaction.Event{
+		Address:    []byte(address),
+		Identifier: []byte(identifier),
+		Data:       []byte("data"),
+	}
+	for _, topic := range topics {
+		event.Topics = append(event.Topics, []byte(topic))
+	}
+
+	return event
+}
+
+func recordLogs(t *testing.T, index *logsIndex, nonce uint64, logs ...*data.LogData) {
+	header := &block.Header{Nonce: nonce, Round: nonce + 1, TimeStamp: nonce * 10, Epoch: 2}
+
+	err := index.recordBlock([]byte("hash"), header, logs)
+	require.Nil(t, err)
+}
+
+func getIdentifiers(result *LogsQueryResult) []string {
+	identifiers := make([]string, 0, len(result.Events))
+	for _, event := range result.Events {
+		identifiers = append(identifiers, string(event.Identifier))
+	}
+
+	return identifiers
+}
+
+func TestBlockLogEvents_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	ble := &blockLogEvents{
+		blockHash: []byte("blockHash"),
+		round:     8,
+		timestamp: 80,
+		epoch:     1,
+		events: []*LogEvent{
+			{
+				TxHash:     []byte("tx"),
+				LogAddress: []byte("caller"),
+				EventIndex: 3,
+				Address:    []byte("contract"),
+				Identifier: []byte("transfer"),
+				Topics:     [][]byte{[]byte("a"), {}},
+				Data:       []byte("data"),
+			},
+		},
+	}
+
+	decoded, err := decodeBlockLogEvents(7, ble.encode())
+	require.Nil(t, err)
+	require.Equal(t, 1, len(decoded.events))
+
+	expectedEvent := *ble.events[0]
+	expectedEvent.BlockHash = []byte("blockHash")
+	expectedEvent.BlockNonce = 7
+	expectedEvent.Round = 8
+	expectedEvent.Timestamp = 80
+	expectedEvent.Epoch = 1
+	assert.Equal(t, &expectedEvent, decoded.events[0])
+
+	encoded := ble.encode()
+	_, err = decodeBlockLogEvents(7, encoded[:len(encoded)-1])
+	assert.Equal(t, errInvalidLogEventsBytes, err)
+
+	_, err = decodeBlockLogEvents(7, append(encoded, 0))
+	assert.Equal(t, errInvalidLogEventsBytes, err)
+}
+
+func TestLogsIndex_RecordBlockAndQuery(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1,
+		createLogData("tx2", createEvent("sc1", "swap", "alice", "bob")),
+		createLogData("tx1", createEvent("sc1", "transfer", "alice"), createEvent("sc2", "transfer", "bob")),
+	)
+	recordLogs(t, index, 2, createLogData("tx3", createEvent("sc2", "transfer", "alice", "carol")))
+
+	result, err := index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2})
+	require.Nil(t, err)
+	require.Equal(t, 4, len(result.Events))
+	assert.False(t, result.Truncated)
+
+	first := result.Events[0]
+	assert.Equal(t, []byte("tx1"), first.TxHash)
+	assert.Equal(t, []byte("caller"), first.LogAddress)
+	assert.Equal(t, uint32(0), first.EventIndex)
+	assert.Equal(t, []byte("sc1"), first.Address)
+	assert.Equal(t, []byte("hash"), first.BlockHash)
+	assert.Equal(t, uint64(1), first.BlockNonce)
+	assert.Equal(t, uint64(2), first.Round)
+	assert.Equal(t, uint64(10), first.Timestamp)
+	assert.Equal(t, uint32(2), first.Epoch)
+	assert.Equal(t, uint32(1), result.Events[1].EventIndex)
+	assert.Equal(t, []byte("tx2"), result.Events[2].TxHash)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 0, ToNonce: 10, Addresses: [][]byte{[]byte("sc2")}})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(result.Events))
+	assert.Equal(t, uint64(1), result.Events[0].BlockNonce)
+	assert.Equal(t, uint64(2), result.Events[1].BlockNonce)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 2, ToNonce: 10, Addresses: [][]byte{[]byte("sc1"), []byte("sc2")}})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"transfer"}, getIdentifiers(result))
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2, Identifier: []byte("swap")})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"swap"}, getIdentifiers(result))
+}
+
+func TestLogsIndex_TopicsFilter(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1, createLogData("tx",
+		createEvent("sc", "e1", "alice", "bob"),
+		createEvent("sc", "e2", "bob", "alice"),
+		createEvent("sc", "e3", "alice"),
+	))
+
+	alice, bob, carol := []byte("alice"), []byte("bob"), []byte("carol")
+	testCases := []struct {
+		topics   [][][]byte
+		expected []string
+	}{
+		{topics: nil, expected: []string{"e1", "e2", "e3"}},
+		{topics: [][][]byte{{alice}}, expected: []string{"e1", "e3"}},
+		{topics: [][][]byte{{alice, bob}}, expected: []string{"e1", "e2", "e3"}},
+		{topics: [][][]byte{nil, {alice}}, expected: []string{"e2"}},
+		{topics: [][][]byte{{alice}, {bob}}, expected: []string{"e1"}},
+		{topics: [][][]byte{{alice}, nil}, expected: []string{"e1", "e3"}},
+		{topics: [][][]byte{{carol}}, expected: []string{}},
+	}
+
+	for _, tc := range testCases {
+		result, err := index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 1, Topics: tc.topics})
+		require.Nil(t, err)
+		assert.Equal(t, tc.expected, getIdentifiers(result), "topics %v", tc.topics)
+	}
+}
+
+func TestLogsIndex_MaxResults(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1, createLogData("tx1", createEvent("sc", "e1"), createEvent("sc", "e2")))
+	recordLogs(t, index, 2, createLogData("tx2", createEvent("sc", "e3")))
+
+	result, err := index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2, MaxResults: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e1", "e2"}, getIdentifiers(result))
+	assert.True(t, result.Truncated)
+	assert.Equal(t, uint64(2), result.NextNonce)
+	assert.Equal(t, uint32(0), result.NextIndex)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2, MaxResults: 3})
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(result.Events))
+	assert.False(t, result.Truncated)
+}
+
+func TestLogsIndex_ContinueTruncatedQueryInsideABlock(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1, createLogData("tx1", createEvent("sc", "e1"), createEvent("sc", "e2"), createEvent("sc", "e3")))
+	recordLogs(t, index, 2, createLogData("tx2", createEvent("sc", "e4")))
+
+	query := LogsQuery{FromNonce: 1, ToNonce: 2, MaxResults: 2}
+	result, err := index.getLogs(query)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e1", "e2"}, getIdentifiers(result))
+	require.True(t, result.Truncated)
+	assert.Equal(t, uint64(1), result.NextNonce)
+	assert.Equal(t, uint32(2), result.NextIndex)
+
+	query.FromNonce = result.NextNonce
+	query.FromIndex = result.NextIndex
+	result, err = index.getLogs(query)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e3", "e4"}, getIdentifiers(result))
+	assert.False(t, result.Truncated)
+}
+
+func TestLogsIndex_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+
+	result, err := index.getLogs(LogsQuery{FromNonce: 2, ToNonce: 1})
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInvalidLogsQueryRange, err)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: maxLogsQueryBlocksRange + 1})
+	assert.Nil(t, result)
+	assert.Equal(t, ErrLogsQueryRangeTooLarge, err)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: maxLogsQueryBlocksRange + 1, Addresses: [][]byte{[]byte("sc")}})
+	assert.Nil(t, result)
+	assert.Equal(t, ErrLogsQueryRangeTooLarge, err)
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: maxLogsQueryBlocksRange, Addresses: [][]byte{[]byte("sc")}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result.Events))
+}
+
+func TestLogsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1, createLogData("tx1", createEvent("sc1", "e1")))
+	recordLogs(t, index, 2, createLogData("tx2", createEvent("sc1", "e2"), createEvent("sc2", "e3")))
+
+	err := index.revertBlock(&block.Header{Nonce: 2})
+	require.Nil(t, err)
+
+	result, err := index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e1"}, getIdentifiers(result))
+
+	count, err := index.getCount([]byte("sc2"))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	recordLogs(t, index, 2, createLogData("tx4", createEvent("sc1", "e4")))
+
+	result, err = index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2, Addresses: [][]byte{[]byte("sc1")}})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e1", "e4"}, getIdentifiers(result))
+
+	err = index.revertBlock(&block.Header{Nonce: 5})
+	assert.Nil(t, err)
+}
+
+func TestLogsIndex_RecordingABlockWithoutEventsShouldRemoveTheOldEvents(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(testscommon.CreateMemUnit())
+	recordLogs(t, index, 1, createLogData("tx1", createEvent("sc1", "e1")))
+	recordLogs(t, index, 2, createLogData("tx2", createEvent("sc2", "e2")))
+
+	// the block of the other fork has no events
+	recordLogs(t, index, 2)
+
+	result, err := index.getLogs(LogsQuery{FromNonce: 1, ToNonce: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"e1"}, getIdentifiers(result))
+
+	count, err := index.getCount([]byte("sc2"))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), count)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -54,3 +55,9 @@ type NodeTypeProviderHandler interface {
 	GetType() core.NodeType
 	IsInterfaceNil() bool
 }
+
+// StateArchiveImporter defines the methods to import a trie from a local state archive
+type StateArchiveImporter interface {
+	ImportTrie(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error
+	IsInterfaceNil() bool
+}
@@ -33,6 +33,7 @@ import (
 	disabledInterceptors "github.com/ElrondNetwork/elrond-go/process/interceptors/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/archive"
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
@@ -101,6 +102,7 @@ type epochStartBootstrap struct {
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
+	stateArchiveImporter       StateArchiveImporter
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -230,6 +232,18 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 	epochStartProvider.trieContainer = state.NewDataTriesHolder()
 	epochStartProvider.trieStorageManagers = make(map[string]common.StorageManager)
 
+	stateArchivePath := epochStartProvider.generalConfig.TrieSync.StateArchivePath
+	if len(stateArchivePath) > 0 {
+		epochStartProvider.stateArchiveImporter, err = archive.NewImporter(archive.ArgsImporter{
+			ArchivePath: stateArchivePath,
+			Marshalizer: args.CoreComponentsHolder.InternalMarshalizer(),
+			Hasher:      args.CoreComponentsHolder.Hasher(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the state archive from %s", err, stateArchivePath)
+		}
+	}
+
 	if epochStartProvider.generalConfig.Hardfork.AfterHardFork {
 		epochStartProvider.startEpoch = epochStartProvider.generalConfig.Hardfork.StartEpoch
 		epochStartProvider.baseData.lastEpoch = epochStartProvider.startEpoch
@@ -1024,15 +1038,19 @@ func (e *epochStartBootstrap) updateDataForScheduled(
 }
 
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
+	e.mutTrieStorageManagers.RUnlock()
+
+	if e.importTrieFromStateArchive(factory.UserAccountTrie, rootHash, trieStorageManager) {
+		return nil
+	}
+
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
 		return err
 	}
 
-	e.mutTrieStorageManagers.RLock()
-	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
-	e.mutTrieStorageManagers.RUnlock()
-
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
@@ -1096,6 +1114,10 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[factory.PeerAccountTrie]
 	e.mutTrieStorageManagers.RUnlock()
 
+	if e.importTrieFromStateArchive(factory.PeerAccountTrie, rootHash, peerTrieStorageManager) {
+		return nil
+	}
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
@@ -1122,6 +1144,35 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	return nil
 }
 
+// importTrieFromStateArchive returns true if the trie was fully imported from the configured state archive. On any
+// failure, including a root hash that does not match the archived one, the trie will be synced from the network
+func (e *epochStartBootstrap) importTrieFromStateArchive(trieName string, rootHash []byte, storageManager common.StorageManager) bool {
+	if check.IfNil(e.stateArchiveImporter) || check.IfNil(storageManager) {
+		return false
+	}
+
+	// the user accounts trie is only usable together with the data tries of the accounts, which the network sync would
+	// also fetch, so an archive exported without them is not imported
+	requireDataTries := trieName == factory.UserAccountTrie
+
+	log.Info("start in epoch bootstrap: importing trie from state archive", "trie", trieName, "root hash", rootHash)
+	err := e.stateArchiveImporter.ImportTrie(trieName, rootHash, requireDataTries, storageManager)
+	if err != nil {
+		log.Warn("start in epoch bootstrap: could not import trie from state archive, syncing it from the network",
+			"trie", trieName, "error", err)
+		return false
+	}
+
+	err = storageManager.Put([]byte(common.TrieSyncedKey), []byte(common.TrieSyncedVal))
+	if err != nil {
+		log.Warn("error while putting trieSynced value into main storer after import", "error", err)
+	}
+
+	log.Info("start in epoch bootstrap: trie imported from state archive", "trie", trieName)
+
+	return true
+}
+
 func (e *epochStartBootstrap) createRequestHandler() error {
 	dataPacker, err := partitioning.NewSimpleDataPacker(e.coreComponentsHolder.InternalMarshalizer())
 	if err != nil {
@@ -1033,6 +1033,96 @@ func TestSyncUserAccountsState(t *testing.T) {
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
+func TestNewEpochStartBootstrap_InvalidStateArchiveShouldErr(t *testing.T) {
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+	args.GeneralConfig.TrieSync.StateArchivePath = t.TempDir()
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	assert.True(t, check.IfNil(epochStartProvider))
+	assert.NotNil(t, err)
+}
+
+func TestSyncUserAccountsState_FromStateArchive(t *testing.T) {
+	rootHash := []byte("rootHash")
+
+	t.Run("imported trie should not sync from network", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+		syncedValueWritten := false
+		storageManager := &testscommon.StorageManagerStub{
+			PutCalled: func(key []byte, val []byte) error {
+				syncedValueWritten = string(key) == common.TrieSyncedKey && string(val) == common.TrieSyncedVal
+				return nil
+			},
+		}
+		epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+			factory.UserAccountTrie: storageManager,
+		}
+		epochStartProvider.stateArchiveImporter = &mock.StateArchiveImporterStub{
+			ImportTrieCalled: func(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error {
+				assert.Equal(t, factory.UserAccountTrie, trieName)
+				assert.Equal(t, rootHash, expectedRootHash)
+				assert.True(t, requireDataTries)
+				assert.True(t, db == storageManager)
+				return nil
+			},
+		}
+
+		err := epochStartProvider.syncUserAccountsState(rootHash)
+		assert.Nil(t, err)
+		assert.True(t, syncedValueWritten)
+	})
+	t.Run("import failure should sync from network", func(t *testing.T) {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		epochStartProvider, _ := NewEpochStartBootstrap(args)
+		epochStartProvider.shardCoordinator = mock.NewMultipleShardsCoordinatorMock()
+		epochStartProvider.dataPool = &dataRetrieverMock.PoolsHolderStub{
+			TrieNodesCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{}
+			},
+		}
+		epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+			factory.UserAccountTrie: &testscommon.StorageManagerStub{},
+		}
+		importCalled := false
+		epochStartProvider.stateArchiveImporter = &mock.StateArchiveImporterStub{
+			ImportTrieCalled: func(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error {
+				importCalled = true
+				return errors.New("root hash mismatch")
+			},
+		}
+
+		err := epochStartProvider.syncUserAccountsState(rootHash)
+		assert.True(t, importCalled)
+		assert.Equal(t, state.ErrNilRequestHandler, err)
+	})
+}
+
+func TestSyncValidatorAccountsState_FromStateArchive(t *testing.T) {
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+	epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+		factory.PeerAccountTrie: &testscommon.StorageManagerStub{},
+	}
+	importedTrieName := ""
+	epochStartProvider.stateArchiveImporter = &mock.StateArchiveImporterStub{
+		ImportTrieCalled: func(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error {
+			importedTrieName = trieName
+			assert.False(t, requireDataTries)
+			return nil
+		},
+	}
+
+	err := epochStartProvider.syncValidatorAccountsState([]byte("rootHash"))
+	assert.Nil(t, err)
+	assert.Equal(t, factory.PeerAccountTrie, importedTrieName)
+}
+
 func TestRequestAndProcessForShard_ShouldFail(t *testing.T) {
 	notarizedShardHeaderHash := []byte("notarizedShardHeaderHash")
 	prevShardHeaderHash := []byte("prevShardHeaderHash")
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// StateArchiveImporterStub -
+type StateArchiveImporterStub struct {
+	ImportTrieCalled func(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error
+}
+
+// ImportTrie -
+func (sais *StateArchiveImporterStub) ImportTrie(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error {
+	if sais.ImportTrieCalled != nil {
+		return sais.ImportTrieCalled(trieName, expectedRootHash, requireDataTries, db)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (sais *StateArchiveImporterStub) IsInterfaceNil() bool {
+	return sais == nil
+}
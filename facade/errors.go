@@ -29,5 +29,8 @@ var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
+// ErrNilHealthService signals that a nil health service has been provided
+var ErrNilHealthService = errors.New("nil health service")
+
 // ErrEmptyRootHash signals that the current root hash is empty
 var ErrEmptyRootHash = errors.New("empty current root hash")
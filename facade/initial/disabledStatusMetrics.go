@@ -1,5 +1,7 @@
 package initial
 
+import "time"
+
 const responseKey = "error"
 const responseValue = "node is starting"
 
@@ -27,6 +29,15 @@ func (d *disabledStatusMetricsHandler) StatusMetricsWithoutP2PPrometheusString()
 	return responseValue
 }
 
+// StatusMetricsOpenMetricsString returns the message that signals that the node is starting
+func (d *disabledStatusMetricsHandler) StatusMetricsOpenMetricsString() string {
+	return responseValue
+}
+
+// ObserveApiRouteDuration does nothing
+func (d *disabledStatusMetricsHandler) ObserveApiRouteDuration(_ string, _ time.Duration) {
+}
+
 // EconomicsMetrics returns a default response map
 func (d *disabledStatusMetricsHandler) EconomicsMetrics() map[string]interface{} {
 	return getReturnMap()
@@ -10,10 +10,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -58,6 +60,16 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof returns nil and error
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetRangeProof returns nil and error
+func (inf *initialNodeFacade) GetRangeProof(_ string, _ string, _ string, _ int) (*common.GetRangeProofResponse, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -74,17 +86,17 @@ func (inf *initialNodeFacade) RestApiInterface() string {
 }
 
 // GetBalance returns nil and error
-func (inf *initialNodeFacade) GetBalance(_ string) (*big.Int, error) {
+func (inf *initialNodeFacade) GetBalance(_ string, _ common.AccountQueryOptions) (*big.Int, error) {
 	return nil, errNodeStarting
 }
 
 // GetUsername returns empty string and error
-func (inf *initialNodeFacade) GetUsername(_ string) (string, error) {
+func (inf *initialNodeFacade) GetUsername(_ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
 // GetValueForKey returns an empty string and error
-func (inf *initialNodeFacade) GetValueForKey(_ string, _ string) (string, error) {
+func (inf *initialNodeFacade) GetValueForKey(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
@@ -98,6 +110,21 @@ func (inf *initialNodeFacade) GetAllESDTTokens(_ string) (map[string]*esdt.ESDig
 	return nil, errNodeStarting
 }
 
+// GetTransactionsByAddress returns nil and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetLogs returns nil and error
+func (inf *initialNodeFacade) GetLogs(_ common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+	return nil, errNodeStarting
+}
+
+// Subscribe returns nil and error
+func (inf *initialNodeFacade) Subscribe(_ common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+	return nil, errNodeStarting
+}
+
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
 func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string) ([]string, error) {
 	return nil, errNodeStarting
@@ -147,7 +174,7 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 	return nil, errNodeStarting
 }
 
@@ -156,13 +183,23 @@ func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.Api
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPool returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPool(_ common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolCounts returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error) {
+	return nil, errNodeStarting
+}
+
 // ComputeTransactionGasLimit returns 0 and error
 func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
 }
 
 // GetAccount returns nil and error
-func (inf *initialNodeFacade) GetAccount(_ string) (api.AccountResponse, error) {
+func (inf *initialNodeFacade) GetAccount(_ string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 	return api.AccountResponse{}, errNodeStarting
 }
 
@@ -196,6 +233,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+	return nil, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -266,8 +308,40 @@ func (inf *initialNodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 	return uint32(0)
 }
 
+// GetLivenessReport returns a report with the up status, as the node process is running
+func (inf *initialNodeFacade) GetLivenessReport() common.HealthReport {
+	return common.HealthReport{
+		Status:     common.HealthStatusUp,
+		Components: make([]common.ComponentHealthStatus, 0),
+	}
+}
+
+// GetReadinessReport returns a report with the down status, as the node is still starting
+func (inf *initialNodeFacade) GetReadinessReport() common.HealthReport {
+	return common.HealthReport{
+		Status: common.HealthStatusDown,
+		Components: []common.ComponentHealthStatus{
+			{
+				Name:   "node",
+				Status: common.HealthStatusDown,
+				Reason: errNodeStarting.Error(),
+			},
+		},
+	}
+}
+
+// GetHealthProfiles returns nil and error
+func (inf *initialNodeFacade) GetHealthProfiles() ([]common.HealthProfileInfo, error) {
+	return nil, errNodeStarting
+}
+
+// GetHealthProfile returns nil and error
+func (inf *initialNodeFacade) GetHealthProfile(_ string) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
 // GetKeyValuePairs nil map
-func (inf *initialNodeFacade) GetKeyValuePairs(_ string) (map[string]string, error) {
+func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -281,6 +355,26 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, errNodeStarting
 }
 
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals(_ string) ([]*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ string) (*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotes returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotes(_ string) ([]*common.GovernanceVote, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationPortfolio returns nil and error
+func (inf *initialNodeFacade) GetDelegationPortfolio(_ string) (*common.DelegationPortfolio, error) {
+	return nil, errNodeStarting
+}
+
 // GetESDTData returns nil and error
 func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,15 +30,15 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	s1, s2, err := inf.GetESDTBalance("", "")
 	assert.Equal(t, emptyString, s1+s2)
 	assert.Equal(t, errNodeStarting, err)
-	v, err := inf.GetBalance("")
+	v, err := inf.GetBalance("", common.AccountQueryOptions{})
 	assert.Nil(t, v)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetUsername("")
+	s1, err = inf.GetUsername("", common.AccountQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetValueForKey("", "")
+	s1, err = inf.GetValueForKey("", "", common.AccountQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -65,7 +66,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, false)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -77,7 +78,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
-	uac, err := inf.GetAccount("")
+	uac, err := inf.GetAccount("", common.AccountQueryOptions{})
 	assert.Equal(t, api.AccountResponse{}, uac)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -92,6 +93,10 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	batchResponse, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, batchResponse)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -172,7 +177,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, asv)
 	assert.Equal(t, errNodeStarting, err)
 
-	mss, err := inf.GetKeyValuePairs("")
+	mss, err := inf.GetKeyValuePairs("", common.AccountQueryOptions{})
 	assert.Nil(t, mss)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -180,6 +185,22 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, ds)
 	assert.Equal(t, errNodeStarting, err)
 
+	gps, err := inf.GetGovernanceProposals("")
+	assert.Nil(t, gps)
+	assert.Equal(t, errNodeStarting, err)
+
+	gp, err := inf.GetGovernanceProposal("")
+	assert.Nil(t, gp)
+	assert.Equal(t, errNodeStarting, err)
+
+	gvs, err := inf.GetGovernanceVotes("")
+	assert.Nil(t, gvs)
+	assert.Equal(t, errNodeStarting, err)
+
+	dp, err := inf.GetDelegationPortfolio("")
+	assert.Nil(t, dp)
+	assert.Equal(t, errNodeStarting, err)
+
 	mssa, err := inf.GetESDTsRoles("")
 	assert.Nil(t, mssa)
 	assert.Equal(t, errNodeStarting, err)
@@ -192,5 +213,18 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, supply)
 	assert.Equal(t, errNodeStarting, err)
 
+	assert.Equal(t, common.HealthStatusUp, inf.GetLivenessReport().Status)
+	readinessReport := inf.GetReadinessReport()
+	assert.Equal(t, common.HealthStatusDown, readinessReport.Status)
+	assert.Equal(t, errNodeStarting.Error(), readinessReport.Components[0].Reason)
+
+	profiles, err := inf.GetHealthProfiles()
+	assert.Nil(t, profiles)
+	assert.Equal(t, errNodeStarting, err)
+
+	profile, err := inf.GetHealthProfile("")
+	assert.Nil(t, profile)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.False(t, check.IfNil(inf))
 }
@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -20,16 +22,16 @@ import (
 // NodeHandler contains all functions that a node should contain.
 type NodeHandler interface {
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 
 	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
 
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
@@ -49,6 +51,15 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
 
+	// GetTransactionsByAddress returns a page of the transactions sent or received by the provided address
+	GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error)
+
+	// GetLogs returns the smart contract events matching the provided filters
+	GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error)
+
+	// Subscribe registers a new subscription for the blocks, transactions and address events matching the request
+	Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error)
+
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 
@@ -66,9 +77,15 @@ type NodeHandler interface {
 	// GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionsPool returns the pending transactions of the self shard senders, grouped by sender
+	GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error)
+
+	// GetTransactionsPoolCounts returns the number of transactions held by the pools
+	GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
-	GetAccount(address string) (api.AccountResponse, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 
 	// GetCode returns the code for the given code hash
 	GetCode(codeHash []byte) []byte
@@ -97,22 +114,39 @@ type NodeHandler interface {
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// HealthServiceHandler defines a component able to report the liveness and the readiness of the node and to provide the
+// profiles captured in the health folder
+type HealthServiceHandler interface {
+	GetLivenessReport() common.HealthReport
+	GetReadinessReport() common.HealthReport
+	GetProfiles() ([]common.HealthProfileInfo, error)
+	GetProfile(name string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotes(address string) ([]*common.GovernanceVote, error)
+	GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -11,11 +12,16 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueriesHandler           func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	GetTotalStakedValueHandler        func() (*api.StakeValues, error)
 	GetDirectStakedListHandler        func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler          func() ([]*api.Delegator, error)
+	GetGovernanceProposalsHandler     func(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalHandler      func(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotesHandler         func(address string) ([]*common.GovernanceVote, error)
+	GetDelegationPortfolioHandler     func(address string) (*common.DelegationPortfolio, error)
 }
 
 // ExecuteSCQuery -
@@ -23,6 +29,11 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return ars.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	return ars.ExecuteSCQueriesHandler(queries)
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
@@ -56,6 +67,42 @@ func (ars *ApiResolverStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	if ars.GetGovernanceProposalsHandler != nil {
+		return ars.GetGovernanceProposalsHandler(status)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	if ars.GetGovernanceProposalHandler != nil {
+		return ars.GetGovernanceProposalHandler(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ars *ApiResolverStub) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	if ars.GetGovernanceVotesHandler != nil {
+		return ars.GetGovernanceVotesHandler(address)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationPortfolio -
+func (ars *ApiResolverStub) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	if ars.GetDelegationPortfolioHandler != nil {
+		return ars.GetDelegationPortfolioHandler(address)
+	}
+
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
@@ -0,0 +1,52 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// HealthServiceStub -
+type HealthServiceStub struct {
+	GetLivenessReportCalled  func() common.HealthReport
+	GetReadinessReportCalled func() common.HealthReport
+	GetProfilesCalled        func() ([]common.HealthProfileInfo, error)
+	GetProfileCalled         func(name string) ([]byte, error)
+}
+
+// GetLivenessReport -
+func (stub *HealthServiceStub) GetLivenessReport() common.HealthReport {
+	if stub.GetLivenessReportCalled != nil {
+		return stub.GetLivenessReportCalled()
+	}
+
+	return common.HealthReport{}
+}
+
+// GetReadinessReport -
+func (stub *HealthServiceStub) GetReadinessReport() common.HealthReport {
+	if stub.GetReadinessReportCalled != nil {
+		return stub.GetReadinessReportCalled()
+	}
+
+	return common.HealthReport{}
+}
+
+// GetProfiles -
+func (stub *HealthServiceStub) GetProfiles() ([]common.HealthProfileInfo, error) {
+	if stub.GetProfilesCalled != nil {
+		return stub.GetProfilesCalled()
+	}
+
+	return make([]common.HealthProfileInfo, 0), nil
+}
+
+// GetProfile -
+func (stub *HealthServiceStub) GetProfile(name string) ([]byte, error) {
+	if stub.GetProfileCalled != nil {
+		return stub.GetProfileCalled(name)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *HealthServiceStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
 
@@ -18,7 +20,7 @@ import (
 type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options common.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -26,7 +28,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (api.AccountResponse, error)
+	GetAccountHandler                              func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GetCodeCalled                                  func(codeHash []byte) []byte
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -36,22 +38,29 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                          func(round uint64, withTxs bool) (*api.Block, error)
-	GetUsernameCalled                              func(address string) (string, error)
+	GetUsernameCalled                              func(address string, options common.AccountQueryOptions) (string, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetTransactionsByAddressCalled                 func(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error)
+	GetLogsCalled                                  func(request common.LogsQueryRequest) (*common.LogsQueryResponse, error)
+	SubscribeCalled                                func(request common.SubscriptionRequest) (*subscriptions.Subscription, error)
+	GetTransactionsPoolCalled                      func(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error)
+	GetTransactionsPoolCountsCalled                func() (*common.TransactionsPoolCountsResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled        func(address string) ([]string, error)
 	GetESDTsWithRoleCalled                         func(address string, role string) ([]string, error)
 	GetESDTsRolesCalled                            func(address string) (map[string][]string, error)
-	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetKeyValuePairsCalled                         func(address string, options common.AccountQueryOptions) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetRangeProofCalled                            func(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error)
 }
 
 // GetProof -
@@ -81,28 +90,46 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetRangeProof -
+func (ns *NodeStub) GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	if ns.GetRangeProofCalled != nil {
+		return ns.GetRangeProofCalled(rootHash, startAddress, endAddress, maxLeaves)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetUsernameCalled != nil {
-		return ns.GetUsernameCalled(address)
+		return ns.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (ns *NodeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address)
+		return ns.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -137,8 +164,8 @@ func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -163,14 +190,32 @@ func (ns *NodeStub) GetTransaction(hash string, withEvents bool) (*transaction.A
 	return ns.GetTransactionHandler(hash, withEvents)
 }
 
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolCounts -
+func (ns *NodeStub) GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error) {
+	if ns.GetTransactionsPoolCountsCalled != nil {
+		return ns.GetTransactionsPoolCountsCalled()
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetCode -
@@ -256,6 +301,33 @@ func (ns *NodeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigital
 	return make(map[string]*esdt.ESDigitalToken), nil
 }
 
+// GetTransactionsByAddress -
+func (ns *NodeStub) GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+	if ns.GetTransactionsByAddressCalled != nil {
+		return ns.GetTransactionsByAddressCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetLogs -
+func (ns *NodeStub) GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+	if ns.GetLogsCalled != nil {
+		return ns.GetLogsCalled(request)
+	}
+
+	return nil, nil
+}
+
+// Subscribe -
+func (ns *NodeStub) Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+	if ns.SubscribeCalled != nil {
+		return ns.SubscribeCalled(request)
+	}
+
+	return nil, nil
+}
+
 // GetTokenSupply -
 func (ns *NodeStub) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
 	return nil, nil
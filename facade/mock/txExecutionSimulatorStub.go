@@ -7,13 +7,13 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
-func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
 	if t.ProcessTxCalled != nil {
-		return t.ProcessTxCalled(tx)
+		return t.ProcessTxCalled(tx, withTrace)
 	}
 
 	return &txSimData.SimulationResults{}, nil
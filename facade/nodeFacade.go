@@ -18,10 +18,12 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -49,6 +51,7 @@ type ArgNodeFacade struct {
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	HealthService          HealthServiceHandler
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -65,6 +68,7 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	healthService          HealthServiceHandler
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -101,6 +105,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.HealthService) {
+		return nil, ErrNilHealthService
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -116,6 +123,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		healthService:          arg.HealthService,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -162,18 +170,18 @@ func (nf *nodeFacade) RestApiInterface() string {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+func (nf *nodeFacade) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetUsername(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTData returns the ESDT data for the given address, tokenID and nonce
@@ -197,8 +205,8 @@ func (nf *nodeFacade) GetESDTsWithRole(address string, role string) ([]string, e
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
-	return nf.node.GetKeyValuePairs(address)
+func (nf *nodeFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	return nf.node.GetKeyValuePairs(address, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
@@ -206,6 +214,21 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigit
 	return nf.node.GetAllESDTTokens(address)
 }
 
+// GetTransactionsByAddress returns a page of the transactions sent or received by the provided address
+func (nf *nodeFacade) GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+	return nf.node.GetTransactionsByAddress(address, query)
+}
+
+// GetLogs returns the smart contract events matching the provided filters
+func (nf *nodeFacade) GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+	return nf.node.GetLogs(request)
+}
+
+// Subscribe registers a new subscription for the blocks, transactions and address events matching the request
+func (nf *nodeFacade) Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+	return nf.node.Subscribe(request)
+}
+
 // GetTokenSupply returns the provided token supply
 func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error) {
 	return nf.node.GetTokenSupply(token)
@@ -256,9 +279,10 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 	return nf.node.SendBulkTransactions(txs)
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return nf.txSimulatorProc.ProcessTx(tx)
+// SimulateTransactionExecution will simulate a transaction's execution and will return the results. If withTrace is
+// set, the results will also contain the execution trace
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTx(tx, withTrace)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -266,14 +290,24 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactionsPool returns the pending transactions of the self shard senders, grouped by sender
+func (nf *nodeFacade) GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+	return nf.node.GetTransactionsPool(request)
+}
+
+// GetTransactionsPoolCounts returns the number of transactions held by the pools
+func (nf *nodeFacade) GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error) {
+	return nf.node.GetTransactionsPoolCounts()
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (apiData.AccountResponse, error) {
-	accountResponse, err := nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options common.AccountQueryOptions) (apiData.AccountResponse, error) {
+	accountResponse, err := nf.node.GetAccount(address, options)
 	if err != nil {
 		return apiData.AccountResponse{}, err
 	}
@@ -299,6 +333,26 @@ func (nf *nodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return nf.apiResolver.StatusMetrics()
 }
 
+// GetLivenessReport will return the liveness status of the node and of its components
+func (nf *nodeFacade) GetLivenessReport() common.HealthReport {
+	return nf.healthService.GetLivenessReport()
+}
+
+// GetReadinessReport will return the readiness status of the node and of its components
+func (nf *nodeFacade) GetReadinessReport() common.HealthReport {
+	return nf.healthService.GetReadinessReport()
+}
+
+// GetHealthProfiles will return the profiles files captured by the health service
+func (nf *nodeFacade) GetHealthProfiles() ([]common.HealthProfileInfo, error) {
+	return nf.healthService.GetProfiles()
+}
+
+// GetHealthProfile will return the content of a profile file captured by the health service
+func (nf *nodeFacade) GetHealthProfile(name string) ([]byte, error) {
+	return nf.healthService.GetProfile(name)
+}
+
 // GetTotalStakedValue will return total staked value
 func (nf *nodeFacade) GetTotalStakedValue() (*apiData.StakeValues, error) {
 	return nf.apiResolver.GetTotalStakedValue()
@@ -314,6 +368,26 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will output the governance proposals, optionally filtered by status
+func (nf *nodeFacade) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposals(status)
+}
+
+// GetGovernanceProposal will output the governance proposal with the provided reference
+func (nf *nodeFacade) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotes will output the governance votes cast by the provided address
+func (nf *nodeFacade) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	return nf.apiResolver.GetGovernanceVotes(address)
+}
+
+// GetDelegationPortfolio will output the positions of the provided address in all the delegation contracts
+func (nf *nodeFacade) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	return nf.apiResolver.GetDelegationPortfolio(address)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -324,6 +398,30 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, e
 	return nf.convertVmOutputToApiResponse(vmOutput), nil
 }
 
+// ExecuteSCQueries executes a batch of queries against the same state. The errors of the individual queries are
+// returned in their results
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) (*common.SCQueriesBatchResponse, error) {
+	queryResults, rootHash, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*common.SCQueryBatchResult, 0, len(queryResults))
+	for _, queryResult := range queryResults {
+		if queryResult.Err != nil {
+			results = append(results, &common.SCQueryBatchResult{Error: queryResult.Err.Error()})
+			continue
+		}
+
+		results = append(results, &common.SCQueryBatchResult{Data: nf.convertVmOutputToApiResponse(queryResult.VMOutput)})
+	}
+
+	return &common.SCQueriesBatchResponse{
+		RootHash: hex.EncodeToString(rootHash),
+		Results:  results,
+	}, nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -424,6 +522,16 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given addresses and the root hash
+func (nf *nodeFacade) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, addresses)
+}
+
+// GetRangeProof returns the accounts in the given addresses range, along with the Merkle proof for the range
+func (nf *nodeFacade) GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	return nf.node.GetRangeProof(rootHash, startAddress, endAddress, maxLeaves)
+}
+
 // GetNumCheckpointsFromPeerState returns the number of checkpoints of the peer state
 func (nf *nodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 	return nf.peerState.GetNumCheckpoints()
@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -67,6 +68,7 @@ func createMockArguments() ArgNodeFacade {
 				return []byte("root hash")
 			},
 		},
+		HealthService: &mock.HealthServiceStub{},
 	}
 }
 
@@ -94,6 +96,17 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilHealthServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.HealthService = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilHealthService, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -156,7 +169,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -168,7 +181,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -183,7 +196,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -195,7 +208,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -207,7 +220,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -216,7 +229,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -283,7 +296,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	getAccountCalled := false
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (api.AccountResponse, error) {
+	node.GetAccountHandler = func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 		getAccountCalled = true
 		return api.AccountResponse{}, nil
 	}
@@ -292,7 +305,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", common.AccountQueryOptions{})
 	assert.True(t, getAccountCalled)
 }
 
@@ -301,7 +314,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 
 	expectedUsername := "username"
 	node := &mock.NodeStub{}
-	node.GetUsernameCalled = func(address string) (string, error) {
+	node.GetUsernameCalled = func(address string, _ common.AccountQueryOptions) (string, error) {
 		return expectedUsername, nil
 	}
 
@@ -309,7 +322,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	username, err := nf.GetUsername("test")
+	username, err := nf.GetUsername("test", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUsername, username)
 }
@@ -468,6 +481,51 @@ func TestNodeFacade_StatusMetrics(t *testing.T) {
 	assert.True(t, apiResolverMetricsRequested)
 }
 
+func TestNodeFacade_HealthReports(t *testing.T) {
+	t.Parallel()
+
+	livenessReport := common.HealthReport{Status: common.HealthStatusUp}
+	readinessReport := common.HealthReport{Status: common.HealthStatusDown}
+	arg := createMockArguments()
+	arg.HealthService = &mock.HealthServiceStub{
+		GetLivenessReportCalled: func() common.HealthReport {
+			return livenessReport
+		},
+		GetReadinessReportCalled: func() common.HealthReport {
+			return readinessReport
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, livenessReport, nf.GetLivenessReport())
+	assert.Equal(t, readinessReport, nf.GetReadinessReport())
+}
+
+func TestNodeFacade_HealthProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiles := []common.HealthProfileInfo{{Name: "profile__20210101000000__memory__heap.pprof", SizeInBytes: 7}}
+	arg := createMockArguments()
+	arg.HealthService = &mock.HealthServiceStub{
+		GetProfilesCalled: func() ([]common.HealthProfileInfo, error) {
+			return profiles, nil
+		},
+		GetProfileCalled: func(name string) ([]byte, error) {
+			assert.Equal(t, profiles[0].Name, name)
+			return []byte("content"), nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	result, err := nf.GetHealthProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, profiles, result)
+
+	content, err := nf.GetHealthProfile(profiles[0].Name)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("content"), content)
+}
+
 func TestNodeFacade_PprofEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -665,14 +723,14 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	expectedPairs := map[string]string{"k": "v"}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetKeyValuePairsCalled: func(address string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(address string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return expectedPairs, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetKeyValuePairs("addr")
+	res, err := nf.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPairs, res)
 }
@@ -724,14 +782,14 @@ func TestNodeFacade_GetValueForKey(t *testing.T) {
 	expectedValue := "value"
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return expectedValue, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetValueForKey("addr", "key")
+	res, err := nf.GetValueForKey("addr", "key", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, res)
 }
@@ -827,6 +885,24 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_SimulateTransactionExecutionShouldForwardTraceFlag(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}
+	arg := createMockArguments()
+	arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+			assert.True(t, withTrace)
+			return expectedResults, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.SimulateTransactionExecution(&transaction.Transaction{}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+}
+
 func TestNodeFacade_GetTotalStakedValue(t *testing.T) {
 	t.Parallel()
 
@@ -881,6 +957,78 @@ func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	expectedProposals := []*common.GovernanceProposal{{Reference: "ref"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalsHandler: func(status string) ([]*common.GovernanceProposal, error) {
+			assert.Equal(t, "active", status)
+			return expectedProposals, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	proposals, err := nf.GetGovernanceProposals("active")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProposals, proposals)
+}
+
+func TestNodeFacade_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	expectedProposal := &common.GovernanceProposal{Reference: "ref"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalHandler: func(reference string) (*common.GovernanceProposal, error) {
+			assert.Equal(t, "ref", reference)
+			return expectedProposal, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	proposal, err := nf.GetGovernanceProposal("ref")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProposal, proposal)
+}
+
+func TestNodeFacade_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	expectedVotes := []*common.GovernanceVote{{Proposal: "ref"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceVotesHandler: func(address string) ([]*common.GovernanceVote, error) {
+			assert.Equal(t, "addr", address)
+			return expectedVotes, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	votes, err := nf.GetGovernanceVotes("addr")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedVotes, votes)
+}
+
+func TestNodeFacade_GetDelegationPortfolio(t *testing.T) {
+	t.Parallel()
+
+	expectedPortfolio := &common.DelegationPortfolio{Address: "addr"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationPortfolioHandler: func(address string) (*common.DelegationPortfolio, error) {
+			assert.Equal(t, "addr", address)
+			return expectedPortfolio, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	portfolio, err := nf.GetDelegationPortfolio("addr")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPortfolio, portfolio)
+}
+
 func TestNodeFacade_GetProofCurrentRootHashIsEmptyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1021,6 +1169,55 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	require.Equal(t, hex.EncodeToString(expectedAddress), outputAccount.Address)
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("api resolver errors should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(_ []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		response, err := nf.ExecuteSCQueries([]*process.SCQuery{{}})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("should convert the results", func(t *testing.T) {
+		t.Parallel()
+
+		queryErr := errors.New("query error")
+		rootHash := []byte("root hash")
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+				require.Equal(t, 2, len(queries))
+
+				return []*process.SCQueryResult{
+					{VMOutput: &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("data")}, ReturnCode: vmcommon.Ok}},
+					{Err: queryErr},
+				}, rootHash, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		response, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(rootHash), response.RootHash)
+		require.Equal(t, 2, len(response.Results))
+		require.Equal(t, [][]byte{[]byte("data")}, response.Results[0].Data.ReturnData)
+		require.Equal(t, vmcommon.Ok.String(), response.Results[0].Data.ReturnCode)
+		require.Empty(t, response.Results[0].Error)
+		require.Nil(t, response.Results[1].Data)
+		require.Equal(t, queryErr.Error(), response.Results[1].Error)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
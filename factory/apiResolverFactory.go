@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -58,6 +59,7 @@ type scQueryServiceArgs struct {
 	bootstrapper        process.Bootstrapper
 	allowVMQueriesChan  chan struct{}
 	workingDir          string
+	processingMode      common.NodeProcessingMode
 }
 
 type scQueryElementArgs struct {
@@ -73,6 +75,7 @@ type scQueryElementArgs struct {
 	bootstrapper        process.Bootstrapper
 	allowVMQueriesChan  chan struct{}
 	workingDir          string
+	processingMode      common.NodeProcessingMode
 	index               int
 }
 
@@ -80,6 +83,10 @@ type scQueryElementArgs struct {
 // TODO: refactor to further decrease node's codebase
 func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 	apiWorkingDir := filepath.Join(args.Configs.FlagsConfig.WorkingDir, common.TemporaryPath)
+	processingMode := common.Normal
+	if args.Configs.ImportDbConfig.IsImportDBMode {
+		processingMode = common.ImportDb
+	}
 	argsSCQuery := &scQueryServiceArgs{
 		generalConfig:       args.Configs.GeneralConfig,
 		epochConfig:         args.Configs.EpochConfig,
@@ -93,6 +100,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		bootstrapper:        args.Bootstrapper,
 		allowVMQueriesChan:  args.AllowVMQueriesChan,
 		workingDir:          apiWorkingDir,
+		processingMode:      processingMode,
 	}
 
 	scQueryService, err := createScQueryService(argsSCQuery)
@@ -174,13 +182,29 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	delegationPortfolioHandler, err := trieIteratorsFactory.CreateDelegationPortfolioHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	argsGovernanceProcessor := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		BlockChain:               args.DataComponents.Blockchain(),
+	}
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsGovernanceProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:          scQueryService,
-		StatusMetricsHandler:    args.CoreComponents.StatusHandlerUtils().Metrics(),
-		TxCostHandler:           txCostHandler,
-		TotalStakedValueHandler: totalStakedValueHandler,
-		DirectStakedListHandler: directStakedListHandler,
-		DelegatedListHandler:    delegatedListHandler,
+		SCQueryService:             scQueryService,
+		StatusMetricsHandler:       args.CoreComponents.StatusHandlerUtils().Metrics(),
+		TxCostHandler:              txCostHandler,
+		TotalStakedValueHandler:    totalStakedValueHandler,
+		DirectStakedListHandler:    directStakedListHandler,
+		DelegatedListHandler:       delegatedListHandler,
+		GovernanceHandler:          governanceHandler,
+		DelegationPortfolioHandler: delegationPortfolioHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -207,6 +231,7 @@ func createScQueryService(
 		workingDir:          args.workingDir,
 		bootstrapper:        args.bootstrapper,
 		allowVMQueriesChan:  args.allowVMQueriesChan,
+		processingMode:      args.processingMode,
 		index:               0,
 	}
 
@@ -235,13 +260,97 @@ func createScQueryService(
 func createScQueryElement(
 	args *scQueryElementArgs,
 ) (process.SCQueryService, error) {
+	cacherCfg := storageFactory.GetCacherFromConfig(args.generalConfig.SmartContractDataPool)
+	smartContractsCache, err := storageUnit.NewCache(cacherCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, blockChainHook, err := createVmContainerAndBlockChainHook(
+		args,
+		args.stateComponents.AccountsAdapter(),
+		smartContractsCache,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var historicalQueries *smartContract.ArgsHistoricalQueries
+	if args.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		historicalQueries, err = createArgsHistoricalQueries(args, smartContractsCache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:              vmContainer,
+		EconomicsFee:             args.coreComponents.EconomicsData(),
+		BlockChainHook:           blockChainHook,
+		BlockChain:               args.dataComponents.Blockchain(),
+		ArwenChangeLocker:        args.coreComponents.ArwenChangeLocker(),
+		Bootstrapper:             args.bootstrapper,
+		AllowExternalQueriesChan: args.allowVMQueriesChan,
+		MaxGasLimitPerQuery:      args.generalConfig.VirtualMachine.GasConfig.MaxGasPerVmQuery,
+		MaxQueriesInBatch:        args.generalConfig.VirtualMachine.Querying.MaxQueriesInBatch,
+		HistoricalQueries:        historicalQueries,
+	}
+
+	return smartContract.NewSCQueryService(argsNewSCQueryService)
+}
+
+// createArgsHistoricalQueries creates the virtual machines container executing the historical queries. Its blockchain
+// hook and built-in functions share the same accounts adapter, which reads the state at the root hash of the block
+// requested by the query. Each query service gets its own accounts adapter with history, so the concurrent queries at
+// different root hashes do not recreate the trie of the same adapter in turns. The metachain does not execute
+// historical queries, as the system smart contracts also read the validators statistics, which are only available for
+// the current state
+func createArgsHistoricalQueries(
+	args *scQueryElementArgs,
+	smartContractsCache storage.Cacher,
+) (*smartContract.ArgsHistoricalQueries, error) {
+	accountsWithHistory, err := createAccountsAdapterWithHistory(
+		args.stateComponents.TriesContainer(),
+		args.coreComponents,
+		args.processingMode,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	historicalAccounts, err := state.NewAccountsDBApiAtRootHash(accountsWithHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, blockChainHook, err := createVmContainerAndBlockChainHook(args, historicalAccounts, smartContractsCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &smartContract.ArgsHistoricalQueries{
+		VmContainer:              vmContainer,
+		BlockChainHook:           blockChainHook,
+		Accounts:                 historicalAccounts,
+		StorageService:           args.dataComponents.StorageService(),
+		Marshaller:               args.coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: args.coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         args.processComponents.ShardCoordinator(),
+	}, nil
+}
+
+func createVmContainerAndBlockChainHook(
+	args *scQueryElementArgs,
+	accounts state.AccountsAdapter,
+	smartContractsCache storage.Cacher,
+) (process.VirtualMachinesContainer, process.BlockChainHookHandler, error) {
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
 	builtInFuncs, nftStorageHandler, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapter(),
+		accounts,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
@@ -251,19 +360,13 @@ func createScQueryElement(
 		args.epochConfig.EnableEpochs.OptimizeNFTStoreEnableEpoch,
 	)
 	if err != nil {
-		return nil, err
-	}
-
-	cacherCfg := storageFactory.GetCacherFromConfig(args.generalConfig.SmartContractDataPool)
-	smartContractsCache, err := storageUnit.NewCache(cacherCfg)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           args.stateComponents.AccountsAdapter(),
+		Accounts:           accounts,
 		PubkeyConv:         args.coreComponents.AddressPubKeyConverter(),
 		StorageService:     args.dataComponents.StorageService(),
 		BlockChain:         args.dataComponents.Blockchain(),
@@ -299,13 +402,13 @@ func createScQueryElement(
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		queryVirtualMachineConfig := args.generalConfig.VirtualMachine.Querying.VirtualMachineConfig
 		esdtTransferParser, errParser := parsers.NewESDTTransferParser(args.coreComponents.InternalMarshalizer())
 		if errParser != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		argsNewVMFactory := shard.ArgVMContainerFactory{
 			Config:             queryVirtualMachineConfig,
@@ -324,32 +427,21 @@ func createScQueryElement(
 
 		vmFactory, err = shard.NewVMContainerFactory(argsNewVMFactory)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	vmContainer, err := vmFactory.Create()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = vmcommonBuiltInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
 	if err != nil {
-		return nil, err
-	}
-
-	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:              vmContainer,
-		EconomicsFee:             args.coreComponents.EconomicsData(),
-		BlockChainHook:           vmFactory.BlockChainHookImpl(),
-		BlockChain:               args.dataComponents.Blockchain(),
-		ArwenChangeLocker:        args.coreComponents.ArwenChangeLocker(),
-		Bootstrapper:             args.bootstrapper,
-		AllowExternalQueriesChan: args.allowVMQueriesChan,
-		MaxGasLimitPerQuery:      args.generalConfig.VirtualMachine.GasConfig.MaxGasPerVmQuery,
+		return nil, nil, err
 	}
 
-	return smartContract.NewSCQueryService(argsNewSCQueryService)
+	return vmContainer, vmFactory.BlockChainHookImpl(), nil
 }
 
 func createBuiltinFuncs(
@@ -924,6 +924,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BuiltInFunctions = builtInFuncs
 
 	return vmFactory, nil
 }
@@ -1016,6 +1017,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BuiltInFunctions = builtInFuncs
 
 	return vmFactory, nil
 }
@@ -26,6 +26,7 @@ import (
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
@@ -225,7 +226,7 @@ type NetworkComponentsHandler interface {
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -282,6 +283,7 @@ type StateComponentsHolder interface {
 	PeerAccounts() state.AccountsAdapter
 	AccountsAdapter() state.AccountsAdapter
 	AccountsAdapterAPI() state.AccountsAdapter
+	AccountsAdapterAPIWithHistory() state.AccountsAdapterWithHistory
 	TriesContainer() common.TriesHolder
 	TrieStorageManagers() map[string]common.StorageManager
 	IsInterfaceNil() bool
@@ -290,6 +292,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	SubscriptionsHandler() subscriptions.SubscriptionsHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	IsInterfaceNil() bool
 }
@@ -7,11 +7,12 @@ import (
 
 // StateComponentsHolderStub -
 type StateComponentsHolderStub struct {
-	PeerAccountsCalled        func() state.AccountsAdapter
-	AccountsAdapterCalled     func() state.AccountsAdapter
-	AccountsAdapterAPICalled  func() state.AccountsAdapter
-	TriesContainerCalled      func() common.TriesHolder
-	TrieStorageManagersCalled func() map[string]common.StorageManager
+	PeerAccountsCalled                  func() state.AccountsAdapter
+	AccountsAdapterCalled               func() state.AccountsAdapter
+	AccountsAdapterAPICalled            func() state.AccountsAdapter
+	AccountsAdapterAPIWithHistoryCalled func() state.AccountsAdapterWithHistory
+	TriesContainerCalled                func() common.TriesHolder
+	TrieStorageManagersCalled           func() map[string]common.StorageManager
 }
 
 // PeerAccounts -
@@ -41,6 +42,15 @@ func (s *StateComponentsHolderStub) AccountsAdapterAPI() state.AccountsAdapter {
 	return nil
 }
 
+// AccountsAdapterAPIWithHistory -
+func (s *StateComponentsHolderStub) AccountsAdapterAPIWithHistory() state.AccountsAdapterWithHistory {
+	if s.AccountsAdapterAPIWithHistoryCalled != nil {
+		return s.AccountsAdapterAPIWithHistoryCalled()
+	}
+
+	return nil
+}
+
 // TriesContainer -
 func (s *StateComponentsHolderStub) TriesContainer() common.TriesHolder {
 	if s.TriesContainerCalled != nil {
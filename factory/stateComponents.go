@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state"
 	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
 	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 )
@@ -42,11 +43,12 @@ type stateComponentsFactory struct {
 
 // stateComponents struct holds the state components of the Elrond protocol
 type stateComponents struct {
-	peerAccounts        state.AccountsAdapter
-	accountsAdapter     state.AccountsAdapter
-	accountsAdapterAPI  state.AccountsAdapter
-	triesContainer      common.TriesHolder
-	trieStorageManagers map[string]common.StorageManager
+	peerAccounts                  state.AccountsAdapter
+	accountsAdapter               state.AccountsAdapter
+	accountsAdapterAPI            state.AccountsAdapter
+	accountsAdapterAPIWithHistory state.AccountsAdapterWithHistory
+	triesContainer                common.TriesHolder
+	trieStorageManagers           map[string]common.StorageManager
 }
 
 // NewStateComponentsFactory will return a new instance of stateComponentsFactory
@@ -103,17 +105,23 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		return nil, err
 	}
 
+	accountsAdapterAPIWithHistory, err := scf.createAccountsAdapterAPIWithHistory(triesContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	peerAdapter, err := scf.createPeerAdapter(triesContainer)
 	if err != nil {
 		return nil, err
 	}
 
 	return &stateComponents{
-		peerAccounts:        peerAdapter,
-		accountsAdapter:     accountsAdapter,
-		accountsAdapterAPI:  accountsAdapterAPI,
-		triesContainer:      triesContainer,
-		trieStorageManagers: trieStorageManagers,
+		peerAccounts:                  peerAdapter,
+		accountsAdapter:               accountsAdapter,
+		accountsAdapterAPI:            accountsAdapterAPI,
+		accountsAdapterAPIWithHistory: accountsAdapterAPIWithHistory,
+		triesContainer:                triesContainer,
+		trieStorageManagers:           trieStorageManagers,
 	}, nil
 }
 
@@ -161,6 +169,42 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 	return accountsAdapter, wrapper, nil
 }
 
+// createAccountsAdapterAPIWithHistory creates an accounts adapter which does not follow the current block, as its
+// trie is recreated at the root hash of every historical query
+func (scf *stateComponentsFactory) createAccountsAdapterAPIWithHistory(triesContainer common.TriesHolder) (state.AccountsAdapterWithHistory, error) {
+	return createAccountsAdapterWithHistory(triesContainer, scf.core, scf.processingMode)
+}
+
+// createAccountsAdapterWithHistory creates an accounts adapter with history owning its own accounts adapter, so the
+// readers holding different instances recreate the trie at their root hashes without blocking each other
+func createAccountsAdapterWithHistory(
+	triesContainer common.TriesHolder,
+	coreComponents CoreComponentsHolder,
+	processingMode common.NodeProcessingMode,
+) (state.AccountsAdapterWithHistory, error) {
+	argsAccountsDB := state.ArgsAccountsDB{
+		Trie:                        triesContainer.Get([]byte(trieFactory.UserAccountTrie)),
+		Hasher:                      coreComponents.Hasher(),
+		Marshaller:                  coreComponents.InternalMarshalizer(),
+		AccountFactory:              factoryState.NewAccountCreator(),
+		StoragePruningManager:       disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:              processingMode,
+		ProcessStatusHandler:        coreComponents.ProcessStatusHandler(),
+		DisableSnapshotAfterRestart: true,
+	}
+	accountsAdapter, err := state.NewAccountsDB(argsAccountsDB)
+	if err != nil {
+		return nil, fmt.Errorf("accounts adapter API with history: %w: %s", errors.ErrAccountsAdapterCreation, err.Error())
+	}
+
+	wrapper, err := state.NewAccountsDBApiWithHistory(accountsAdapter)
+	if err != nil {
+		return nil, fmt.Errorf("accounts adapter API with history: %w: %s", errors.ErrAccountsAdapterCreation, err.Error())
+	}
+
+	return wrapper, nil
+}
+
 func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.TriesHolder) (state.AccountsAdapter, error) {
 	accountFactory := factoryState.NewPeerAccountCreator()
 	merkleTrie := triesContainer.Get([]byte(trieFactory.PeerAccountTrie))
@@ -221,6 +265,11 @@ func (pc *stateComponents) Close() error {
 		errString += fmt.Errorf("accountsAdapterAPI close failed: %w ", err).Error()
 	}
 
+	err = pc.accountsAdapterAPIWithHistory.Close()
+	if err != nil {
+		errString += fmt.Errorf("accountsAdapterAPIWithHistory close failed: %w ", err).Error()
+	}
+
 	err = pc.peerAccounts.Close()
 	if err != nil {
 		errString += fmt.Errorf("peerAccounts close failed: %w ", err).Error()
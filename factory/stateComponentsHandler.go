@@ -78,6 +78,9 @@ func (msc *managedStateComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.accountsAdapter) {
 		return errors.ErrNilAccountsAdapter
 	}
+	if check.IfNil(msc.accountsAdapterAPIWithHistory) {
+		return errors.ErrNilAccountsAdapter
+	}
 	if check.IfNil(msc.triesContainer) {
 		return errors.ErrNilTriesContainer
 	}
@@ -129,6 +132,18 @@ func (msc *managedStateComponents) AccountsAdapterAPI() state.AccountsAdapter {
 	return msc.stateComponents.accountsAdapterAPI
 }
 
+// AccountsAdapterAPIWithHistory returns the accounts adapter used by the REST API to query the accounts at past blocks
+func (msc *managedStateComponents) AccountsAdapterAPIWithHistory() state.AccountsAdapterWithHistory {
+	msc.mutStateComponents.RLock()
+	defer msc.mutStateComponents.RUnlock()
+
+	if msc.stateComponents == nil {
+		return nil
+	}
+
+	return msc.stateComponents.accountsAdapterAPIWithHistory
+}
+
 // TriesContainer returns the tries container
 func (msc *managedStateComponents) TriesContainer() common.TriesHolder {
 	msc.mutStateComponents.RLock()
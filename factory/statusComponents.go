@@ -3,12 +3,14 @@ package factory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
 	"github.com/ElrondNetwork/elrond-go/common/statistics/softwareVersion/factory"
@@ -18,21 +20,27 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/filedriver"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	disabledSubscriptions "github.com/ElrondNetwork/elrond-go/outport/subscriptions/disabled"
+	"github.com/ElrondNetwork/elrond-go/outport/wsdriver"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 )
 
 // TODO: move app status handler initialization here
 
 type statusComponents struct {
-	nodesCoordinator sharding.NodesCoordinator
-	statusHandler    core.AppStatusHandler
-	outportHandler   outport.OutportHandler
-	softwareVersion  statistics.SoftwareVersionChecker
-	resourceMonitor  statistics.ResourceMonitorHandler
-	cancelFunc       func()
+	nodesCoordinator     sharding.NodesCoordinator
+	statusHandler        core.AppStatusHandler
+	outportHandler       outport.OutportHandler
+	subscriptionsHandler subscriptions.SubscriptionsHandler
+	softwareVersion      statistics.SoftwareVersionChecker
+	resourceMonitor      statistics.ResourceMonitorHandler
+	cancelFunc           func()
 }
 
 // StatusComponentsFactoryArgs redefines the arguments structure needed for the status components factory
@@ -143,7 +151,12 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, errors.ErrInvalidRoundDuration
 	}
 
-	outportHandler, err := scf.createOutportDriver()
+	subscriptionsHandler, subscriptionsDriver, err := scf.createSubscriptionsHub()
+	if err != nil {
+		return nil, err
+	}
+
+	outportHandler, err := scf.createOutportDriver(subscriptionsDriver)
 	if err != nil {
 		return nil, err
 	}
@@ -151,12 +164,13 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 	_, cancelFunc := context.WithCancel(context.Background())
 
 	statusComponentsInstance := &statusComponents{
-		nodesCoordinator: scf.nodesCoordinator,
-		softwareVersion:  softwareVersionChecker,
-		outportHandler:   outportHandler,
-		statusHandler:    scf.coreComponents.StatusHandler(),
-		resourceMonitor:  resMon,
-		cancelFunc:       cancelFunc,
+		nodesCoordinator:     scf.nodesCoordinator,
+		softwareVersion:      softwareVersionChecker,
+		outportHandler:       outportHandler,
+		subscriptionsHandler: subscriptionsHandler,
+		statusHandler:        scf.coreComponents.StatusHandler(),
+		resourceMonitor:      resMon,
+		cancelFunc:           cancelFunc,
 	}
 
 	if scf.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -205,18 +219,66 @@ func (pc *statusComponents) Close() error {
 
 // createOutportDriver creates a new outport.OutportHandler which is used to register outport drivers
 // once a driver is subscribed it will receive data through the implemented outport.Driver methods
-func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, error) {
+func (scf *statusComponentsFactory) createOutportDriver(subscriptionsDriver outport.Driver) (outport.OutportHandler, error) {
 
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		RetrialInterval:            common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		WebSocketDriverFactoryArgs: scf.makeWebSocketDriverArgs(),
+		FileDriverFactoryArgs:      scf.makeFileDriverArgs(),
+		QueueFactoryArgs:           scf.makeOutportQueueArgs(),
+		SubscriptionsDriver:        subscriptionsDriver,
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createSubscriptionsHub creates the handler of the API subscriptions. When enabled, the same hub is also returned as
+// the outport driver that feeds it, as only the outport receives the committed blocks together with their bodies and logs
+func (scf *statusComponentsFactory) createSubscriptionsHub() (subscriptions.SubscriptionsHandler, outport.Driver, error) {
+	subscriptionsConfig := scf.config.Subscriptions
+	if !subscriptionsConfig.Enabled {
+		return disabledSubscriptions.NewDisabledSubscriptionsHandler(), nil, nil
+	}
+
+	hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		PubKeyConverter:      scf.coreComponents.AddressPubKeyConverter(),
+		MaxSubscribers:       subscriptionsConfig.MaxSubscribers,
+		SubscriberBufferSize: subscriptionsConfig.SubscriberBufferSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hub, hub, nil
+}
+
+func (scf *statusComponentsFactory) makeOutportQueueArgs() *outportDriverFactory.OutportQueueFactoryArgs {
+	outportQueueConfig := scf.config.OutportQueue
+	if !outportQueueConfig.Enabled {
+		return &outportDriverFactory.OutportQueueFactoryArgs{}
+	}
+
+	// the queue is durable only if each entry is written as soon as it is pushed, without being batched
+	dbConfig := outportQueueConfig.DB
+	if dbConfig.MaxBatchSize != 1 {
+		log.Warn("outport queue DB MaxBatchSize forced to 1", "configured value", dbConfig.MaxBatchSize)
+		dbConfig.MaxBatchSize = 1
+	}
+
+	shardID := core.GetShardIDString(scf.shardCoordinator.SelfId())
+	return &outportDriverFactory.OutportQueueFactoryArgs{
+		Enabled:          true,
+		MaxQueueSize:     outportQueueConfig.MaxQueueSize,
+		MaxDrainWait:     time.Duration(outportQueueConfig.MaxDrainWaitInSeconds) * time.Second,
+		BasePath:         scf.coreComponents.PathHandler().PathForStatic(shardID, dbConfig.FilePath),
+		PersisterFactory: storageFactory.NewPersisterFactory(dbConfig),
+		StatusHandler:    scf.coreComponents.StatusHandler(),
+	}
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return &indexerFactory.ArgsIndexerFactory{
@@ -266,6 +328,33 @@ func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.A
 	}
 }
 
+func (scf *statusComponentsFactory) makeWebSocketDriverArgs() *wsdriver.ArgsWebSocketDriverFactory {
+	webSocketConfig := scf.externalConfig.WebSocketConnector
+	return &wsdriver.ArgsWebSocketDriverFactory{
+		Enabled:               webSocketConfig.Enabled,
+		URL:                   webSocketConfig.URL,
+		Route:                 webSocketConfig.Route,
+		BufferSize:            webSocketConfig.BufferSize,
+		MaxUnackedEvents:      webSocketConfig.MaxUnackedEvents,
+		WriteTimeoutInSeconds: webSocketConfig.WriteTimeoutInSeconds,
+		AllowedOrigins:        webSocketConfig.AllowedOrigins,
+		Marshaller:            &marshal.JsonMarshalizer{},
+	}
+}
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() *filedriver.ArgsFileDriverFactory {
+	fileExportConfig := scf.externalConfig.FileExportConnector
+	return &filedriver.ArgsFileDriverFactory{
+		Enabled:               fileExportConfig.Enabled,
+		Path:                  fileExportConfig.Path,
+		MaxFileSizeInMB:       fileExportConfig.MaxFileSizeInMB,
+		NumWritesBetweenSyncs: fileExportConfig.NumWritesBetweenSyncs,
+		PubKeyConverter:       scf.coreComponents.AddressPubKeyConverter(),
+		Marshalizer:           scf.coreComponents.InternalMarshalizer(),
+		Hasher:                scf.coreComponents.Hasher(),
+	}
+}
+
 func startStatisticsMonitor(
 	generalConfig *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -146,6 +147,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// SubscriptionsHandler returns the handler of the API subscriptions
+func (msc *managedStatusComponents) SubscriptionsHandler() subscriptions.SubscriptionsHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.subscriptionsHandler
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
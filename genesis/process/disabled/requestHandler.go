@@ -50,6 +50,10 @@ func (r *RequestHandler) RequestMiniBlocks(_ uint32, _ [][]byte) {
 func (r *RequestHandler) RequestTrieNodes(_ uint32, _ [][]byte, _ string) {
 }
 
+// RequestTrieNodesBatch does nothing
+func (r *RequestHandler) RequestTrieNodesBatch(_ uint32, _ [][]byte, _ string) {
+}
+
 // RequestStartOfEpochMetaBlock does nothing
 func (r *RequestHandler) RequestStartOfEpochMetaBlock(_ uint32) {
 }
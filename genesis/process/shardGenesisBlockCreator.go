@@ -115,6 +115,7 @@ func createGenesisConfig() config.EnableEpochs {
 		ScheduledMiniBlocksEnableEpoch:                    unreachableEpoch,
 		AddFailedRelayedTxToInvalidMBsDisableEpoch:        unreachableEpoch,
 		SCRSizeInvariantOnBuiltInResultEnableEpoch:        unreachableEpoch,
+		GovernanceViewFunctionsEnableEpoch:                unreachableEpoch,
 	}
 }
 
@@ -6,3 +6,21 @@ import (
 
 var errNilComponent = errors.New("component is nil")
 var errNotDiagnosableComponent = errors.New("component is not diagnosable")
+
+// ErrNilProbe signals that a nil health probe has been provided
+var ErrNilProbe = errors.New("nil health probe")
+
+// ErrEmptyProbeName signals that a health probe without a name has been provided
+var ErrEmptyProbeName = errors.New("empty health probe name")
+
+// ErrProbeAlreadyRegistered signals that a health probe with the same name is already registered
+var ErrProbeAlreadyRegistered = errors.New("health probe already registered")
+
+// ErrInvalidProfileName signals that the provided profile name is not a valid profile file name
+var ErrInvalidProfileName = errors.New("invalid profile name")
+
+// ErrProfileNotFound signals that the requested profile file does not exist
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ErrNilStatusMetricsProvider signals that a nil status metrics provider has been provided
+var ErrNilStatusMetricsProvider = errors.New("nil status metrics provider")
@@ -5,17 +5,39 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/goroutine"
 )
 
 var log = logger.GetOrCreate("health")
 
+const (
+	reasonMemory          = "memory"
+	reasonGoroutinesSpike = "goroutines"
+	reasonSlowBlock       = "slowblock"
+
+	// goroutinesAverageWeight is the weight of the newest sample in the running average of the number of goroutines
+	goroutinesAverageWeight = 0.1
+)
+
+type blockProcessingObservation struct {
+	nonce        uint64
+	durationInMs uint64
+}
+
+type namedProbe struct {
+	name  string
+	probe Probe
+}
+
 type healthService struct {
 	config                              config.HealthServiceConfig
 	folder                              string
@@ -23,6 +45,15 @@ type healthService struct {
 	records                             *records
 	diagnosableComponents               []diagnosable
 	diagnosableComponentsMutex          sync.RWMutex
+	probes                              []namedProbe
+	probesMutex                         sync.RWMutex
+	profiles                            *profilesCapturer
+	goroutinesAnalyser                  goroutinesDumper
+	numGoroutines                       func() int
+	goroutinesAverage                   float64
+	statusMetrics                       StatusMetricsProvider
+	statusMetricsMutex                  sync.RWMutex
+	lastSlowBlock                       blockProcessingObservation
 	clock                               clock
 	memory                              memory
 	onMonitorContinuouslyBeginIteration func()
@@ -35,6 +66,22 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 
 	folder := path.Join(workingDir, config.FolderPath)
 	recordsObj := newRecords(config.NumMemoryUsageRecordsToKeep)
+	clockObj := &realClock{}
+	profiles := newProfilesCapturer(
+		folder,
+		config.NumProfileCapturesToKeep,
+		time.Duration(config.CPUProfileDurationInSeconds)*time.Second,
+		time.Duration(config.MinIntervalBetweenCapturesInSeconds)*time.Second,
+		clockObj,
+	)
+
+	var analyser goroutinesDumper
+	goRoutinesAnalyser, err := goroutine.NewGoRoutinesAnalyser(goroutine.NewGoRoutinesProcessor())
+	if err != nil {
+		log.Warn("NewHealthService: can not create the goroutines analyser", "err", err)
+	} else {
+		analyser = goRoutinesAnalyser
+	}
 
 	return &healthService{
 		config:                              config,
@@ -42,7 +89,11 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 		cancelFunction:                      func() {},
 		records:                             recordsObj,
 		diagnosableComponents:               make([]diagnosable, 0),
-		clock:                               &realClock{},
+		probes:                              make([]namedProbe, 0),
+		profiles:                            profiles,
+		goroutinesAnalyser:                  analyser,
+		numGoroutines:                       runtime.NumGoroutine,
+		clock:                               clockObj,
 		memory:                              &realMemory{},
 		onMonitorContinuouslyBeginIteration: func() {},
 		onMonitorContinuouslyEndIteration:   func() {},
@@ -72,6 +123,98 @@ func (h *healthService) doRegisterComponent(component interface{}) error {
 	return nil
 }
 
+// SetStatusMetricsProvider sets the status metrics used to detect the slow blocks processing
+func (h *healthService) SetStatusMetricsProvider(provider StatusMetricsProvider) error {
+	if check.IfNil(provider) {
+		return ErrNilStatusMetricsProvider
+	}
+
+	h.statusMetricsMutex.Lock()
+	h.statusMetrics = provider
+	h.statusMetricsMutex.Unlock()
+
+	return nil
+}
+
+// GetProfiles returns the profiles files found in the health folder
+func (h *healthService) GetProfiles() ([]common.HealthProfileInfo, error) {
+	return h.profiles.listProfiles()
+}
+
+// GetProfile returns the content of a profile file from the health folder
+func (h *healthService) GetProfile(name string) ([]byte, error) {
+	return h.profiles.getProfile(name)
+}
+
+// RegisterProbe registers the health probe of a component. The components are reported in the order of registration
+func (h *healthService) RegisterProbe(name string, probe Probe) error {
+	if len(name) == 0 {
+		return ErrEmptyProbeName
+	}
+	if check.IfNil(probe) {
+		return ErrNilProbe
+	}
+
+	h.probesMutex.Lock()
+	defer h.probesMutex.Unlock()
+
+	for _, registered := range h.probes {
+		if registered.name == name {
+			return fmt.Errorf("%w: %s", ErrProbeAlreadyRegistered, name)
+		}
+	}
+
+	h.probes = append(h.probes, namedProbe{
+		name:  name,
+		probe: probe,
+	})
+
+	return nil
+}
+
+// GetLivenessReport runs the liveness check of all registered probes. The node is reported as up only if all the
+// components are live
+func (h *healthService) GetLivenessReport() common.HealthReport {
+	return h.createReport(func(probe Probe) error {
+		return probe.CheckLiveness()
+	})
+}
+
+// GetReadinessReport runs the readiness check of all registered probes. The node is reported as up only if all the
+// components are ready
+func (h *healthService) GetReadinessReport() common.HealthReport {
+	return h.createReport(func(probe Probe) error {
+		return probe.CheckReadiness()
+	})
+}
+
+func (h *healthService) createReport(checkHandler func(probe Probe) error) common.HealthReport {
+	h.probesMutex.RLock()
+	defer h.probesMutex.RUnlock()
+
+	report := common.HealthReport{
+		Status:     common.HealthStatusUp,
+		Components: make([]common.ComponentHealthStatus, 0, len(h.probes)),
+	}
+	for _, registered := range h.probes {
+		componentStatus := common.ComponentHealthStatus{
+			Name:   registered.name,
+			Status: common.HealthStatusUp,
+		}
+
+		err := checkHandler(registered.probe)
+		if err != nil {
+			componentStatus.Status = common.HealthStatusDown
+			componentStatus.Reason = err.Error()
+			report.Status = common.HealthStatusDown
+		}
+
+		report.Components = append(report.Components, componentStatus)
+	}
+
+	return report
+}
+
 // Start starts the health service
 func (h *healthService) Start() {
 	log.Debug("healthService.Start()")
@@ -109,6 +252,8 @@ func (h *healthService) monitorContinuously(ctx context.Context) {
 		select {
 		case <-chanMonitorMemory:
 			h.monitorMemory()
+			h.monitorGoroutines()
+			h.monitorBlockProcessing()
 			chanMonitorMemory = h.clock.after(intervalVerifyMemoryInSeconds)
 		case <-chanDiagnoseComponents:
 			h.diagnoseComponents(false)
@@ -133,7 +278,75 @@ func (h *healthService) monitorMemory() {
 	if int(stats.HeapInuse) > h.config.MemoryUsageToCreateProfiles {
 		recordObj := newMemoryUsageRecord(stats, h.clock.now(), h.folder)
 		h.records.addRecord(recordObj)
+		h.profiles.capture(reasonMemory)
+	}
+}
+
+// monitorGoroutines captures the profiles when the number of goroutines grows over its running average with more than
+// the configured percent
+func (h *healthService) monitorGoroutines() {
+	if h.config.GoroutinesSpikePercentToCreateProfiles <= 0 {
+		return
+	}
+
+	numGoroutines := h.numGoroutines()
+	average := h.goroutinesAverage
+	if average == 0 {
+		h.goroutinesAverage = float64(numGoroutines)
+		return
 	}
+	h.goroutinesAverage = average*(1-goroutinesAverageWeight) + float64(numGoroutines)*goroutinesAverageWeight
+
+	spikeThreshold := average * float64(100+h.config.GoroutinesSpikePercentToCreateProfiles) / 100
+	isSpike := numGoroutines >= h.config.MinNumGoroutinesToCreateProfiles && float64(numGoroutines) > spikeThreshold
+	if !isSpike {
+		return
+	}
+
+	log.Debug("healthService.monitorGoroutines(): goroutines spike detected",
+		"num goroutines", numGoroutines, "average", average)
+	if h.profiles.capture(reasonGoroutinesSpike) && h.goroutinesAnalyser != nil {
+		h.goroutinesAnalyser.DumpGoRoutinesToLogWithTypes()
+	}
+}
+
+// monitorBlockProcessing captures the profiles when the last processed block took longer than the configured duration
+func (h *healthService) monitorBlockProcessing() {
+	if h.config.BlockProcessingDurationToCreateProfilesMs == 0 {
+		return
+	}
+
+	h.statusMetricsMutex.RLock()
+	provider := h.statusMetrics
+	h.statusMetricsMutex.RUnlock()
+	if check.IfNil(provider) {
+		return
+	}
+
+	metrics := provider.StatusMetricsMapWithoutP2P()
+	durationInMs := getUint64Metric(metrics, common.MetricBlockProcessingDuration+"_"+common.BlockOperationProcess)
+	if durationInMs <= h.config.BlockProcessingDurationToCreateProfilesMs {
+		return
+	}
+
+	// the duration metric keeps its value until the next block is processed, so the same block is reported only once
+	observation := blockProcessingObservation{
+		nonce:        getUint64Metric(metrics, common.MetricNonce),
+		durationInMs: durationInMs,
+	}
+	if observation == h.lastSlowBlock {
+		return
+	}
+	h.lastSlowBlock = observation
+
+	log.Debug("healthService.monitorBlockProcessing(): slow block processing detected",
+		"duration in ms", durationInMs, "last nonce", observation.nonce)
+	h.profiles.capture(reasonSlowBlock)
+}
+
+func getUint64Metric(metrics map[string]interface{}, key string) uint64 {
+	value, _ := metrics[key].(uint64)
+	return value
 }
 
 func (h *healthService) diagnoseComponents(deep bool) {
@@ -150,6 +363,7 @@ func (h *healthService) diagnoseComponents(deep bool) {
 // Close stops the service
 func (h *healthService) Close() error {
 	h.cancelFunction()
+	h.profiles.close()
 	return nil
 }
 
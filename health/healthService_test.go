@@ -2,8 +2,11 @@ package health
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +39,54 @@ func TestHealthService_DiagnoseComponents(t *testing.T) {
 	require.Equal(t, 1, int(b.numShallowDiagnoses.Get()))
 }
 
+func TestHealthService_RegisterProbe(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	err := h.RegisterProbe("", &dummyProbe{})
+	require.Equal(t, ErrEmptyProbeName, err)
+
+	err = h.RegisterProbe("sync", (*dummyProbe)(nil))
+	require.Equal(t, ErrNilProbe, err)
+
+	err = h.RegisterProbe("sync", &dummyProbe{})
+	require.Nil(t, err)
+
+	err = h.RegisterProbe("sync", &dummyProbe{})
+	require.True(t, errors.Is(err, ErrProbeAlreadyRegistered))
+}
+
+func TestHealthService_ReportsWithoutProbesShouldBeUp(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	require.Equal(t, common.HealthReport{Status: common.HealthStatusUp, Components: []common.ComponentHealthStatus{}}, h.GetLivenessReport())
+	require.Equal(t, common.HealthReport{Status: common.HealthStatusUp, Components: []common.ComponentHealthStatus{}}, h.GetReadinessReport())
+}
+
+func TestHealthService_Reports(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	_ = h.RegisterProbe("sync", &dummyProbe{readinessErr: errors.New("not synchronized")})
+	_ = h.RegisterProbe("storage", &dummyProbe{})
+
+	livenessReport := h.GetLivenessReport()
+	require.Equal(t, common.HealthReport{
+		Status: common.HealthStatusUp,
+		Components: []common.ComponentHealthStatus{
+			{Name: "sync", Status: common.HealthStatusUp},
+			{Name: "storage", Status: common.HealthStatusUp},
+		},
+	}, livenessReport)
+
+	readinessReport := h.GetReadinessReport()
+	require.Equal(t, common.HealthReport{
+		Status: common.HealthStatusDown,
+		Components: []common.ComponentHealthStatus{
+			{Name: "sync", Status: common.HealthStatusDown, Reason: "not synchronized"},
+			{Name: "storage", Status: common.HealthStatusUp},
+		},
+	}, readinessReport)
+}
+
 func TestHealthService_MonitorMemory(t *testing.T) {
 	h := newHealthServiceToTest(42, 1)
 
@@ -63,6 +114,91 @@ func TestHealthService_MonitorMemory(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestHealthService_MonitorMemoryShouldCaptureProfiles(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	h.profiles = newProfilesCapturer(t.TempDir(), 10, 0, time.Hour, h.clock)
+	h.memory = newDummyMemory(43)
+	h.monitorMemory()
+
+	profiles, err := h.GetProfiles()
+	require.Nil(t, err)
+	require.Len(t, profiles, 2)
+
+	content, err := h.GetProfile(profiles[0].Name)
+	require.Nil(t, err)
+	require.NotEmpty(t, content)
+
+	// Cleanup after test
+	recordAsMemoryUsageRecord := h.records.getMostImportant().(*memoryUsageRecord)
+	err = recordAsMemoryUsageRecord.delete()
+	require.Nil(t, err)
+}
+
+func TestHealthService_MonitorGoroutines(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	h.config.GoroutinesSpikePercentToCreateProfiles = 50
+	h.config.MinNumGoroutinesToCreateProfiles = 100
+	h.profiles = newProfilesCapturer(t.TempDir(), 10, 0, time.Hour, h.clock)
+	numGoroutines := 80
+	h.numGoroutines = func() int {
+		return numGoroutines
+	}
+
+	// the first sample only sets the average
+	h.monitorGoroutines()
+	require.Equal(t, float64(80), h.goroutinesAverage)
+
+	// above the spike threshold, but below the minimum number of goroutines
+	numGoroutines = 99
+	h.monitorGoroutines()
+	requireNumProfiles(t, h, 0)
+
+	numGoroutines = 150
+	h.monitorGoroutines()
+	requireNumProfiles(t, h, 2)
+}
+
+func TestHealthService_MonitorBlockProcessing(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	h.config.BlockProcessingDurationToCreateProfilesMs = 1000
+	clock := newDummyClock()
+	h.profiles = newProfilesCapturer(t.TempDir(), 10, 0, 0, clock)
+
+	// no status metrics provider set
+	h.monitorBlockProcessing()
+	requireNumProfiles(t, h, 0)
+
+	require.Equal(t, ErrNilStatusMetricsProvider, h.SetStatusMetricsProvider(nil))
+	metrics := map[string]interface{}{
+		common.MetricNonce: uint64(10),
+		common.MetricBlockProcessingDuration + "_" + common.BlockOperationProcess: uint64(900),
+	}
+	require.Nil(t, h.SetStatusMetricsProvider(&dummyStatusMetrics{metrics: metrics}))
+
+	h.monitorBlockProcessing()
+	requireNumProfiles(t, h, 0)
+
+	metrics[common.MetricBlockProcessingDuration+"_"+common.BlockOperationProcess] = uint64(1500)
+	h.monitorBlockProcessing()
+	require.Equal(t, blockProcessingObservation{nonce: 10, durationInMs: 1500}, h.lastSlowBlock)
+	requireNumProfiles(t, h, 2)
+
+	// the same slow block should not be reported again
+	clock.tick()
+	h.monitorBlockProcessing()
+	requireNumProfiles(t, h, 2)
+
+	metrics[common.MetricNonce] = uint64(11)
+	h.monitorBlockProcessing()
+	requireNumProfiles(t, h, 4)
+}
+
+func requireNumProfiles(t *testing.T, h *healthService, expected int) {
+	profiles, err := h.GetProfiles()
+	require.Nil(t, err)
+	require.Len(t, profiles, expected)
+}
+
 func TestHealthService_MonitorContinuously(t *testing.T) {
 	h := newHealthServiceToTest(42, 1)
 	require.NotNil(t, h)
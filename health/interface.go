@@ -11,6 +11,25 @@ type diagnosable interface {
 	IsInterfaceNil() bool
 }
 
+// Probe defines a component which is able to report if it is live and if it is ready to be used. A nil error means
+// that the check passed, otherwise the error holds the reason of the failure
+type Probe interface {
+	CheckLiveness() error
+	CheckReadiness() error
+	IsInterfaceNil() bool
+}
+
+// StatusMetricsProvider defines the status metrics used by the health service to detect the slow blocks processing
+type StatusMetricsProvider interface {
+	StatusMetricsMapWithoutP2P() map[string]interface{}
+	IsInterfaceNil() bool
+}
+
+// goroutinesDumper is an internal interface, implemented by the goroutines analyser
+type goroutinesDumper interface {
+	DumpGoRoutinesToLogWithTypes()
+}
+
 // record in an internal interface, implemented by various health records (e.g. "memoryUsageRecord")
 type record interface {
 	save() error
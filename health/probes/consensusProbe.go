@@ -0,0 +1,82 @@
+package probes
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// ArgsConsensusProbe is the DTO used to create a consensus probe
+type ArgsConsensusProbe struct {
+	RoundHandler             RoundIndexProvider
+	NumRoundsWithoutProgress uint32
+}
+
+type consensusProbe struct {
+	roundHandler             RoundIndexProvider
+	numRoundsWithoutProgress uint32
+	getTimeHandler           func() time.Time
+
+	mutLastRound     sync.Mutex
+	lastRoundIndex   int64
+	lastRoundChanged time.Time
+}
+
+// NewConsensusProbe creates a probe which reports the node as not live when the round index does not change for
+// the configured number of rounds, meaning that the chronology of the node is stuck
+func NewConsensusProbe(args ArgsConsensusProbe) (*consensusProbe, error) {
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundIndexProvider
+	}
+	if args.NumRoundsWithoutProgress == 0 {
+		return nil, ErrInvalidNumRoundsWithoutProgress
+	}
+
+	cp := &consensusProbe{
+		roundHandler:             args.RoundHandler,
+		numRoundsWithoutProgress: args.NumRoundsWithoutProgress,
+		getTimeHandler:           time.Now,
+	}
+	cp.lastRoundIndex = cp.roundHandler.Index()
+	cp.lastRoundChanged = cp.getTimeHandler()
+
+	return cp, nil
+}
+
+// CheckLiveness returns an error if the round index did not change for too long
+func (cp *consensusProbe) CheckLiveness() error {
+	return cp.checkRoundProgress()
+}
+
+// CheckReadiness returns an error if the round index did not change for too long
+func (cp *consensusProbe) CheckReadiness() error {
+	return cp.checkRoundProgress()
+}
+
+func (cp *consensusProbe) checkRoundProgress() error {
+	cp.mutLastRound.Lock()
+	defer cp.mutLastRound.Unlock()
+
+	now := cp.getTimeHandler()
+	currentRoundIndex := cp.roundHandler.Index()
+	if currentRoundIndex != cp.lastRoundIndex {
+		cp.lastRoundIndex = currentRoundIndex
+		cp.lastRoundChanged = now
+		return nil
+	}
+
+	maxDurationWithoutProgress := time.Duration(cp.numRoundsWithoutProgress) * cp.roundHandler.TimeDuration()
+	durationWithoutProgress := now.Sub(cp.lastRoundChanged)
+	if durationWithoutProgress > maxDurationWithoutProgress {
+		return fmt.Errorf("%w: round %d since %s", ErrRoundNotAdvancing, currentRoundIndex, durationWithoutProgress)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *consensusProbe) IsInterfaceNil() bool {
+	return cp == nil
+}
@@ -0,0 +1,83 @@
+package probes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsConsensusProbe() ArgsConsensusProbe {
+	return ArgsConsensusProbe{
+		RoundHandler:             &testscommon.RoundHandlerMock{},
+		NumRoundsWithoutProgress: 10,
+	}
+}
+
+func TestNewConsensusProbe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusProbe()
+		args.RoundHandler = nil
+		cp, err := NewConsensusProbe(args)
+		assert.True(t, check.IfNil(cp))
+		assert.Equal(t, ErrNilRoundIndexProvider, err)
+	})
+	t.Run("invalid number of rounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConsensusProbe()
+		args.NumRoundsWithoutProgress = 0
+		cp, err := NewConsensusProbe(args)
+		assert.True(t, check.IfNil(cp))
+		assert.Equal(t, ErrInvalidNumRoundsWithoutProgress, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cp, err := NewConsensusProbe(createMockArgsConsensusProbe())
+		assert.False(t, check.IfNil(cp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestConsensusProbe_Checks(t *testing.T) {
+	t.Parallel()
+
+	roundIndex := int64(5)
+	args := createMockArgsConsensusProbe()
+	args.NumRoundsWithoutProgress = 2
+	args.RoundHandler = &testscommon.RoundHandlerMock{
+		IndexCalled: func() int64 {
+			return roundIndex
+		},
+		TimeDurationCalled: func() time.Duration {
+			return time.Second
+		},
+	}
+	cp, _ := NewConsensusProbe(args)
+	currentTime := time.Unix(1000, 0)
+	cp.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	cp.lastRoundChanged = currentTime
+
+	currentTime = currentTime.Add(2 * time.Second)
+	assert.Nil(t, cp.CheckLiveness())
+	assert.Nil(t, cp.CheckReadiness())
+
+	currentTime = currentTime.Add(time.Millisecond)
+	assert.True(t, errors.Is(cp.CheckLiveness(), ErrRoundNotAdvancing))
+	assert.True(t, errors.Is(cp.CheckReadiness(), ErrRoundNotAdvancing))
+
+	roundIndex++
+	assert.Nil(t, cp.CheckLiveness())
+	currentTime = currentTime.Add(2 * time.Second)
+	assert.Nil(t, cp.CheckReadiness())
+}
@@ -0,0 +1,36 @@
+package probes
+
+import "errors"
+
+// ErrNilNodeStateProvider signals that a nil node state provider has been provided
+var ErrNilNodeStateProvider = errors.New("nil node state provider")
+
+// ErrNilConnectedPeersProvider signals that a nil connected peers provider has been provided
+var ErrNilConnectedPeersProvider = errors.New("nil connected peers provider")
+
+// ErrNilRoundIndexProvider signals that a nil round index provider has been provided
+var ErrNilRoundIndexProvider = errors.New("nil round index provider")
+
+// ErrNilOutportStatusProvider signals that a nil outport status provider has been provided
+var ErrNilOutportStatusProvider = errors.New("nil outport status provider")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidNumRoundsWithoutProgress signals that an invalid number of rounds without progress has been provided
+var ErrInvalidNumRoundsWithoutProgress = errors.New("invalid number of rounds without progress")
+
+// ErrInvalidMaxRetryingDuration signals that an invalid maximum retrying duration has been provided
+var ErrInvalidMaxRetryingDuration = errors.New("invalid maximum retrying duration")
+
+// ErrNodeNotSynchronized signals that the node is not synchronized
+var ErrNodeNotSynchronized = errors.New("node is not synchronized")
+
+// ErrNoConnectedPeers signals that the node is not connected to any peer
+var ErrNoConnectedPeers = errors.New("no connected peers")
+
+// ErrRoundNotAdvancing signals that the round index did not change for too long
+var ErrRoundNotAdvancing = errors.New("round index is not advancing")
+
+// ErrOutportRetrying signals that the outport is retrying to push data to a driver for too long
+var ErrOutportRetrying = errors.New("outport is retrying to push data for too long")
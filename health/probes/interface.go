@@ -0,0 +1,40 @@
+package probes
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// NodeStateProvider defines a component able to tell the synchronization state of the node
+type NodeStateProvider interface {
+	GetNodeState() common.NodeState
+	IsInterfaceNil() bool
+}
+
+// ConnectedPeersProvider defines a component able to provide the connected peers
+type ConnectedPeersProvider interface {
+	ConnectedPeers() []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// RoundIndexProvider defines a component able to provide the current round index and the round duration
+type RoundIndexProvider interface {
+	Index() int64
+	TimeDuration() time.Duration
+	IsInterfaceNil() bool
+}
+
+// OutportStatusProvider defines a component able to tell for how long it has been retrying to push data to its drivers
+type OutportStatusProvider interface {
+	HasDrivers() bool
+	GetRetryingDuration() time.Duration
+	IsInterfaceNil() bool
+}
+
+// HasChecker defines a storer able to tell if it holds a key
+type HasChecker interface {
+	Has(key []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,53 @@
+package probes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+type outportProbe struct {
+	outportHandler      OutportStatusProvider
+	maxRetryingDuration time.Duration
+}
+
+// NewOutportProbe creates a probe which reports the node as not ready when the outport is retrying to push data to
+// one of its drivers for more than the provided duration
+func NewOutportProbe(outportHandler OutportStatusProvider, maxRetryingDuration time.Duration) (*outportProbe, error) {
+	if check.IfNil(outportHandler) {
+		return nil, ErrNilOutportStatusProvider
+	}
+	if maxRetryingDuration <= 0 {
+		return nil, ErrInvalidMaxRetryingDuration
+	}
+
+	return &outportProbe{
+		outportHandler:      outportHandler,
+		maxRetryingDuration: maxRetryingDuration,
+	}, nil
+}
+
+// CheckLiveness returns nil as the outport recovers once the driver becomes available again
+func (op *outportProbe) CheckLiveness() error {
+	return nil
+}
+
+// CheckReadiness returns an error if the outport is retrying to push data for too long
+func (op *outportProbe) CheckReadiness() error {
+	if !op.outportHandler.HasDrivers() {
+		return nil
+	}
+
+	retryingDuration := op.outportHandler.GetRetryingDuration()
+	if retryingDuration > op.maxRetryingDuration {
+		return fmt.Errorf("%w: retrying since %s", ErrOutportRetrying, retryingDuration)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (op *outportProbe) IsInterfaceNil() bool {
+	return op == nil
+}
@@ -0,0 +1,52 @@
+package probes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOutportProbe(t *testing.T) {
+	t.Parallel()
+
+	op, err := NewOutportProbe(nil, time.Second)
+	assert.True(t, check.IfNil(op))
+	assert.Equal(t, ErrNilOutportStatusProvider, err)
+
+	op, err = NewOutportProbe(&testscommon.OutportStub{}, 0)
+	assert.True(t, check.IfNil(op))
+	assert.Equal(t, ErrInvalidMaxRetryingDuration, err)
+
+	op, err = NewOutportProbe(&testscommon.OutportStub{}, time.Second)
+	assert.False(t, check.IfNil(op))
+	assert.Nil(t, err)
+}
+
+func TestOutportProbe_Checks(t *testing.T) {
+	t.Parallel()
+
+	hasDrivers := false
+	retryingDuration := 2 * time.Second
+	op, _ := NewOutportProbe(&testscommon.OutportStub{
+		HasDriversCalled: func() bool {
+			return hasDrivers
+		},
+		GetRetryingDurationCalled: func() time.Duration {
+			return retryingDuration
+		},
+	}, time.Second)
+
+	assert.Nil(t, op.CheckLiveness())
+	assert.Nil(t, op.CheckReadiness())
+
+	hasDrivers = true
+	assert.Nil(t, op.CheckLiveness())
+	assert.True(t, errors.Is(op.CheckReadiness(), ErrOutportRetrying))
+
+	retryingDuration = 0
+	assert.Nil(t, op.CheckReadiness())
+}
@@ -0,0 +1,39 @@
+package probes
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+type p2pProbe struct {
+	connectedPeersProvider ConnectedPeersProvider
+}
+
+// NewP2PProbe creates a probe which reports the node as ready only when it is connected to at least one peer
+func NewP2PProbe(connectedPeersProvider ConnectedPeersProvider) (*p2pProbe, error) {
+	if check.IfNil(connectedPeersProvider) {
+		return nil, ErrNilConnectedPeersProvider
+	}
+
+	return &p2pProbe{
+		connectedPeersProvider: connectedPeersProvider,
+	}, nil
+}
+
+// CheckLiveness returns nil as the node can recover its connections
+func (pp *p2pProbe) CheckLiveness() error {
+	return nil
+}
+
+// CheckReadiness returns an error if the node has no connected peers
+func (pp *p2pProbe) CheckReadiness() error {
+	if len(pp.connectedPeersProvider.ConnectedPeers()) == 0 {
+		return ErrNoConnectedPeers
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *p2pProbe) IsInterfaceNil() bool {
+	return pp == nil
+}
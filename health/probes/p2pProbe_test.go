@@ -0,0 +1,40 @@
+package probes
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewP2PProbe(t *testing.T) {
+	t.Parallel()
+
+	pp, err := NewP2PProbe(nil)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, ErrNilConnectedPeersProvider, err)
+
+	pp, err = NewP2PProbe(&p2pmocks.MessengerStub{})
+	assert.False(t, check.IfNil(pp))
+	assert.Nil(t, err)
+}
+
+func TestP2PProbe_Checks(t *testing.T) {
+	t.Parallel()
+
+	connectedPeers := make([]core.PeerID, 0)
+	pp, _ := NewP2PProbe(&p2pmocks.MessengerStub{
+		ConnectedPeersCalled: func() []core.PeerID {
+			return connectedPeers
+		},
+	})
+
+	assert.Nil(t, pp.CheckLiveness())
+	assert.Equal(t, ErrNoConnectedPeers, pp.CheckReadiness())
+
+	connectedPeers = append(connectedPeers, "peer")
+	assert.Nil(t, pp.CheckLiveness())
+	assert.Nil(t, pp.CheckReadiness())
+}
@@ -0,0 +1,51 @@
+package probes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var probeKey = []byte("healthProbe")
+
+type storageProbe struct {
+	storer HasChecker
+}
+
+// NewStorageProbe creates a probe which checks that the provided storer can still be read. The probe key is not
+// expected to exist, so a "key not found" answer means the storer works properly
+func NewStorageProbe(storer HasChecker) (*storageProbe, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &storageProbe{
+		storer: storer,
+	}, nil
+}
+
+// CheckLiveness returns an error if the storer can not be read
+func (sp *storageProbe) CheckLiveness() error {
+	return sp.checkStorer()
+}
+
+// CheckReadiness returns an error if the storer can not be read
+func (sp *storageProbe) CheckReadiness() error {
+	return sp.checkStorer()
+}
+
+func (sp *storageProbe) checkStorer() error {
+	err := sp.storer.Has(probeKey)
+	if err == nil || errors.Is(err, storage.ErrKeyNotFound) {
+		return nil
+	}
+
+	return fmt.Errorf("storer is not accessible: %w", err)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *storageProbe) IsInterfaceNil() bool {
+	return sp == nil
+}
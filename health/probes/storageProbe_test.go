@@ -0,0 +1,46 @@
+package probes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStorageProbe(t *testing.T) {
+	t.Parallel()
+
+	sp, err := NewStorageProbe(nil)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, ErrNilStorer, err)
+
+	sp, err = NewStorageProbe(&storageStubs.StorerStub{})
+	assert.False(t, check.IfNil(sp))
+	assert.Nil(t, err)
+}
+
+func TestStorageProbe_Checks(t *testing.T) {
+	t.Parallel()
+
+	var hasErr error
+	sp, _ := NewStorageProbe(&storageStubs.StorerStub{
+		HasCalled: func(key []byte) error {
+			assert.Equal(t, probeKey, key)
+			return hasErr
+		},
+	})
+
+	assert.Nil(t, sp.CheckLiveness())
+	assert.Nil(t, sp.CheckReadiness())
+
+	hasErr = storage.ErrKeyNotFound
+	assert.Nil(t, sp.CheckLiveness())
+	assert.Nil(t, sp.CheckReadiness())
+
+	hasErr = storage.ErrDBIsClosed
+	assert.True(t, errors.Is(sp.CheckLiveness(), storage.ErrDBIsClosed))
+	assert.True(t, errors.Is(sp.CheckReadiness(), storage.ErrDBIsClosed))
+}
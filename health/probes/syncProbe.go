@@ -0,0 +1,40 @@
+package probes
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+type syncProbe struct {
+	nodeStateProvider NodeStateProvider
+}
+
+// NewSyncProbe creates a probe which reports the node as ready only when it is synchronized
+func NewSyncProbe(nodeStateProvider NodeStateProvider) (*syncProbe, error) {
+	if check.IfNil(nodeStateProvider) {
+		return nil, ErrNilNodeStateProvider
+	}
+
+	return &syncProbe{
+		nodeStateProvider: nodeStateProvider,
+	}, nil
+}
+
+// CheckLiveness returns nil as a syncing node is still live
+func (sp *syncProbe) CheckLiveness() error {
+	return nil
+}
+
+// CheckReadiness returns an error if the node is not synchronized
+func (sp *syncProbe) CheckReadiness() error {
+	if sp.nodeStateProvider.GetNodeState() != common.NsSynchronized {
+		return ErrNodeNotSynchronized
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *syncProbe) IsInterfaceNil() bool {
+	return sp == nil
+}
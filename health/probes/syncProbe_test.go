@@ -0,0 +1,40 @@
+package probes
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSyncProbe(t *testing.T) {
+	t.Parallel()
+
+	sp, err := NewSyncProbe(nil)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, ErrNilNodeStateProvider, err)
+
+	sp, err = NewSyncProbe(&mock.BootstrapperStub{})
+	assert.False(t, check.IfNil(sp))
+	assert.Nil(t, err)
+}
+
+func TestSyncProbe_Checks(t *testing.T) {
+	t.Parallel()
+
+	nodeState := common.NsNotSynchronized
+	sp, _ := NewSyncProbe(&mock.BootstrapperStub{
+		GetNodeStateCalled: func() common.NodeState {
+			return nodeState
+		},
+	})
+
+	assert.Nil(t, sp.CheckLiveness())
+	assert.Equal(t, ErrNodeNotSynchronized, sp.CheckReadiness())
+
+	nodeState = common.NsSynchronized
+	assert.Nil(t, sp.CheckLiveness())
+	assert.Nil(t, sp.CheckReadiness())
+}
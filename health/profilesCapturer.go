@@ -0,0 +1,235 @@
+package health
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	profilePrefix          = "profile__"
+	profileExtension       = ".pprof"
+	profileTimestampFormat = "20060102150405"
+	heapProfile            = "heap"
+	goroutineProfile       = "goroutine"
+	cpuProfile             = "cpu"
+)
+
+// profilesCapturer writes heap, goroutine and CPU profiles into the health folder. The profiles captured at the same
+// moment share the same capture identifier (prefix, timestamp and reason), which is used when rotating them
+type profilesCapturer struct {
+	folder                     string
+	numCapturesToKeep          int
+	cpuProfileDuration         time.Duration
+	minIntervalBetweenCaptures time.Duration
+	clock                      clock
+	mutex                      sync.Mutex
+	lastCaptureTimes           map[string]time.Time
+	chanClose                  chan struct{}
+	closeOnce                  sync.Once
+}
+
+func newProfilesCapturer(
+	folder string,
+	numCapturesToKeep int,
+	cpuProfileDuration time.Duration,
+	minIntervalBetweenCaptures time.Duration,
+	clock clock,
+) *profilesCapturer {
+	return &profilesCapturer{
+		folder:                     folder,
+		numCapturesToKeep:          numCapturesToKeep,
+		cpuProfileDuration:         cpuProfileDuration,
+		minIntervalBetweenCaptures: minIntervalBetweenCaptures,
+		clock:                      clock,
+		lastCaptureTimes:           make(map[string]time.Time),
+		chanClose:                  make(chan struct{}),
+	}
+}
+
+// capture writes a new set of profiles for the provided reason. Returns false if the captures are disabled or if a
+// capture for the same reason was done less than the minimum interval ago
+func (pc *profilesCapturer) capture(reason string) bool {
+	if pc.numCapturesToKeep <= 0 {
+		return false
+	}
+
+	now := pc.clock.now()
+
+	pc.mutex.Lock()
+	lastCapture, found := pc.lastCaptureTimes[reason]
+	if found && now.Sub(lastCapture) < pc.minIntervalBetweenCaptures {
+		pc.mutex.Unlock()
+		return false
+	}
+	pc.lastCaptureTimes[reason] = now
+	pc.mutex.Unlock()
+
+	captureID := fmt.Sprintf("%s%s__%s", profilePrefix, now.Format(profileTimestampFormat), reason)
+	log.Info("capturing profiles", "reason", reason, "capture", captureID, "folder", pc.folder)
+
+	pc.writeProfile(captureID, heapProfile)
+	pc.writeProfile(captureID, goroutineProfile)
+	pc.startCPUProfile(captureID)
+	pc.rotate()
+
+	return true
+}
+
+func (pc *profilesCapturer) getFilename(captureID string, profileType string) string {
+	return path.Join(pc.folder, captureID+"__"+profileType+profileExtension)
+}
+
+func (pc *profilesCapturer) writeProfile(captureID string, profileType string) {
+	profile := pprof.Lookup(profileType)
+	if profile == nil {
+		log.Error("profilesCapturer.writeProfile: unknown profile", "type", profileType)
+		return
+	}
+
+	file, err := os.Create(pc.getFilename(captureID, profileType))
+	if err != nil {
+		log.Error("profilesCapturer.writeProfile", "type", profileType, "err", err)
+		return
+	}
+
+	err = profile.WriteTo(file, 0)
+	log.LogIfError(err)
+	log.LogIfError(file.Close())
+}
+
+func (pc *profilesCapturer) startCPUProfile(captureID string) {
+	if pc.cpuProfileDuration <= 0 {
+		return
+	}
+
+	filename := pc.getFilename(captureID, cpuProfile)
+	file, err := os.Create(filename)
+	if err != nil {
+		log.Error("profilesCapturer.startCPUProfile", "err", err)
+		return
+	}
+
+	// the CPU profiling fails if another CPU profile is running, e.g. one requested on the pprof route
+	err = pprof.StartCPUProfile(file)
+	if err != nil {
+		log.Warn("profilesCapturer.startCPUProfile: can not start CPU profiling", "err", err)
+		log.LogIfError(file.Close())
+		log.LogIfError(os.Remove(filename))
+		return
+	}
+
+	go func() {
+		select {
+		case <-pc.clock.after(pc.cpuProfileDuration):
+		case <-pc.chanClose:
+		}
+
+		pprof.StopCPUProfile()
+		log.LogIfError(file.Close())
+	}()
+}
+
+// rotate removes the oldest captures so that at most numCapturesToKeep captures remain in the folder
+func (pc *profilesCapturer) rotate() {
+	files, err := ioutil.ReadDir(pc.folder)
+	if err != nil {
+		log.Error("profilesCapturer.rotate", "err", err)
+		return
+	}
+
+	filesByCapture := make(map[string][]string)
+	for _, file := range files {
+		captureID, ok := getCaptureID(file.Name())
+		if !ok {
+			continue
+		}
+
+		filesByCapture[captureID] = append(filesByCapture[captureID], file.Name())
+	}
+	if len(filesByCapture) <= pc.numCapturesToKeep {
+		return
+	}
+
+	captureIDs := make([]string, 0, len(filesByCapture))
+	for captureID := range filesByCapture {
+		captureIDs = append(captureIDs, captureID)
+	}
+	// the timestamp follows the prefix, so the lexicographic order is the chronological one
+	sort.Strings(captureIDs)
+
+	numCapturesToRemove := len(captureIDs) - pc.numCapturesToKeep
+	for _, captureID := range captureIDs[:numCapturesToRemove] {
+		for _, filename := range filesByCapture[captureID] {
+			err = os.Remove(path.Join(pc.folder, filename))
+			log.LogIfError(err)
+		}
+	}
+}
+
+func getCaptureID(filename string) (string, bool) {
+	if !strings.HasPrefix(filename, profilePrefix) || !strings.HasSuffix(filename, profileExtension) {
+		return "", false
+	}
+
+	separatorIndex := strings.LastIndex(filename, "__")
+	if separatorIndex < len(profilePrefix) {
+		return "", false
+	}
+
+	return filename[:separatorIndex], true
+}
+
+// listProfiles returns the profiles files found in the folder, sorted by name, including the memory usage records
+func (pc *profilesCapturer) listProfiles() ([]common.HealthProfileInfo, error) {
+	files, err := ioutil.ReadDir(pc.folder)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make([]common.HealthProfileInfo, 0), nil
+		}
+		return nil, err
+	}
+
+	profiles := make([]common.HealthProfileInfo, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), profileExtension) {
+			continue
+		}
+
+		profiles = append(profiles, common.HealthProfileInfo{
+			Name:        file.Name(),
+			SizeInBytes: file.Size(),
+			Timestamp:   file.ModTime().Unix(),
+		})
+	}
+
+	return profiles, nil
+}
+
+// getProfile returns the content of a profile file from the folder
+func (pc *profilesCapturer) getProfile(name string) ([]byte, error) {
+	if path.Base(name) != name || strings.Contains(name, "\\") || !strings.HasSuffix(name, profileExtension) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProfileName, name)
+	}
+
+	content, err := ioutil.ReadFile(path.Join(pc.folder, name))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+
+	return content, err
+}
+
+func (pc *profilesCapturer) close() {
+	pc.closeOnce.Do(func() {
+		close(pc.chanClose)
+	})
+}
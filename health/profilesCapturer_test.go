@@ -0,0 +1,108 @@
+package health
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilesCapturer_CaptureShouldWriteProfiles(t *testing.T) {
+	folder := t.TempDir()
+	clock := newDummyClock()
+	pc := newProfilesCapturer(folder, 10, 0, time.Minute, clock)
+
+	require.True(t, pc.capture(reasonMemory))
+
+	captureID := profilePrefix + clock.now().Format(profileTimestampFormat) + "__" + reasonMemory
+	require.FileExists(t, pc.getFilename(captureID, heapProfile))
+	require.FileExists(t, pc.getFilename(captureID, goroutineProfile))
+	require.NoFileExists(t, pc.getFilename(captureID, cpuProfile))
+}
+
+func TestProfilesCapturer_CaptureShouldRespectMinInterval(t *testing.T) {
+	clock := newDummyClock()
+	pc := newProfilesCapturer(t.TempDir(), 10, 0, 2*time.Second, clock)
+
+	require.True(t, pc.capture(reasonMemory))
+	require.False(t, pc.capture(reasonMemory))
+	require.True(t, pc.capture(reasonSlowBlock))
+
+	clock.tick()
+	require.False(t, pc.capture(reasonMemory))
+	clock.tick()
+	require.True(t, pc.capture(reasonMemory))
+}
+
+func TestProfilesCapturer_CaptureDisabled(t *testing.T) {
+	folder := t.TempDir()
+	pc := newProfilesCapturer(folder, 0, 0, 0, newDummyClock())
+
+	require.False(t, pc.capture(reasonMemory))
+
+	profiles, err := pc.listProfiles()
+	require.Nil(t, err)
+	require.Empty(t, profiles)
+}
+
+func TestProfilesCapturer_RotateShouldKeepTheNewestCaptures(t *testing.T) {
+	folder := t.TempDir()
+	clock := newDummyClock()
+	pc := newProfilesCapturer(folder, 2, 0, 0, clock)
+
+	firstCaptureID := profilePrefix + clock.now().Format(profileTimestampFormat) + "__" + reasonMemory
+	require.True(t, pc.capture(reasonMemory))
+	clock.tick()
+	require.True(t, pc.capture(reasonMemory))
+	clock.tick()
+	require.True(t, pc.capture(reasonMemory))
+
+	// files not written by the capturer should not be touched
+	otherFile := path.Join(folder, "mem__20210101000000__1_GB.pprof")
+	require.Nil(t, ioutil.WriteFile(otherFile, []byte("mem"), os.ModePerm))
+	pc.rotate()
+
+	require.NoFileExists(t, pc.getFilename(firstCaptureID, heapProfile))
+	require.FileExists(t, otherFile)
+
+	profiles, err := pc.listProfiles()
+	require.Nil(t, err)
+	require.Len(t, profiles, 5)
+}
+
+func TestProfilesCapturer_GetProfile(t *testing.T) {
+	folder := t.TempDir()
+	pc := newProfilesCapturer(folder, 1, 0, 0, newDummyClock())
+
+	profileName := "profile__20210101000000__memory__heap.pprof"
+	require.Nil(t, ioutil.WriteFile(path.Join(folder, profileName), []byte("content"), os.ModePerm))
+
+	content, err := pc.getProfile(profileName)
+	require.Nil(t, err)
+	require.Equal(t, []byte("content"), content)
+
+	_, err = pc.getProfile("../" + profileName)
+	require.True(t, errors.Is(err, ErrInvalidProfileName))
+
+	_, err = pc.getProfile("config.toml")
+	require.True(t, errors.Is(err, ErrInvalidProfileName))
+
+	_, err = pc.getProfile("missing.pprof")
+	require.True(t, errors.Is(err, ErrProfileNotFound))
+}
+
+func TestGetCaptureID(t *testing.T) {
+	captureID, ok := getCaptureID("profile__20210101000000__memory__heap.pprof")
+	require.True(t, ok)
+	require.Equal(t, "profile__20210101000000__memory", captureID)
+
+	_, ok = getCaptureID("mem__20210101000000__1_GB.pprof")
+	require.False(t, ok)
+
+	_, ok = getCaptureID("profile__heap.txt")
+	require.False(t, ok)
+}
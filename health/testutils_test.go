@@ -157,3 +157,37 @@ func (dummy *dummyClock) getEventToHappenNoLock() (eventToHappen *dummyEvent, ev
 
 	return
 }
+
+type dummyProbe struct {
+	livenessErr  error
+	readinessErr error
+}
+
+// CheckLiveness -
+func (dummy *dummyProbe) CheckLiveness() error {
+	return dummy.livenessErr
+}
+
+// CheckReadiness -
+func (dummy *dummyProbe) CheckReadiness() error {
+	return dummy.readinessErr
+}
+
+// IsInterfaceNil -
+func (dummy *dummyProbe) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyStatusMetrics struct {
+	metrics map[string]interface{}
+}
+
+// StatusMetricsMapWithoutP2P -
+func (dummy *dummyStatusMetrics) StatusMetricsMapWithoutP2P() map[string]interface{} {
+	return dummy.metrics
+}
+
+// IsInterfaceNil -
+func (dummy *dummyStatusMetrics) IsInterfaceNil() bool {
+	return dummy == nil
+}
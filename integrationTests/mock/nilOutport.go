@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -60,3 +62,8 @@ func (n *nilOutport) SubscribeDriver(_ outport.Driver) error {
 func (n *nilOutport) HasDrivers() bool {
 	return false
 }
+
+// GetRetryingDuration -
+func (n *nilOutport) GetRetryingDuration() time.Duration {
+	return 0
+}
@@ -1,5 +1,7 @@
 package mock
 
+import "time"
+
 // StatusMetricsStub -
 type StatusMetricsStub struct {
 	StatusMetricsMapWithoutP2PCalled              func() map[string]interface{}
@@ -9,6 +11,8 @@ type StatusMetricsStub struct {
 	NetworkMetricsCalled                          func() map[string]interface{}
 	EconomicsMetricsCalled                        func() map[string]interface{}
 	StatusMetricsWithoutP2PPrometheusStringCalled func() string
+	StatusMetricsOpenMetricsStringCalled          func() string
+	ObserveApiRouteDurationCalled                 func(route string, duration time.Duration)
 }
 
 // StatusMetricsWithoutP2PPrometheusString -
@@ -20,6 +24,22 @@ func (sms *StatusMetricsStub) StatusMetricsWithoutP2PPrometheusString() string {
 	return "metric 10"
 }
 
+// StatusMetricsOpenMetricsString -
+func (sms *StatusMetricsStub) StatusMetricsOpenMetricsString() string {
+	if sms.StatusMetricsOpenMetricsStringCalled != nil {
+		return sms.StatusMetricsOpenMetricsStringCalled()
+	}
+
+	return "# EOF\n"
+}
+
+// ObserveApiRouteDuration -
+func (sms *StatusMetricsStub) ObserveApiRouteDuration(route string, duration time.Duration) {
+	if sms.ObserveApiRouteDurationCalled != nil {
+		sms.ObserveApiRouteDurationCalled(route, duration)
+	}
+}
+
 // ConfigMetrics -
 func (sms *StatusMetricsStub) ConfigMetrics() map[string]interface{} {
 	return sms.ConfigMetricsCalled()
@@ -1,8 +1,9 @@
 package startInEpoch
 
 import (
+	"fmt"
 	"math/big"
-	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -168,10 +169,15 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		},
 	}
 
-	defer func() {
-		errRemoveDir := os.RemoveAll("Epoch_0")
-		assert.NoError(t, errRemoveDir)
-	}()
+	workingDir := t.TempDir()
+	pathManager := &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(workingDir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+		},
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			return filepath.Join(workingDir, "Static", fmt.Sprintf("Shard_%s", shardId), identifier)
+		},
+	}
 
 	genesisShardCoordinator, _ := sharding.NewMultiShardCoordinator(nodesConfig.NumberOfShards(), 0)
 
@@ -200,7 +206,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 	coreComponents.HasherField = integrationTests.TestHasher
 	coreComponents.AddressPubKeyConverterField = integrationTests.TestAddressPubkeyConverter
 	coreComponents.Uint64ByteSliceConverterField = uint64Converter
-	coreComponents.PathHandlerField = &testscommon.PathManagerStub{}
+	coreComponents.PathHandlerField = pathManager
 	coreComponents.ChainIdCalled = func() string {
 		return string(integrationTests.ChainID)
 	}
@@ -256,7 +262,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		&generalConfig,
 		&prefsConfig,
 		shardC,
-		&testscommon.PathManagerStub{},
+		pathManager,
 		notifier.NewEpochStartSubscriptionHandler(),
 		&nodeTypeProviderMock.NodeTypeProviderStub{},
 		0,
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	nodeFacade "github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
@@ -89,18 +90,19 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 		AccountsState:   tpn.AccntState,
 		PeerState:       tpn.PeerState,
 		Blockchain:      tpn.BlockChain,
+		HealthService:   health.NewHealthService(config.HealthServiceConfig{}, ""),
 	}
 }
 
 func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
-		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
+		"node":        {"/status", "/metrics", "/openmetrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo", "/health/live", "/health/ready", "/profiles", "/profiles/:name"},
 		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
 		"hardfork":    {"/trigger"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
-		"vm-values":   {"/hex", "/string", "/int", "/query"},
+		"vm-values":   {"/hex", "/string", "/int", "/query", "/query/batch"},
 		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
@@ -155,7 +157,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		txTypeHandler,
 		tpn.EconomicsData,
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *dataTransaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *dataTransaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{}, nil
 			},
 		},
@@ -186,13 +188,25 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	delegationPortfolioHandler, err := factory.CreateDelegationPortfolioHandler(args)
+	log.LogIfError(err)
+
+	argsGovernance := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: args,
+		BlockChain:               tpn.BlockChain,
+	}
+	governanceHandler, err := factory.CreateGovernanceHandler(argsGovernance)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
-		SCQueryService:          tpn.SCQueryService,
-		StatusMetricsHandler:    &mock.StatusMetricsStub{},
-		TxCostHandler:           txCostHandler,
-		TotalStakedValueHandler: totalStakedValueHandler,
-		DirectStakedListHandler: directStakedListHandler,
-		DelegatedListHandler:    delegatedListHandler,
+		SCQueryService:             tpn.SCQueryService,
+		StatusMetricsHandler:       &mock.StatusMetricsStub{},
+		TxCostHandler:              txCostHandler,
+		TotalStakedValueHandler:    totalStakedValueHandler,
+		DirectStakedListHandler:    directStakedListHandler,
+		DelegatedListHandler:       delegatedListHandler,
+		GovernanceHandler:          governanceHandler,
+		DelegationPortfolioHandler: delegationPortfolioHandler,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -206,6 +220,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		BuiltInFunctions:          tpn.BlockchainHook.GetBuiltinFunctionsContainer(),
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
@@ -244,6 +259,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["hardfork"] = hardforkGroup
 	}
 
+	logsGroup, err := groups.NewLogsGroup(facade)
+	if err == nil {
+		groupsMap["logs"] = logsGroup
+	}
+
 	networkGroup, err := groups.NewNetworkGroup(facade)
 	if err == nil {
 		groupsMap["network"] = networkGroup
@@ -259,6 +279,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["proof"] = proofGroup
 	}
 
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(facade)
+	if err == nil {
+		groupsMap["subscriptions"] = subscriptionsGroup
+	}
+
 	transactionGroup, err := groups.NewTransactionGroup(facade)
 	if err == nil {
 		groupsMap["transaction"] = transactionGroup
@@ -946,6 +946,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BuiltInFunctions = blockChainHook.GetBuiltinFunctionsContainer()
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
@@ -150,3 +150,12 @@ var ErrCannotCastAccountHandlerToUserAccountHandler = errors.New("cannot cast ac
 
 // ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler signals that an user account handler cannot be cast to vm common user account handler
 var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("cannot cast user account handler to vm common user account handler")
+
+// ErrTxPoolInspectionNotSupported signals that the transactions pool of the current shard cannot be inspected
+var ErrTxPoolInspectionNotSupported = errors.New("transactions pool inspection not supported")
+
+// ErrNilAccountsAdapterWithHistory signals that a nil accounts adapter with history has been provided
+var ErrNilAccountsAdapterWithHistory = errors.New("nil accounts adapter with history")
+
+// ErrBlockRootHashNotFound signals that the block requested by an account query cannot be found in storage
+var ErrBlockRootHashNotFound = errors.New("block not found, cannot get its root hash")
@@ -1,8 +1,11 @@
 package external
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -10,6 +13,7 @@ import (
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -20,6 +24,8 @@ type StatusMetricsHandler interface {
 	StatusMetricsMapWithoutP2P() map[string]interface{}
 	StatusP2pMetricsMap() map[string]interface{}
 	StatusMetricsWithoutP2PPrometheusString() string
+	StatusMetricsOpenMetricsString() string
+	ObserveApiRouteDuration(route string, duration time.Duration)
 	EconomicsMetrics() map[string]interface{}
 	ConfigMetrics() map[string]interface{}
 	EnableEpochsMetrics() map[string]interface{}
@@ -50,3 +56,17 @@ type DelegatedListHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	IsInterfaceNil() bool
 }
+
+// DelegationPortfolioHandler defines the behavior of a component able to return the delegation positions of an address
+type DelegationPortfolioHandler interface {
+	GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and votes
+type GovernanceHandler interface {
+	GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotes(address string) ([]*common.GovernanceVote, error)
+	IsInterfaceNil() bool
+}
@@ -4,28 +4,33 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
 type ArgNodeApiResolver struct {
-	SCQueryService          SCQueryService
-	StatusMetricsHandler    StatusMetricsHandler
-	TxCostHandler           TransactionCostHandler
-	TotalStakedValueHandler TotalStakedValueHandler
-	DirectStakedListHandler DirectStakedListHandler
-	DelegatedListHandler    DelegatedListHandler
+	SCQueryService             SCQueryService
+	StatusMetricsHandler       StatusMetricsHandler
+	TxCostHandler              TransactionCostHandler
+	TotalStakedValueHandler    TotalStakedValueHandler
+	DirectStakedListHandler    DirectStakedListHandler
+	DelegatedListHandler       DelegatedListHandler
+	GovernanceHandler          GovernanceHandler
+	DelegationPortfolioHandler DelegationPortfolioHandler
 }
 
 // nodeApiResolver can resolve API requests
 type nodeApiResolver struct {
-	scQueryService          SCQueryService
-	statusMetricsHandler    StatusMetricsHandler
-	txCostHandler           TransactionCostHandler
-	totalStakedValueHandler TotalStakedValueHandler
-	directStakedListHandler DirectStakedListHandler
-	delegatedListHandler    DelegatedListHandler
+	scQueryService             SCQueryService
+	statusMetricsHandler       StatusMetricsHandler
+	txCostHandler              TransactionCostHandler
+	totalStakedValueHandler    TotalStakedValueHandler
+	directStakedListHandler    DirectStakedListHandler
+	delegatedListHandler       DelegatedListHandler
+	governanceHandler          GovernanceHandler
+	delegationPortfolioHandler DelegationPortfolioHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -48,14 +53,22 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationPortfolioHandler) {
+		return nil, ErrNilDelegationPortfolioHandler
+	}
 
 	return &nodeApiResolver{
-		scQueryService:          arg.SCQueryService,
-		statusMetricsHandler:    arg.StatusMetricsHandler,
-		txCostHandler:           arg.TxCostHandler,
-		totalStakedValueHandler: arg.TotalStakedValueHandler,
-		directStakedListHandler: arg.DirectStakedListHandler,
-		delegatedListHandler:    arg.DelegatedListHandler,
+		scQueryService:             arg.SCQueryService,
+		statusMetricsHandler:       arg.StatusMetricsHandler,
+		txCostHandler:              arg.TxCostHandler,
+		totalStakedValueHandler:    arg.TotalStakedValueHandler,
+		directStakedListHandler:    arg.DirectStakedListHandler,
+		delegatedListHandler:       arg.DelegatedListHandler,
+		governanceHandler:          arg.GovernanceHandler,
+		delegationPortfolioHandler: arg.DelegationPortfolioHandler,
 	}, nil
 }
 
@@ -64,6 +77,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries executes a batch of queries against the same state, through a VM
+func (nar *nodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -94,6 +112,26 @@ func (nar *nodeApiResolver) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nar.delegatedListHandler.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will return the governance proposals, optionally filtered by status
+func (nar *nodeApiResolver) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	return nar.governanceHandler.GetGovernanceProposals(status)
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided reference
+func (nar *nodeApiResolver) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	return nar.governanceHandler.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotes will return the governance votes cast by the provided address
+func (nar *nodeApiResolver) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	return nar.governanceHandler.GetGovernanceVotes(address)
+}
+
+// GetDelegationPortfolio will return the positions of the provided address in all the delegation contracts
+func (nar *nodeApiResolver) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	return nar.delegationPortfolioHandler.GetDelegationPortfolio(address)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -14,12 +15,14 @@ import (
 
 func createMockAgrs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
-		SCQueryService:          &mock.SCQueryServiceStub{},
-		StatusMetricsHandler:    &mock.StatusMetricsStub{},
-		TxCostHandler:           &mock.TransactionCostEstimatorMock{},
-		TotalStakedValueHandler: &mock.StakeValuesProcessorStub{},
-		DirectStakedListHandler: &mock.DirectStakedListProcessorStub{},
-		DelegatedListHandler:    &mock.DelegatedListProcessorStub{},
+		SCQueryService:             &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:       &mock.StatusMetricsStub{},
+		TxCostHandler:              &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler:    &mock.StakeValuesProcessorStub{},
+		DirectStakedListHandler:    &mock.DirectStakedListProcessorStub{},
+		DelegatedListHandler:       &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:          &mock.GovernanceHandlerStub{},
+		DelegationPortfolioHandler: &mock.DelegationPortfolioHandlerStub{},
 	}
 }
 
@@ -89,6 +92,28 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationPortfolioHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.DelegationPortfolioHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationPortfolioHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -249,3 +274,61 @@ func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	assert.Equal(t, recoveredDirectStakedValueList, directStakedValueList)
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GovernanceMethodsShouldForward(t *testing.T) {
+	t.Parallel()
+
+	proposals := []*common.GovernanceProposal{{Reference: "ref"}}
+	votes := []*common.GovernanceVote{{Proposal: "ref"}}
+	providedStatus, providedReference, providedAddress := "", "", ""
+	arg := createMockAgrs()
+	arg.GovernanceHandler = &mock.GovernanceHandlerStub{
+		GetGovernanceProposalsCalled: func(status string) ([]*common.GovernanceProposal, error) {
+			providedStatus = status
+			return proposals, nil
+		},
+		GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposal, error) {
+			providedReference = reference
+			return proposals[0], nil
+		},
+		GetGovernanceVotesCalled: func(address string) ([]*common.GovernanceVote, error) {
+			providedAddress = address
+			return votes, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	recoveredProposals, err := nar.GetGovernanceProposals("active")
+	assert.Nil(t, err)
+	assert.Equal(t, proposals, recoveredProposals)
+	assert.Equal(t, "active", providedStatus)
+
+	recoveredProposal, err := nar.GetGovernanceProposal("ref")
+	assert.Nil(t, err)
+	assert.Equal(t, proposals[0], recoveredProposal)
+	assert.Equal(t, "ref", providedReference)
+
+	recoveredVotes, err := nar.GetGovernanceVotes("addr")
+	assert.Nil(t, err)
+	assert.Equal(t, votes, recoveredVotes)
+	assert.Equal(t, "addr", providedAddress)
+}
+
+func TestNodeApiResolver_GetDelegationPortfolio(t *testing.T) {
+	t.Parallel()
+
+	portfolio := &common.DelegationPortfolio{Address: "addr"}
+	arg := createMockAgrs()
+	arg.DelegationPortfolioHandler = &mock.DelegationPortfolioHandlerStub{
+		GetDelegationPortfolioCalled: func(address string) (*common.DelegationPortfolio, error) {
+			assert.Equal(t, "addr", address)
+			return portfolio, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredPortfolio, err := nar.GetDelegationPortfolio("addr")
+	assert.Nil(t, err)
+	assert.Equal(t, portfolio, recoveredPortfolio)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -68,6 +70,19 @@ type Throttler interface {
 	IsInterfaceNil() bool
 }
 
+// HealthServiceHandler defines the health service used by the node, able to run the registered probes and to provide
+// the captured profiles
+type HealthServiceHandler interface {
+	RegisterProbe(name string, probe health.Probe) error
+	SetStatusMetricsProvider(provider health.StatusMetricsProvider) error
+	GetLivenessReport() common.HealthReport
+	GetReadinessReport() common.HealthReport
+	GetProfiles() ([]common.HealthProfileInfo, error)
+	GetProfile(name string) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // HeartbeatHandler defines the behavior of a heartbeat handler
 type HeartbeatHandler interface {
 	Monitor() *process.Monitor
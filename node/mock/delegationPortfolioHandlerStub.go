@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// DelegationPortfolioHandlerStub -
+type DelegationPortfolioHandlerStub struct {
+	GetDelegationPortfolioCalled func(address string) (*common.DelegationPortfolio, error)
+}
+
+// GetDelegationPortfolio -
+func (dphs *DelegationPortfolioHandlerStub) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	if dphs.GetDelegationPortfolioCalled != nil {
+		return dphs.GetDelegationPortfolioCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dphs *DelegationPortfolioHandlerStub) IsInterfaceNil() bool {
+	return dphs == nil
+}
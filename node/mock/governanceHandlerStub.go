@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// GovernanceHandlerStub -
+type GovernanceHandlerStub struct {
+	GetGovernanceProposalsCalled func(status string) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalCalled  func(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotesCalled     func(address string) ([]*common.GovernanceVote, error)
+}
+
+// GetGovernanceProposals -
+func (ghs *GovernanceHandlerStub) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	if ghs.GetGovernanceProposalsCalled != nil {
+		return ghs.GetGovernanceProposalsCalled(status)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ghs *GovernanceHandlerStub) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	if ghs.GetGovernanceProposalCalled != nil {
+		return ghs.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ghs *GovernanceHandlerStub) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	if ghs.GetGovernanceVotesCalled != nil {
+		return ghs.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ghs *GovernanceHandlerStub) IsInterfaceNil() bool {
+	return ghs == nil
+}
@@ -9,6 +9,7 @@ import (
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
 	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueriesCalled         func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
@@ -18,6 +19,15 @@ func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vm
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
+// ExecuteQueries -
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	if serviceStub.ExecuteQueriesCalled != nil {
+		return serviceStub.ExecuteQueriesCalled(queries)
+	}
+
+	return make([]*process.SCQueryResult, 0), nil, nil
+}
+
 // ComputeScCallGasLimit -
 func (serviceStub *SCQueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return serviceStub.ComputeScCallGasLimitHandler(tx)
@@ -175,8 +175,8 @@ func (n *Node) GetConsensusGroupSize() int {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		if err == ErrCannotCastAccountHandlerToUserAccountHandler {
 			return big.NewInt(0), nil
@@ -188,8 +188,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 }
 
 // GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -204,7 +204,7 @@ func (n *Node) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -267,8 +267,8 @@ func (n *Node) getEsdtDataFromLeaf(leaf core.KeyValueHolder, userAccount state.U
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -303,13 +303,13 @@ func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -324,7 +324,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 
 // GetESDTData returns the esdt balance and properties from a given account
 func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +354,7 @@ func (n *Node) getTokensIDsWithFilter(
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	userAccount, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -468,7 +468,7 @@ func bigToString(bigValue *big.Int) string {
 
 // GetAllESDTTokens returns all the ESDTs that the given address interacted with
 func (n *Node) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+	userAccount, err := n.getAccountHandlerAPIAccounts(address, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -544,7 +544,7 @@ func adjustNftTokenIdentifier(token string, nonce uint64) string {
 	return formattedTokenIdentifier
 }
 
-func (n *Node) getAccountHandlerAPIAccounts(address string) (state.UserAccountHandler, error) {
+func (n *Node) getAccountHandlerAPIAccounts(address string, options common.AccountQueryOptions) (state.UserAccountHandler, error) {
 	componentsNotInitialized := check.IfNil(n.coreComponents.AddressPubKeyConverter()) ||
 		check.IfNil(n.stateComponents.AccountsAdapterAPI())
 	if componentsNotInitialized {
@@ -556,11 +556,11 @@ func (n *Node) getAccountHandlerAPIAccounts(address string) (state.UserAccountHa
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
 
-	return n.getAccountHandlerForPubKey(addr)
+	return n.getAccountHandlerForPubKey(addr, options)
 }
 
-func (n *Node) getAccountHandlerForPubKey(address []byte) (state.UserAccountHandler, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+func (n *Node) getAccountHandlerForPubKey(address []byte, options common.AccountQueryOptions) (state.UserAccountHandler, error) {
+	account, err := n.getExistingAccountWithOptions(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -715,7 +715,12 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		return err
 	}
 
-	return txValidator.CheckTxValidity(intTx)
+	err = txValidator.CheckTxValidity(intTx)
+	if err != nil {
+		return err
+	}
+
+	return n.checkTxReplacement(tx)
 }
 
 // ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
@@ -943,7 +948,7 @@ func (n *Node) CreateTransaction(
 }
 
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
+func (n *Node) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
 	if check.IfNil(n.coreComponents.AddressPubKeyConverter()) {
 		return api.AccountResponse{}, ErrNilPubkeyConverter
 	}
@@ -956,7 +961,7 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 		return api.AccountResponse{}, err
 	}
 
-	accWrp, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(addr)
+	accWrp, err := n.getExistingAccountWithOptions(addr, options)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return api.AccountResponse{
@@ -965,7 +970,7 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 				DeveloperReward: "0",
 			}, nil
 		}
-		return api.AccountResponse{}, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return api.AccountResponse{}, fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
@@ -1284,6 +1289,84 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given addresses, in which the common trie nodes are included
+// only once. The proof also covers the absent addresses
+func (n *Node) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		key, errDecode := n.getKeyBytes(address)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		keys = append(keys, key)
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, values, err := tr.GetMultiProof(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    proof,
+		Values:   values,
+		RootHash: rootHash,
+	}, nil
+}
+
+// GetRangeProof returns the accounts between the start and the end addresses, in the order of the accounts trie,
+// along with the Merkle proof that no other account exists in between. Empty addresses leave the range open on that
+// side. At most maxLeaves accounts are returned, the range being shortened accordingly
+func (n *Node) GetRangeProof(rootHash string, startAddress string, endAddress string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	startKey, err := n.getOptionalKeyBytes(startAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	endKey, err := n.getOptionalKeyBytes(endAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeProof, err := tr.GetRangeProof(startKey, endKey, maxLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetRangeProofResponse{
+		TrieRangeProof: *rangeProof,
+		RootHash:       rootHash,
+	}, nil
+}
+
+func (n *Node) getOptionalKeyBytes(key string) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	return n.getKeyBytes(key)
+}
+
 func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]byte, []byte, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
 	if err != nil {
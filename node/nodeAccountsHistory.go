@@ -0,0 +1,68 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// getExistingAccountWithOptions loads the account from the current state or, for historical queries, from the state
+// at the root hash of the requested block
+func (n *Node) getExistingAccountWithOptions(address []byte, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
+	if !options.IsHistorical() {
+		return n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+	}
+
+	accountsAdapterWithHistory := n.stateComponents.AccountsAdapterAPIWithHistory()
+	if check.IfNil(accountsAdapterWithHistory) {
+		return nil, ErrNilAccountsAdapterWithHistory
+	}
+
+	rootHash, err := n.getRootHashForAccountQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsAdapterWithHistory.GetAccountWithRootHash(address, rootHash)
+}
+
+func (n *Node) getRootHashForAccountQuery(options common.AccountQueryOptions) ([]byte, error) {
+	if len(options.BlockRootHash) > 0 {
+		return options.BlockRootHash, nil
+	}
+
+	header, err := n.getHeaderForAccountQuery(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBlockRootHashNotFound, err.Error())
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func (n *Node) getHeaderForAccountQuery(options common.AccountQueryOptions) (data.HeaderHandler, error) {
+	selfShardID := n.processComponents.ShardCoordinator().SelfId()
+	storageService := n.dataComponents.StorageService()
+	marshalizer := n.coreComponents.InternalMarshalizer()
+
+	if options.BlockNonce.HasValue {
+		header, _, err := process.GetHeaderFromStorageWithNonce(
+			options.BlockNonce.Value,
+			selfShardID,
+			storageService,
+			n.coreComponents.Uint64ByteSliceConverter(),
+			marshalizer,
+		)
+		return header, err
+	}
+
+	if selfShardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(options.BlockHash, marshalizer, storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(options.BlockHash, marshalizer, storageService)
+}
@@ -0,0 +1,157 @@
+package node_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForAccountsHistory(
+	t *testing.T,
+	accountsWithHistory state.AccountsAdapterWithHistory,
+	store dataRetriever.StorageService,
+) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			assert.Fail(t, "the current state should have not been queried")
+			return nil, nil
+		},
+	}
+	stateComponents.AccountsAPIWithHistory = accountsWithHistory
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = store
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+
+	return n
+}
+
+func createHistoricalAccount(balance int64) state.UserAccountHandler {
+	account, _ := state.NewUserAccount([]byte("1234"))
+	_ = account.AddToBalance(big.NewInt(balance))
+
+	return account
+}
+
+func TestNode_GetAccountWithRootHashOption(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("old root hash")
+	accountsWithHistory := &stateMock.AccountsAdapterWithHistoryStub{
+		GetAccountWithRootHashCalled: func(_ []byte, providedRootHash []byte) (vmcommon.AccountHandler, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return createHistoricalAccount(37), nil
+		},
+	}
+	n := createNodeForAccountsHistory(t, accountsWithHistory, &mock.ChainStorerMock{})
+
+	account, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{BlockRootHash: rootHash})
+	require.Nil(t, err)
+	assert.Equal(t, "37", account.Balance)
+}
+
+func TestNode_GetBalanceWithBlockNonceOption(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(7)
+	headerHash := []byte("header hash")
+	rootHash := []byte("root hash at nonce 7")
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					switch unitType {
+					case dataRetriever.ShardHdrNonceHashDataUnit:
+						return headerHash, nil
+					case dataRetriever.BlockHeaderUnit:
+						assert.Equal(t, headerHash, key)
+						return json.Marshal(&block.Header{Nonce: blockNonce, RootHash: rootHash})
+					default:
+						return nil, errors.New("unexpected unit")
+					}
+				},
+			}
+		},
+	}
+	accountsWithHistory := &stateMock.AccountsAdapterWithHistoryStub{
+		GetAccountWithRootHashCalled: func(_ []byte, providedRootHash []byte) (vmcommon.AccountHandler, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return createHistoricalAccount(10), nil
+		},
+	}
+	n := createNodeForAccountsHistory(t, accountsWithHistory, store)
+
+	options := common.AccountQueryOptions{BlockNonce: common.OptionalUint64{Value: blockNonce, HasValue: true}}
+	balance, err := n.GetBalance(createDummyHexAddress(64), options)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), balance)
+}
+
+func TestNode_GetAccountWithBlockHashOptionMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: func(_ dataRetriever.UnitType) storage.Storer {
+			return &storageStubs.StorerStub{
+				GetCalled: func(_ []byte) ([]byte, error) {
+					return nil, errors.New("key not found")
+				},
+			}
+		},
+	}
+	accountsWithHistory := &stateMock.AccountsAdapterWithHistoryStub{
+		GetAccountWithRootHashCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	n := createNodeForAccountsHistory(t, accountsWithHistory, store)
+
+	_, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{BlockHash: []byte("hash")})
+	assert.True(t, errors.Is(err, node.ErrBlockRootHashNotFound))
+}
+
+func TestNode_GetValueForKeyWithPrunedRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountsWithHistory := &stateMock.AccountsAdapterWithHistoryStub{
+		GetAccountWithRootHashCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+			return nil, state.ErrRootHashNotAvailable
+		},
+	}
+	n := createNodeForAccountsHistory(t, accountsWithHistory, &mock.ChainStorerMock{})
+
+	_, err := n.GetValueForKey(createDummyHexAddress(64), "aa", common.AccountQueryOptions{BlockRootHash: []byte("pruned")})
+	assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+}
+
+func TestNode_GetUsernameWithHistoricalOptionAndNilAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForAccountsHistory(t, nil, &mock.ChainStorerMock{})
+
+	_, err := n.GetUsername(createDummyHexAddress(64), common.AccountQueryOptions{BlockRootHash: []byte("root hash")})
+	assert.Equal(t, node.ErrNilAccountsAdapterWithHistory, err)
+}
@@ -0,0 +1,61 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+// GetLogs returns the smart contract events matching the provided filters, in the order they were generated
+func (n *Node) GetLogs(request common.LogsQueryRequest) (*common.LogsQueryResponse, error) {
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+
+	query := dblookupext.LogsQuery{
+		FromNonce:  request.FromNonce,
+		FromIndex:  request.FromIndex,
+		ToNonce:    request.ToNonce,
+		Addresses:  make([][]byte, 0, len(request.Addresses)),
+		Identifier: []byte(request.Identifier),
+		Topics:     request.Topics,
+		MaxResults: request.MaxResults,
+	}
+	for _, address := range request.Addresses {
+		addressBytes, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Addresses = append(query.Addresses, addressBytes)
+	}
+
+	result, err := n.processComponents.HistoryRepository().GetLogs(query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.LogsQueryResponse{
+		Events:    make([]*common.LogEventApiResponse, 0, len(result.Events)),
+		Truncated: result.Truncated,
+		NextNonce: result.NextNonce,
+		NextIndex: result.NextIndex,
+	}
+	for _, event := range result.Events {
+		response.Events = append(response.Events, &common.LogEventApiResponse{
+			TxHash:     hex.EncodeToString(event.TxHash),
+			LogAddress: pubKeyConverter.Encode(event.LogAddress),
+			EventIndex: event.EventIndex,
+			Address:    pubKeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+			BlockHash:  hex.EncodeToString(event.BlockHash),
+			BlockNonce: event.BlockNonce,
+			Round:      event.Round,
+			Timestamp:  event.Timestamp,
+			Epoch:      event.Epoch,
+		})
+	}
+
+	return response, nil
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/health/probes"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -175,6 +176,7 @@ func printEnableEpochs(configs *config.Configs) {
 	log.Debug(readEpochFor("correct jailed not unstaked if empty queue"), "epoch", enableEpochs.CorrectJailedNotUnstakedEmptyQueueEpoch)
 	log.Debug(readEpochFor("do not return old block in blockchain hook"), "epoch", enableEpochs.DoNotReturnOldBlockInBlockchainHookEnableEpoch)
 	log.Debug(readEpochFor("scr size invariant check on built in"), "epoch", enableEpochs.SCRSizeInvariantOnBuiltInResultEnableEpoch)
+	log.Debug(readEpochFor("governance view functions"), "epoch", enableEpochs.GovernanceViewFunctionsEnableEpoch)
 
 	gasSchedule := configs.EpochConfig.GasSchedule
 
@@ -299,7 +301,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	}
 
 	log.Debug("creating healthService")
-	healthService := nr.createHealthService(flagsConfig, managedDataComponents)
+	healthService := nr.createHealthService(flagsConfig, managedCoreComponents, managedDataComponents)
 
 	nodesShufflerOut, err := mainFactory.CreateNodesShuffleOut(
 		managedCoreComponents.GenesisNodesSetup(),
@@ -398,6 +400,19 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	log.Debug("registering health probes")
+	err = nr.registerHealthProbes(
+		healthService,
+		managedCoreComponents,
+		managedNetworkComponents,
+		managedDataComponents,
+		managedStatusComponents,
+		managedConsensusComponents,
+	)
+	if err != nil {
+		return true, err
+	}
+
 	managedHeartbeatComponents, err := nr.CreateManagedHeartbeatComponents(
 		managedCoreComponents,
 		managedNetworkComponents,
@@ -446,7 +461,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	allowExternalVMQueriesChan := make(chan struct{})
 
 	log.Debug("updating the API service after creating the node facade")
-	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
+	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan, healthService)
 	if err != nil {
 		return true, err
 	}
@@ -483,6 +498,7 @@ func (nr *nodeRunner) createApiFacade(
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
 	gasScheduleNotifier core.GasScheduleNotifier,
 	allowVMQueriesChan chan struct{},
+	healthService facade.HealthServiceHandler,
 ) (closing.Closer, error) {
 	configs := nr.configs
 
@@ -524,6 +540,7 @@ func (nr *nodeRunner) createApiFacade(
 		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
 		PeerState:       currentNode.stateComponents.PeerAccounts(),
 		Blockchain:      currentNode.dataComponents.Blockchain(),
+		HealthService:   healthService,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -600,8 +617,14 @@ func (nr *nodeRunner) createMetrics(
 	return nil
 }
 
-func (nr *nodeRunner) createHealthService(flagsConfig *config.ContextFlagsConfig, dataComponents mainFactory.DataComponentsHolder) closing.Closer {
+func (nr *nodeRunner) createHealthService(
+	flagsConfig *config.ContextFlagsConfig,
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+) HealthServiceHandler {
 	healthService := health.NewHealthService(nr.configs.GeneralConfig.Health, flagsConfig.WorkingDir)
+	err := healthService.SetStatusMetricsProvider(coreComponents.StatusHandlerUtils().Metrics())
+	log.LogIfError(err)
 	if flagsConfig.UseHealthService {
 		healthService.Start()
 	}
@@ -612,6 +635,63 @@ func (nr *nodeRunner) createHealthService(flagsConfig *config.ContextFlagsConfig
 	return healthService
 }
 
+func (nr *nodeRunner) registerHealthProbes(
+	healthService HealthServiceHandler,
+	coreComponents mainFactory.CoreComponentsHolder,
+	networkComponents mainFactory.NetworkComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	statusComponents mainFactory.StatusComponentsHolder,
+	consensusComponents mainFactory.ConsensusComponentsHolder,
+) error {
+	healthConfig := nr.configs.GeneralConfig.Health
+
+	syncProbe, err := probes.NewSyncProbe(consensusComponents.Bootstrapper())
+	if err != nil {
+		return err
+	}
+	p2pProbe, err := probes.NewP2PProbe(networkComponents.NetworkMessenger())
+	if err != nil {
+		return err
+	}
+	storageProbe, err := probes.NewStorageProbe(dataComponents.StorageService().GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return err
+	}
+	consensusProbe, err := probes.NewConsensusProbe(probes.ArgsConsensusProbe{
+		RoundHandler:             coreComponents.RoundHandler(),
+		NumRoundsWithoutProgress: healthConfig.NumRoundsWithoutProgressToReportNotLive,
+	})
+	if err != nil {
+		return err
+	}
+	outportProbe, err := probes.NewOutportProbe(
+		statusComponents.OutportHandler(),
+		time.Duration(healthConfig.OutportRetryDurationToReportNotReadyInSec)*time.Second,
+	)
+	if err != nil {
+		return err
+	}
+
+	componentsProbes := []struct {
+		name  string
+		probe health.Probe
+	}{
+		{name: "sync", probe: syncProbe},
+		{name: "p2p", probe: p2pProbe},
+		{name: "storage", probe: storageProbe},
+		{name: "consensus", probe: consensusProbe},
+		{name: "outport", probe: outportProbe},
+	}
+	for _, componentProbe := range componentsProbes {
+		err = healthService.RegisterProbe(componentProbe.name, componentProbe.probe)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CreateManagedConsensusComponents is the managed consensus components factory
 func (nr *nodeRunner) CreateManagedConsensusComponents(
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -0,0 +1,44 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+)
+
+// Subscribe registers a new subscription for the blocks, transactions and address events matching the request
+func (n *Node) Subscribe(request common.SubscriptionRequest) (*subscriptions.Subscription, error) {
+	subscriptionsHandler := n.statusComponents.SubscriptionsHandler()
+	if check.IfNil(subscriptionsHandler) {
+		return nil, subscriptions.ErrSubscriptionsDisabled
+	}
+
+	filter := subscriptions.Filter{
+		Blocks:          request.Blocks,
+		FinalizedBlocks: request.FinalizedBlocks,
+		TxHashes:        make([][]byte, 0, len(request.TxHashes)),
+		Addresses:       make([][]byte, 0, len(request.Addresses)),
+	}
+	for _, txHash := range request.TxHashes {
+		txHashBytes, err := hex.DecodeString(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.TxHashes = append(filter.TxHashes, txHashBytes)
+	}
+
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	for _, address := range request.Addresses {
+		addressBytes, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Addresses = append(filter.Addresses, addressBytes)
+	}
+
+	return subscriptionsHandler.Subscribe(filter)
+}
@@ -10,6 +10,7 @@ import (
 	rewardTxData "github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
@@ -359,3 +360,42 @@ func (n *Node) prepareUnsignedTx(tx *smartContractResult.SmartContractResult) (*
 
 	return txResult, nil
 }
+
+// GetTransactionsByAddress returns a page of the transactions sent or received by the provided address, newest first
+func (n *Node) GetTransactionsByAddress(address string, query dblookupext.AddressTransactionsQuery) (*common.AddressTransactionsResponse, error) {
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := n.processComponents.HistoryRepository().GetTransactionsByAddress(addressBytes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AddressTransactionsResponse{
+		Transactions: make([]*transaction.ApiTransactionResult, 0, len(page.Transactions)),
+		HasMore:      page.HasMore,
+		Cursor:       page.Cursor,
+	}
+	for _, entry := range page.Transactions {
+		tx, errLookup := n.lookupHistoricalTransaction(entry.TxHash, false)
+		if errLookup != nil {
+			// the transaction might have been pruned from the storage, only the indexed fields are returned
+			log.Debug("GetTransactionsByAddress: cannot fetch transaction",
+				"hash", entry.TxHash, "error", errLookup.Error())
+			tx = &transaction.ApiTransactionResult{
+				MiniBlockType: block.Type(entry.MiniblockType).String(),
+				BlockNonce:    entry.BlockNonce,
+				Round:         entry.Round,
+				Epoch:         entry.Epoch,
+				Timestamp:     int64(entry.Timestamp),
+			}
+		}
+		tx.Hash = hex.EncodeToString(entry.TxHash)
+
+		response.Transactions = append(response.Transactions, tx)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,174 @@
+package node
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// txPoolInspector defines the methods of the self shard transactions cache used by the pool API
+type txPoolInspector interface {
+	InspectSenders(offset int, limit int) ([]*txcache.SenderInspection, int)
+	InspectSender(sender []byte) (*txcache.SenderInspection, bool)
+	CountSenders() uint64
+	CountTx() uint64
+	NumReplacedTxs() uint64
+	NumRejectedReplacements() uint64
+}
+
+// txReplacementChecker defines the self shard transactions cache applying a replacement policy for the transactions
+// with the same sender and nonce
+type txReplacementChecker interface {
+	CheckReplacement(tx data.TransactionHandler, txHash []byte) error
+}
+
+// GetTransactionsPool returns the pending transactions of a page of the self shard senders, or of the provided sender
+// only, filling the requested fields of every transaction
+func (n *Node) GetTransactionsPool(request common.TransactionsPoolRequest) (*common.TransactionsPoolResponse, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	var inspections []*txcache.SenderInspection
+	numSenders := 0
+	if len(request.Sender) == 0 {
+		inspections, numSenders = inspector.InspectSenders(int(request.Offset), int(request.Limit))
+	} else {
+		senderBytes, errDecode := n.coreComponents.AddressPubKeyConverter().Decode(request.Sender)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		inspection, found := inspector.InspectSender(senderBytes)
+		if found {
+			inspections = append(inspections, inspection)
+			numSenders = 1
+		}
+	}
+
+	response := &common.TransactionsPoolResponse{
+		Senders:    make([]*common.TransactionsPoolSender, 0, len(inspections)),
+		NumSenders: numSenders,
+	}
+	for _, inspection := range inspections {
+		response.Senders = append(response.Senders, n.createTransactionsPoolSender(inspection, request.Fields))
+	}
+
+	return response, nil
+}
+
+// GetTransactionsPoolCounts returns the number of transactions held by the pools
+func (n *Node) GetTransactionsPoolCounts() (*common.TransactionsPoolCountsResponse, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	datapool := n.dataComponents.Datapool()
+	txCounts := datapool.Transactions().GetCounts()
+
+	return &common.TransactionsPoolCountsResponse{
+		NumTransactions:         txCounts.GetTotal(),
+		NumBytes:                txCounts.GetTotalSize(),
+		NumSmartContractResults: datapool.UnsignedTransactions().GetCounts().GetTotal(),
+		NumRewardTransactions:   datapool.RewardTransactions().GetCounts().GetTotal(),
+		NumSelfShardSenders:     inspector.CountSenders(),
+		NumSelfShardTxs:         inspector.CountTx(),
+		NumReplacedTxs:          inspector.NumReplacedTxs(),
+		NumRejectedReplacements: inspector.NumRejectedReplacements(),
+	}, nil
+}
+
+// getTxPoolInspector returns the cache holding the transactions sent from the self shard, the only one which keeps
+// the transactions grouped by sender
+func (n *Node) getTxPoolInspector() (txPoolInspector, error) {
+	cacheID := strconv.Itoa(int(n.processComponents.ShardCoordinator().SelfId()))
+	cache := n.dataComponents.Datapool().Transactions().ShardDataStore(cacheID)
+
+	inspector, ok := cache.(txPoolInspector)
+	if !ok {
+		return nil, ErrTxPoolInspectionNotSupported
+	}
+
+	return inspector, nil
+}
+
+// checkTxReplacement rejects the transactions the pool would not accept as replacements of pending ones, before
+// being broadcast
+func (n *Node) checkTxReplacement(tx *transaction.Transaction) error {
+	cacheID := strconv.Itoa(int(n.processComponents.ShardCoordinator().SelfId()))
+	cache := n.dataComponents.Datapool().Transactions().ShardDataStore(cacheID)
+
+	checker, ok := cache.(txReplacementChecker)
+	if !ok {
+		return nil
+	}
+
+	txHash, err := core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
+	if err != nil {
+		return err
+	}
+
+	return checker.CheckReplacement(tx, txHash)
+}
+
+func (n *Node) createTransactionsPoolSender(inspection *txcache.SenderInspection, fields []string) *common.TransactionsPoolSender {
+	poolSender := &common.TransactionsPoolSender{
+		Sender:              n.coreComponents.AddressPubKeyConverter().Encode(inspection.Sender),
+		Score:               inspection.Score,
+		AccountNonce:        inspection.AccountNonce,
+		AccountNonceKnown:   inspection.AccountNonceKnown,
+		HasInitialGap:       inspection.HasInitialGap,
+		NumFailedSelections: inspection.NumFailedSelections,
+		NumTransactions:     len(inspection.Transactions),
+		NonceGaps:           make([]*common.NonceGap, 0, len(inspection.NonceGaps)),
+		Transactions:        make([]map[string]interface{}, 0, len(inspection.Transactions)),
+	}
+	for _, gap := range inspection.NonceGaps {
+		poolSender.NonceGaps = append(poolSender.NonceGaps, &common.NonceGap{
+			FromNonce: gap.FromNonce,
+			ToNonce:   gap.ToNonce,
+		})
+	}
+	for _, wrappedTx := range inspection.Transactions {
+		poolSender.Transactions = append(poolSender.Transactions, n.selectTxPoolFields(wrappedTx, fields))
+	}
+
+	return poolSender
+}
+
+func (n *Node) selectTxPoolFields(wrappedTx *txcache.WrappedTransaction, fields []string) map[string]interface{} {
+	tx := wrappedTx.Tx
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		switch field {
+		case common.TxPoolFieldHash:
+			selected[field] = hex.EncodeToString(wrappedTx.TxHash)
+		case common.TxPoolFieldNonce:
+			selected[field] = tx.GetNonce()
+		case common.TxPoolFieldReceiver:
+			selected[field] = n.coreComponents.AddressPubKeyConverter().Encode(tx.GetRcvAddr())
+		case common.TxPoolFieldValue:
+			selected[field] = "0"
+			if tx.GetValue() != nil {
+				selected[field] = tx.GetValue().String()
+			}
+		case common.TxPoolFieldGasPrice:
+			selected[field] = tx.GetGasPrice()
+		case common.TxPoolFieldGasLimit:
+			selected[field] = tx.GetGasLimit()
+		case common.TxPoolFieldData:
+			selected[field] = tx.GetData()
+		case common.TxPoolFieldSize:
+			selected[field] = wrappedTx.Size
+		}
+	}
+
+	return selected
+}
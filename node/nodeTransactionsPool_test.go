@@ -0,0 +1,109 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 42, false)
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:                  1000,
+			SizePerSender:             100,
+			SizeInBytes:               1000000,
+			SizeInBytesPerSender:      100000,
+			Shards:                    1,
+			MinGasPriceBumpPercentage: 10,
+		},
+		TxGasHandler:   &txcachemocks.TxGasHandlerMock{MinimumGasMove: 50000, MinimumGasPrice: 1000000000, GasProcessingDivisor: 100},
+		NumberOfShards: 3,
+		SelfShardID:    1,
+	})
+	require.Nil(t, err)
+	dataPool.SetTransactions(txPool)
+
+	alice, bob := []byte("alice"), []byte("bob")
+	addTxWithGasPrice := func(hash string, sender []byte, nonce uint64, gasPrice uint64) {
+		tx := &transaction.Transaction{Nonce: nonce, SndAddr: sender, RcvAddr: bob, Value: big.NewInt(10), GasLimit: 50000, GasPrice: gasPrice}
+		txPool.AddData([]byte(hash), tx, 100, "1")
+	}
+	addTx := func(hash string, sender []byte, nonce uint64) {
+		addTxWithGasPrice(hash, sender, nonce, 1000000000)
+	}
+	addTx("a1", alice, 1)
+	addTx("a2", alice, 2)
+	addTx("a5", alice, 5)
+	addTx("b7", bob, 7)
+	addTxWithGasPrice("b7-underpriced", bob, 7, 1050000000)
+	addTxWithGasPrice("b7-replacement", bob, 7, 1100000000)
+
+	response, err := n.GetTransactionsPool(common.TransactionsPoolRequest{
+		Sender: hex.EncodeToString(alice),
+		Fields: []string{common.TxPoolFieldHash, common.TxPoolFieldValue},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(response.Senders))
+
+	sender := response.Senders[0]
+	assert.Equal(t, hex.EncodeToString(alice), sender.Sender)
+	assert.Equal(t, 3, sender.NumTransactions)
+	assert.Equal(t, []*common.NonceGap{{FromNonce: 3, ToNonce: 4}}, sender.NonceGaps)
+	require.Equal(t, 3, len(sender.Transactions))
+	assert.Equal(t, map[string]interface{}{"hash": hex.EncodeToString([]byte("a1")), "value": "10"}, sender.Transactions[0])
+
+	response, err = n.GetTransactionsPool(common.TransactionsPoolRequest{Fields: []string{common.TxPoolFieldNonce}, Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(response.Senders))
+	assert.Equal(t, 2, response.NumSenders)
+
+	response, err = n.GetTransactionsPool(common.TransactionsPoolRequest{Fields: []string{common.TxPoolFieldNonce}, Offset: 1, Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(response.Senders))
+	assert.Equal(t, 2, response.NumSenders)
+
+	response, err = n.GetTransactionsPool(common.TransactionsPoolRequest{Sender: hex.EncodeToString([]byte("carol"))})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(response.Senders))
+	assert.Equal(t, 0, response.NumSenders)
+
+	_, err = n.GetTransactionsPool(common.TransactionsPoolRequest{Sender: "not hex"})
+	assert.NotNil(t, err)
+
+	counts, err := n.GetTransactionsPoolCounts()
+	require.Nil(t, err)
+	assert.Equal(t, int64(4), counts.NumTransactions)
+	assert.Equal(t, uint64(2), counts.NumSelfShardSenders)
+	assert.Equal(t, uint64(4), counts.NumSelfShardTxs)
+	assert.Equal(t, uint64(1), counts.NumReplacedTxs)
+	assert.Equal(t, uint64(1), counts.NumRejectedReplacements)
+}
+
+func TestNode_GetTransactionsPoolNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 42, false)
+	dataPool.SetTransactions(&testscommon.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+			return testscommon.NewCacherStub()
+		},
+	})
+
+	response, err := n.GetTransactionsPool(common.TransactionsPoolRequest{Limit: 10})
+	assert.Nil(t, response)
+	assert.Equal(t, node.ErrTxPoolInspectionNotSupported, err)
+}
@@ -130,7 +130,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -145,7 +145,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -175,7 +175,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -214,7 +214,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -237,7 +237,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -271,7 +271,7 @@ func TestGetUsername(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	username, err := n.GetUsername(createDummyHexAddress(64))
+	username, err := n.GetUsername(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, string(expectedUsername), username)
 }
@@ -329,7 +329,7 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 		node.WithDataComponents(dataComponents),
 	)
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64))
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	resV1, ok := pairs[hex.EncodeToString(k1)]
 	assert.True(t, ok)
@@ -370,7 +370,7 @@ func TestNode_GetValueForKey(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1))
+	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1), common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(v1), value)
 }
@@ -1992,6 +1992,9 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	bootstrapComponents := getDefaultBootstrapComponents()
 	bootstrapComponents.ShCoordinator = processComponents.ShardCoordinator()
 	bootstrapComponents.HdrIntegrityVerifier = processComponents.HeaderIntegrVerif
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = dataRetrieverMock.NewPoolsHolderMock()
+
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
@@ -1999,6 +2002,7 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 		node.WithNetworkComponents(networkComponents),
 		node.WithCryptoComponents(cryptoComponents),
 		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithDataComponents(dataComponents),
 		node.WithAddressSignatureSize(10),
 	)
 
@@ -2519,7 +2523,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 	)
 
 	stateComponents.AccountsAPI = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -2543,7 +2547,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 	)
 
 	coreComponents.AddrPubKeyConv = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -2573,7 +2577,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -2597,7 +2601,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.Nonce)
@@ -2626,7 +2630,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -2660,7 +2664,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(2), recovAccnt.Nonce)
@@ -3280,7 +3284,7 @@ func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.True(t, strings.Contains(fmt.Sprintf("%v", err), expectedErr.Error()))
 }
@@ -3606,6 +3610,97 @@ func TestNode_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetMultiProofInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	stateComponents := getDefaultStateComponents()
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	response, err := n.GetMultiProof("deadbeef", []string{"0123", "key"})
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetMultiProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proof := [][]byte{[]byte("valid"), []byte("proof")}
+	values := [][]byte{[]byte("value"), nil}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+					require.Equal(t, 2, len(keys))
+					assert.Equal(t, "0123", hex.EncodeToString(keys[0]))
+					assert.Equal(t, "4567", hex.EncodeToString(keys[1]))
+					return proof, values, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	rootHash := "deadbeef"
+	response, err := n.GetMultiProof(rootHash, []string{"0123", "4567"})
+	assert.Nil(t, err)
+	assert.Equal(t, proof, response.Proof)
+	assert.Equal(t, values, response.Values)
+	assert.Equal(t, rootHash, response.RootHash)
+}
+
+func TestNode_GetRangeProofInvalidRootHash(t *testing.T) {
+	t.Parallel()
+
+	stateComponents := getDefaultStateComponents()
+	n, _ := node.NewNode(node.WithStateComponents(stateComponents))
+
+	response, err := n.GetRangeProof("invalidRootHash", "", "", 10)
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetRangeProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rangeProof := &common.TrieRangeProof{
+		Proof:   [][]byte{[]byte("proof")},
+		Keys:    [][]byte{[]byte("key")},
+		Values:  [][]byte{[]byte("value")},
+		EndKey:  []byte("key"),
+		HasMore: true,
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetRangeProofCalled: func(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+					assert.Equal(t, "0123", hex.EncodeToString(startKey))
+					assert.Nil(t, endKey)
+					assert.Equal(t, 10, maxLeaves)
+					return rangeProof, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	rootHash := "deadbeef"
+	response, err := n.GetRangeProof(rootHash, "0123", "", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, *rangeProof, response.TrieRangeProof)
+	assert.Equal(t, rootHash, response.RootHash)
+}
+
 func TestGetESDTSupplyError(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +50,52 @@ func (csp *commonStakingProcessor) getValidatorInfoFromSC(validatorAddress []byt
 	return info, nil
 }
 
+func (csp *commonStakingProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.DelegationManagerSCAddress,
+		FuncName:   "getAllContractAddresses",
+		CallerAddr: vm.DelegationManagerSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  make([][]byte, 0),
+	}
+
+	vmOutput, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func (csp *commonStakingProcessor) getActiveFund(delegationSC []byte, delegator []byte) (*big.Int, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  delegationSC,
+		FuncName:   "getUserActiveStake",
+		CallerAddr: delegationSC,
+		CallValue:  big.NewInt(0),
+		Arguments:  [][]byte{delegator},
+	}
+
+	vmOutput, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	if len(vmOutput.ReturnData) != 1 {
+		return nil, fmt.Errorf("%w, getActiveFund function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	value := big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
+
+	return value, nil
+}
+
 func (csp *commonStakingProcessor) getAccount(scAddress []byte) (state.UserAccountHandler, error) {
 	accountHandler, err := csp.accounts.GetExistingAccount(scAddress)
 	if err != nil {
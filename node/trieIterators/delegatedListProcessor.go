@@ -9,10 +9,6 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
-	"github.com/ElrondNetwork/elrond-go/epochStart"
-	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/ElrondNetwork/elrond-go/vm"
-	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 type delegatedListProcessor struct {
@@ -57,26 +53,6 @@ func (dlp *delegatedListProcessor) GetDelegatorsList() ([]*api.Delegator, error)
 	return dlp.mapToSlice(delegatorsInfo), nil
 }
 
-func (dlp *delegatedListProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
-	scQuery := &process.SCQuery{
-		ScAddress:  vm.DelegationManagerSCAddress,
-		FuncName:   "getAllContractAddresses",
-		CallerAddr: vm.DelegationManagerSCAddress,
-		CallValue:  big.NewInt(0),
-		Arguments:  make([][]byte, 0),
-	}
-
-	vmOutput, err := dlp.queryService.ExecuteQuery(scQuery)
-	if err != nil {
-		return nil, err
-	}
-	if vmOutput.ReturnCode != vmcommon.Ok {
-		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
-	}
-
-	return vmOutput.ReturnData, nil
-}
-
 func (dlp *delegatedListProcessor) getDelegatorsInfo(delegationSC []byte, delegatorsMap map[string]*api.Delegator) error {
 	delegatorsList, err := dlp.getDelegatorsList(delegationSC)
 	if err != nil {
@@ -142,32 +118,6 @@ func (dlp *delegatedListProcessor) getDelegatorsList(delegationSC []byte) ([][]b
 	return delegators, nil
 }
 
-func (dlp *delegatedListProcessor) getActiveFund(delegationSC []byte, delegator []byte) (*big.Int, error) {
-	scQuery := &process.SCQuery{
-		ScAddress:  delegationSC,
-		FuncName:   "getUserActiveStake",
-		CallerAddr: delegationSC,
-		CallValue:  big.NewInt(0),
-		Arguments:  [][]byte{delegator},
-	}
-
-	vmOutput, err := dlp.queryService.ExecuteQuery(scQuery)
-	if err != nil {
-		return nil, err
-	}
-	if vmOutput.ReturnCode != vmcommon.Ok {
-		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
-	}
-
-	if len(vmOutput.ReturnData) != 1 {
-		return nil, fmt.Errorf("%w, getActiveFund function should have returned one value", epochStart.ErrExecutingSystemScCode)
-	}
-
-	value := big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
-
-	return value, nil
-}
-
 func (dlp *delegatedListProcessor) mapToSlice(mapDelegators map[string]*api.Delegator) []*api.Delegator {
 	keys := make([]string, 0, len(mapDelegators))
 	for key := range mapDelegators {
@@ -0,0 +1,179 @@
+package trieIterators
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type delegationPortfolioProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+}
+
+// NewDelegationPortfolioProcessor will create a new instance of delegationPortfolioProcessor
+func NewDelegationPortfolioProcessor(arg ArgTrieIteratorProcessor) (*delegationPortfolioProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delegationPortfolioProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+	}, nil
+}
+
+// GetDelegationPortfolio will return the positions of the provided address in all the delegation contracts
+func (dpp *delegationPortfolioProcessor) GetDelegationPortfolio(address string) (*common.DelegationPortfolio, error) {
+	delegator, err := dpp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dpp.accounts.Lock()
+	defer dpp.accounts.Unlock()
+
+	delegationScAddresses, err := dpp.getAllDelegationContractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	totalActiveStake := big.NewInt(0)
+	totalUnStaked := big.NewInt(0)
+	totalClaimableRewards := big.NewInt(0)
+	contracts := make([]*common.DelegationContractPosition, 0)
+	for _, delegationSC := range delegationScAddresses {
+		isDelegator, errCheck := dpp.isDelegator(delegationSC, delegator)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+		if !isDelegator {
+			continue
+		}
+
+		activeStake, errGet := dpp.getActiveFund(delegationSC, delegator)
+		if errGet != nil {
+			return nil, errGet
+		}
+		unStakedFunds, unStaked, errGet := dpp.getUnStakedFunds(delegationSC, delegator)
+		if errGet != nil {
+			return nil, errGet
+		}
+		claimableRewards, errGet := dpp.getClaimableRewards(delegationSC, delegator)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		totalActiveStake.Add(totalActiveStake, activeStake)
+		totalUnStaked.Add(totalUnStaked, unStaked)
+		totalClaimableRewards.Add(totalClaimableRewards, claimableRewards)
+		contracts = append(contracts, &common.DelegationContractPosition{
+			DelegationScAddress: dpp.publicKeyConverter.Encode(delegationSC),
+			ActiveStake:         activeStake.String(),
+			UnStakedFunds:       unStakedFunds,
+			TotalUnStaked:       unStaked.String(),
+			ClaimableRewards:    claimableRewards.String(),
+		})
+	}
+
+	return &common.DelegationPortfolio{
+		Address:               address,
+		TotalActiveStake:      totalActiveStake.String(),
+		TotalUnStaked:         totalUnStaked.String(),
+		TotalClaimableRewards: totalClaimableRewards.String(),
+		Contracts:             contracts,
+	}, nil
+}
+
+// isDelegator checks the delegation contract storage directly, as the delegator data is saved under the delegator
+// address. This avoids executing a view function on every delegation contract
+func (dpp *delegationPortfolioProcessor) isDelegator(delegationSC []byte, delegator []byte) (bool, error) {
+	delegationAccount, err := dpp.getAccount(delegationSC)
+	if err != nil {
+		return false, fmt.Errorf("%w for delegationSC %s", err, dpp.publicKeyConverter.Encode(delegationSC))
+	}
+
+	delegatorData, err := delegationAccount.DataTrieTracker().RetrieveValue(delegator)
+	if err != nil {
+		return false, fmt.Errorf("%w for delegationSC %s", err, dpp.publicKeyConverter.Encode(delegationSC))
+	}
+
+	return len(delegatorData) > 0, nil
+}
+
+// getUnStakedFunds returns the unstaked funds along with their total value. getUserUnDelegatedList returns pairs
+// of (value, remaining unbond epochs)
+func (dpp *delegationPortfolioProcessor) getUnStakedFunds(delegationSC []byte, delegator []byte) ([]*common.DelegationUnStakedFund, *big.Int, error) {
+	returnData, err := dpp.executeDelegatorQuery(delegationSC, "getUserUnDelegatedList", delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(returnData)%2 != 0 {
+		return nil, nil, fmt.Errorf("%w, getUserUnDelegatedList function should have returned pairs of values", epochStart.ErrExecutingSystemScCode)
+	}
+
+	total := big.NewInt(0)
+	funds := make([]*common.DelegationUnStakedFund, 0, len(returnData)/2)
+	for i := 0; i < len(returnData); i += 2 {
+		value := big.NewInt(0).SetBytes(returnData[i])
+		remainingEpochs := big.NewInt(0).SetBytes(returnData[i+1])
+		if !remainingEpochs.IsUint64() || remainingEpochs.Uint64() > math.MaxUint32 {
+			return nil, nil, fmt.Errorf("%w, getUserUnDelegatedList function returned an invalid number of epochs", epochStart.ErrExecutingSystemScCode)
+		}
+
+		total.Add(total, value)
+		funds = append(funds, &common.DelegationUnStakedFund{
+			Value:                 value.String(),
+			RemainingUnBondEpochs: uint32(remainingEpochs.Uint64()),
+		})
+	}
+
+	return funds, total, nil
+}
+
+func (dpp *delegationPortfolioProcessor) getClaimableRewards(delegationSC []byte, delegator []byte) (*big.Int, error) {
+	returnData, err := dpp.executeDelegatorQuery(delegationSC, "getClaimableRewards", delegator)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w, getClaimableRewards function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]), nil
+}
+
+func (dpp *delegationPortfolioProcessor) executeDelegatorQuery(delegationSC []byte, function string, delegator []byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  delegationSC,
+		FuncName:   function,
+		CallerAddr: delegationSC,
+		CallValue:  big.NewInt(0),
+		Arguments:  [][]byte{delegator},
+	}
+
+	vmOutput, err := dpp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *delegationPortfolioProcessor) IsInterfaceNil() bool {
+	return dpp == nil
+}
@@ -0,0 +1,220 @@
+package trieIterators
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDelegationScAccountWithDelegators(address []byte, delegators [][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(address)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			for _, delegator := range delegators {
+				if bytes.Equal(delegator, key) {
+					value := append([]byte("delegator data"), key...)
+					return append(value, address...), nil
+				}
+			}
+
+			return nil, nil
+		},
+	})
+
+	return acc
+}
+
+func TestNewDelegationPortfolioProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgTrieIteratorProcessor
+		exError  error
+	}{
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgTrieIteratorProcessor {
+				arg := createMockArgs()
+				arg.Accounts = nil
+
+				return arg
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilQueryService",
+			argsFunc: func() ArgTrieIteratorProcessor {
+				arg := createMockArgs()
+				arg.QueryService = nil
+
+				return arg
+			},
+			exError: ErrNilQueryService,
+		},
+		{
+			name: "ShouldWork",
+			argsFunc: func() ArgTrieIteratorProcessor {
+				return createMockArgs()
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDelegationPortfolioProcessor(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+		})
+	}
+
+	dpp, _ := NewDelegationPortfolioProcessor(createMockArgs())
+	assert.False(t, check.IfNil(dpp))
+}
+
+func TestDelegationPortfolioProc_GetDelegationPortfolioInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgs()
+	arg.PublicKeyConverter = &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	dpp, _ := NewDelegationPortfolioProcessor(arg)
+
+	portfolio, err := dpp.GetDelegationPortfolio("invalid")
+	assert.Nil(t, portfolio)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDelegationPortfolioProc_GetDelegationPortfolioQueryFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator1")
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if query.FuncName == "getAllContractAddresses" {
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{[]byte("delegationSc1")},
+				}, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.UserError,
+			}, nil
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createDelegationScAccountWithDelegators(address, [][]byte{delegator}), nil
+		},
+	}
+	dpp, _ := NewDelegationPortfolioProcessor(arg)
+
+	portfolio, err := dpp.GetDelegationPortfolio(arg.PublicKeyConverter.Encode(delegator))
+	assert.Nil(t, portfolio)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestDelegationPortfolioProc_GetDelegationPortfolioShouldWork(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator1")
+	delegationSc := [][]byte{[]byte("delegationSc1"), []byte("delegationSc2"), []byte("delegationSc3")}
+	delegatorsPerContract := map[string][][]byte{
+		string(delegationSc[0]): {delegator},
+		string(delegationSc[1]): {[]byte("delegator2")},
+		string(delegationSc[2]): {[]byte("delegator2"), delegator},
+	}
+
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if query.FuncName == "getAllContractAddresses" {
+				return &vmcommon.VMOutput{
+					ReturnData: delegationSc,
+				}, nil
+			}
+
+			require.Equal(t, delegator, query.Arguments[0])
+			isFirstContract := bytes.Equal(query.ScAddress, delegationSc[0])
+			switch query.FuncName {
+			case "getUserActiveStake":
+				if isFirstContract {
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(100).Bytes()}}, nil
+				}
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(0).Bytes()}}, nil
+			case "getUserUnDelegatedList":
+				if isFirstContract {
+					return &vmcommon.VMOutput{}, nil
+				}
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{big.NewInt(20).Bytes(), big.NewInt(0).Bytes(), big.NewInt(30).Bytes(), big.NewInt(4).Bytes()},
+				}, nil
+			case "getClaimableRewards":
+				if isFirstContract {
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(7).Bytes()}}, nil
+				}
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(3).Bytes()}}, nil
+			}
+
+			return nil, fmt.Errorf("not an expected call")
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createDelegationScAccountWithDelegators(address, delegatorsPerContract[string(address)]), nil
+		},
+	}
+	dpp, _ := NewDelegationPortfolioProcessor(arg)
+
+	address := arg.PublicKeyConverter.Encode(delegator)
+	portfolio, err := dpp.GetDelegationPortfolio(address)
+	require.Nil(t, err)
+
+	expectedPortfolio := &common.DelegationPortfolio{
+		Address:               address,
+		TotalActiveStake:      "100",
+		TotalUnStaked:         "50",
+		TotalClaimableRewards: "10",
+		Contracts: []*common.DelegationContractPosition{
+			{
+				DelegationScAddress: arg.PublicKeyConverter.Encode(delegationSc[0]),
+				ActiveStake:         "100",
+				UnStakedFunds:       make([]*common.DelegationUnStakedFund, 0),
+				TotalUnStaked:       "0",
+				ClaimableRewards:    "7",
+			},
+			{
+				DelegationScAddress: arg.PublicKeyConverter.Encode(delegationSc[2]),
+				ActiveStake:         "0",
+				UnStakedFunds: []*common.DelegationUnStakedFund{
+					{Value: "20", RemainingUnBondEpochs: 0},
+					{Value: "30", RemainingUnBondEpochs: 4},
+				},
+				TotalUnStaked:    "50",
+				ClaimableRewards: "3",
+			},
+		},
+	}
+	assert.Equal(t, expectedPortfolio, portfolio)
+}
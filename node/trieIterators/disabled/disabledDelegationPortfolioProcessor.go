@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnDelegationPortfolioFromShardNode = errors.New("delegation portfolio can not be returned by a shard node")
+
+type delegationPortfolioProcessor struct{}
+
+// NewDisabledDelegationPortfolioProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledDelegationPortfolioProcessor() *delegationPortfolioProcessor {
+	return &delegationPortfolioProcessor{}
+}
+
+// GetDelegationPortfolio returns the errCannotReturnDelegationPortfolioFromShardNode error
+func (dpp *delegationPortfolioProcessor) GetDelegationPortfolio(_ string) (*common.DelegationPortfolio, error) {
+	return nil, errCannotReturnDelegationPortfolioFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *delegationPortfolioProcessor) IsInterfaceNil() bool {
+	return dpp == nil
+}
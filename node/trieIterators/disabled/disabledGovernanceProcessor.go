@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnGovernanceDataFromShardNode = errors.New("governance data can not be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetGovernanceProposals returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposals(_ string) ([]*common.GovernanceProposal, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceProposal returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposal(_ string) (*common.GovernanceProposal, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceVotes returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceVotes(_ string) ([]*common.GovernanceVote, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
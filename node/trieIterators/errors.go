@@ -22,3 +22,9 @@ var ErrNodeNotInitialized = errors.New("the node is not fully initialized")
 
 // ErrNilMutex signals that a nil mutex has been provided
 var ErrNilMutex = errors.New("nil mutex")
+
+// ErrInvalidGovernanceStatus signals that an invalid governance proposal status has been provided
+var ErrInvalidGovernanceStatus = errors.New("invalid governance proposal status")
+
+// ErrInvalidGovernanceReference signals that an invalid governance proposal reference has been provided
+var ErrInvalidGovernanceReference = errors.New("invalid governance proposal reference")
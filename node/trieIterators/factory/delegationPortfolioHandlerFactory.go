@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateDelegationPortfolioHandler will create a new instance of DelegationPortfolioHandler
+func CreateDelegationPortfolioHandler(args trieIterators.ArgTrieIteratorProcessor) (external.DelegationPortfolioHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledDelegationPortfolioProcessor(), nil
+	}
+
+	return trieIterators.NewDelegationPortfolioProcessor(args)
+}
@@ -0,0 +1,44 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationPortfolioHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	delegationPortfolioHandler, err := CreateDelegationPortfolioHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.delegationPortfolioProcessor", fmt.Sprintf("%T", delegationPortfolioHandler))
+}
+
+func TestCreateDelegationPortfolioHandler_DelegationPortfolioProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+	}
+
+	delegationPortfolioHandler, err := CreateDelegationPortfolioHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.delegationPortfolioProcessor", fmt.Sprintf("%T", delegationPortfolioHandler))
+}
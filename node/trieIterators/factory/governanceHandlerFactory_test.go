@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGovernanceHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	governanceHandler, err := CreateGovernanceHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
+
+func TestCreateGovernanceHandler_GovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &mock.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		BlockChain: &testscommon.ChainHandlerStub{},
+	}
+
+	governanceHandler, err := CreateGovernanceHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
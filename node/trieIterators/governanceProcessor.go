@@ -0,0 +1,400 @@
+package trieIterators
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// the governance contract does not keep an index of its proposals, so their references are collected from the keys
+// of its data trie, the proposals being stored under this prefix
+const governanceProposalPrefix = "proposal_"
+
+const (
+	numProposalInfoValues = 7
+	numTallyValues        = 3
+	numConfigValues       = 4
+	numVoteValues         = 5
+)
+
+const (
+	// GovernanceStatusPending is the status of a proposal whose voting period did not start yet
+	GovernanceStatusPending = "pending"
+	// GovernanceStatusActive is the status of a proposal that can be voted
+	GovernanceStatusActive = "active"
+	// GovernanceStatusEnded is the status of a proposal whose voting period ended, but which was not closed yet
+	GovernanceStatusEnded = "ended"
+	// GovernanceStatusClosed is the status of a closed proposal, its result being final
+	GovernanceStatusClosed = "closed"
+)
+
+// ArgGovernanceProcessor represents the arguments DTO used in the governance processor constructor
+type ArgGovernanceProcessor struct {
+	ArgTrieIteratorProcessor
+	BlockChain data.ChainHandler
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	blockChain         data.ChainHandler
+}
+
+type governanceConfig struct {
+	minQuorum        *big.Int
+	minPassThreshold *big.Int
+	minVetoThreshold *big.Int
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor, able to return the proposals and the votes
+// of the governance system smart contract by calling its view functions
+func NewGovernanceProcessor(arg ArgGovernanceProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		blockChain:         arg.BlockChain,
+	}, nil
+}
+
+// GetGovernanceProposals will return all the governance proposals, sorted by their start nonce. If status is not
+// empty, only the proposals having that status are returned
+func (gp *governanceProcessor) GetGovernanceProposals(status string) ([]*common.GovernanceProposal, error) {
+	err := checkGovernanceStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := gp.getProposalReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	config := gp.getGovernanceConfig()
+	currentNonce := gp.getCurrentNonce()
+	proposals := make([]*common.GovernanceProposal, 0, len(references))
+	for _, reference := range references {
+		proposal, errGet := gp.getProposal(reference, config, currentNonce)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if len(status) > 0 && proposal.Status != status {
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		if proposals[i].StartVoteNonce == proposals[j].StartVoteNonce {
+			return proposals[i].Reference < proposals[j].Reference
+		}
+		return proposals[i].StartVoteNonce < proposals[j].StartVoteNonce
+	})
+
+	return proposals, nil
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided reference. The reference is either the
+// commit hash of the proposal or, for white list proposals, the address to be white listed
+func (gp *governanceProcessor) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	referenceBytes, err := gp.decodeReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := gp.getProposal(referenceBytes, gp.getGovernanceConfig(), gp.getCurrentNonce())
+	if err != nil {
+		return nil, fmt.Errorf("%w for reference %s", err, reference)
+	}
+
+	return proposal, nil
+}
+
+// GetGovernanceVotes will return the votes cast by the provided address on the governance proposals. The governance
+// contract deletes the votes of a proposal when closing it, so for the closed proposals only the votes cast with
+// funds that were not yet claimed are returned
+func (gp *governanceProcessor) GetGovernanceVotes(address string) ([]*common.GovernanceVote, error) {
+	addressBytes, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+
+	references, err := gp.getProposalReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]*common.GovernanceVote, 0)
+	for _, reference := range references {
+		proposalVotes, errGet := gp.getUserVotes(reference, addressBytes)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		votes = append(votes, proposalVotes...)
+	}
+
+	return votes, nil
+}
+
+// getProposalReferences returns the sorted references of all the proposals, reading only the keys of the governance
+// contract data trie. The accounts are locked only while the keys are read, the view functions being called afterwards
+func (gp *governanceProcessor) getProposalReferences() ([][]byte, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(governanceAccount.DataTrie()) {
+		return make([][]byte, 0), nil
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := governanceAccount.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	references := make([][]byte, 0)
+	for leaf := range chLeaves {
+		leafKey := leaf.Key()
+		if !bytes.HasPrefix(leafKey, []byte(governanceProposalPrefix)) {
+			continue
+		}
+
+		reference := leafKey[len(governanceProposalPrefix):]
+		if gp.isProposalReference(reference) {
+			references = append(references, reference)
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		return bytes.Compare(references[i], references[j]) < 0
+	})
+
+	return references, nil
+}
+
+func (gp *governanceProcessor) getProposal(
+	reference []byte,
+	config *governanceConfig,
+	currentNonce uint64,
+) (*common.GovernanceProposal, error) {
+	info, err := gp.executeGovernanceQuery("getProposalInfo", numProposalInfoValues, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	tally, err := gp.executeGovernanceQuery("getProposalTally", numTallyValues, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	yes := big.NewInt(0).SetBytes(tally[0])
+	no := big.NewInt(0).SetBytes(tally[1])
+	veto := big.NewInt(0).SetBytes(tally[2])
+	total := big.NewInt(0).Add(yes, no)
+	total.Add(total, veto)
+
+	proposal := &common.GovernanceProposal{
+		Reference:      gp.encodeReference(reference),
+		Issuer:         gp.publicKeyConverter.Encode(info[0]),
+		StartVoteNonce: big.NewInt(0).SetBytes(info[2]).Uint64(),
+		EndVoteNonce:   big.NewInt(0).SetBytes(info[3]).Uint64(),
+		Closed:         string(info[4]) == "true",
+		Passed:         string(info[5]) == "true",
+		NumVoters:      int(big.NewInt(0).SetBytes(info[6]).Int64()),
+		Tally: &common.GovernanceTally{
+			Yes:   yes.String(),
+			No:    no.String(),
+			Veto:  veto.String(),
+			Total: total.String(),
+		},
+	}
+	proposal.Status = getGovernanceStatus(proposal, currentNonce)
+	if config == nil {
+		return proposal, nil
+	}
+
+	// same rules as the ones applied by the governance contract when closing a proposal
+	quorumReached := total.Cmp(config.minQuorum) >= 0
+	vetoed := veto.Cmp(config.minVetoThreshold) >= 0
+	proposal.Quorum = &common.GovernanceQuorumStatus{
+		MinQuorum:        config.minQuorum.String(),
+		MinPassThreshold: config.minPassThreshold.String(),
+		MinVetoThreshold: config.minVetoThreshold.String(),
+		QuorumReached:    quorumReached,
+		Vetoed:           vetoed,
+		Passing:          quorumReached && !vetoed && yes.Cmp(config.minPassThreshold) >= 0 && yes.Cmp(no) > 0,
+	}
+
+	return proposal, nil
+}
+
+// getGovernanceConfig returns nil if the governance contract was not yet configured
+func (gp *governanceProcessor) getGovernanceConfig() *governanceConfig {
+	returnData, err := gp.executeGovernanceQuery("getGovernanceConfig", numConfigValues)
+	if err != nil {
+		return nil
+	}
+
+	return &governanceConfig{
+		minQuorum:        big.NewInt(0).SetBytes(returnData[0]),
+		minPassThreshold: big.NewInt(0).SetBytes(returnData[1]),
+		minVetoThreshold: big.NewInt(0).SetBytes(returnData[2]),
+	}
+}
+
+func (gp *governanceProcessor) getUserVotes(reference []byte, voter []byte) ([]*common.GovernanceVote, error) {
+	returnData, err := gp.executeGovernanceQuery("getUserVotes", 0, reference, voter)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData)%numVoteValues != 0 {
+		return nil, fmt.Errorf("%w, getUserVotes function should have returned %d values for each vote",
+			epochStart.ErrExecutingSystemScCode, numVoteValues)
+	}
+
+	encodedReference := gp.encodeReference(reference)
+	votes := make([]*common.GovernanceVote, 0, len(returnData)/numVoteValues)
+	for i := 0; i < len(returnData); i += numVoteValues {
+		vote := &common.GovernanceVote{
+			Proposal:  encodedReference,
+			Value:     string(returnData[i]),
+			Power:     big.NewInt(0).SetBytes(returnData[i+1]).String(),
+			Balance:   big.NewInt(0).SetBytes(returnData[i+2]).String(),
+			WithFunds: string(returnData[i+4]) == "true",
+		}
+		if len(returnData[i+3]) > 0 {
+			vote.DelegatedTo = gp.publicKeyConverter.Encode(returnData[i+3])
+		}
+
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+// executeGovernanceQuery calls a view function of the governance contract. If numValues is not 0, the function is
+// expected to return exactly that many values
+func (gp *governanceProcessor) executeGovernanceQuery(funcName string, numValues int, arguments ...[]byte) ([][]byte, error) {
+	if arguments == nil {
+		arguments = make([][]byte, 0)
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   funcName,
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, err := gp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		if vmOutput.ReturnMessage == vm.ErrProposalNotFound.Error() {
+			return nil, vm.ErrProposalNotFound
+		}
+
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if numValues > 0 && len(vmOutput.ReturnData) != numValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, funcName, numValues)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// isProposalReference returns true for commit hashes and for addresses, the latter being used as white list
+// proposals references
+func (gp *governanceProcessor) isProposalReference(reference []byte) bool {
+	return systemSmartContracts.IsValidCommitHash(reference) || len(reference) == gp.publicKeyConverter.Len()
+}
+
+func (gp *governanceProcessor) encodeReference(reference []byte) string {
+	if len(reference) == gp.publicKeyConverter.Len() {
+		return gp.publicKeyConverter.Encode(reference)
+	}
+
+	return string(reference)
+}
+
+func (gp *governanceProcessor) decodeReference(reference string) ([]byte, error) {
+	if systemSmartContracts.IsValidCommitHash([]byte(reference)) {
+		return []byte(reference), nil
+	}
+
+	address, err := gp.publicKeyConverter.Decode(reference)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGovernanceReference, reference)
+	}
+
+	return address, nil
+}
+
+func (gp *governanceProcessor) getCurrentNonce() uint64 {
+	currentHeader := gp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+func getGovernanceStatus(proposal *common.GovernanceProposal, currentNonce uint64) string {
+	switch {
+	case proposal.Closed:
+		return GovernanceStatusClosed
+	case currentNonce < proposal.StartVoteNonce:
+		return GovernanceStatusPending
+	case currentNonce > proposal.EndVoteNonce:
+		return GovernanceStatusEnded
+	default:
+		return GovernanceStatusActive
+	}
+}
+
+func checkGovernanceStatus(status string) error {
+	switch status {
+	case "", GovernanceStatusPending, GovernanceStatusActive, GovernanceStatusEnded, GovernanceStatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidGovernanceStatus, status)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,346 @@
+package trieIterators
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	commitHash1  = []byte("1111111111111111111111111111111111111111")
+	commitHash2  = []byte("2222222222222222222222222222222222222222")
+	voterAddress = bytes.Repeat([]byte{7}, 32)
+)
+
+func createMockGovernanceArgs(currentNonce uint64, storage map[string][]byte) ArgGovernanceProcessor {
+	return ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &AccountsWrapper{
+				Mutex: &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{
+					GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+						return createGovernanceScAccount(storage), nil
+					},
+				},
+			},
+			PublicKeyConverter: mock.NewPubkeyConverterMock(32),
+			QueryService:       createGovernanceQueryService(),
+		},
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Nonce: currentNonce}
+			},
+		},
+	}
+}
+
+func createGovernanceScAccount(storage map[string][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(vm.GovernanceSCAddress)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder)
+
+			go func() {
+				for key, value := range storage {
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), value)
+				}
+
+				close(ch)
+			}()
+
+			return ch, nil
+		},
+	})
+
+	return acc
+}
+
+func createGovernanceStorage() map[string][]byte {
+	return map[string][]byte{
+		"governanceConfig": []byte("config"),
+		governanceProposalPrefix + string(commitHash1):                        []byte("proposal 1"),
+		governanceProposalPrefix + string(commitHash2):                        []byte("proposal 2"),
+		governanceProposalPrefix + string(commitHash1) + string(voterAddress): []byte("vote set"),
+		"foundsLock_" + string(commitHash2) + string(voterAddress):            []byte("funds vote set"),
+	}
+}
+
+// createGovernanceQueryService returns the values the view functions of the governance contract would return for
+// an active proposal having commitHash1 as reference and for a closed one having commitHash2 as reference
+func createGovernanceQueryService() *mock.SCQueryServiceStub {
+	returnData := map[string][][]byte{
+		"getGovernanceConfig": {big.NewInt(50).Bytes(), big.NewInt(40).Bytes(), big.NewInt(30).Bytes(), big.NewInt(100).Bytes()},
+		"getProposalInfo" + string(commitHash1): {
+			bytes.Repeat([]byte{1}, 32), commitHash1, big.NewInt(10).Bytes(), big.NewInt(20).Bytes(), []byte("false"), []byte("false"), big.NewInt(1).Bytes(),
+		},
+		"getProposalTally" + string(commitHash1): {big.NewInt(60).Bytes(), big.NewInt(10).Bytes(), big.NewInt(0).Bytes()},
+		"getProposalInfo" + string(commitHash2): {
+			bytes.Repeat([]byte{2}, 32), commitHash2, big.NewInt(1).Bytes(), big.NewInt(5).Bytes(), []byte("true"), []byte("false"), big.NewInt(0).Bytes(),
+		},
+		"getProposalTally" + string(commitHash2): {big.NewInt(1).Bytes(), big.NewInt(2).Bytes(), big.NewInt(3).Bytes()},
+		"getUserVotes" + string(commitHash1) + string(voterAddress): {
+			[]byte("yes"), big.NewInt(60).Bytes(), big.NewInt(0).Bytes(), nil, []byte("false"),
+		},
+		"getUserVotes" + string(commitHash2) + string(voterAddress): {
+			[]byte("veto"), big.NewInt(3).Bytes(), big.NewInt(9).Bytes(), nil, []byte("true"),
+		},
+	}
+
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			key := query.FuncName
+			for _, argument := range query.Arguments {
+				key += string(argument)
+			}
+
+			values, found := returnData[key]
+			switch {
+			case found:
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: values}, nil
+			case query.FuncName == "getUserVotes":
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			default:
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: vm.ErrProposalNotFound.Error()}, nil
+			}
+		},
+	}
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgGovernanceProcessor
+		exError  error
+	}{
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs(0, nil)
+				arg.Accounts = nil
+
+				return arg
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilQueryService",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs(0, nil)
+				arg.QueryService = nil
+
+				return arg
+			},
+			exError: ErrNilQueryService,
+		},
+		{
+			name: "NilBlockChain",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs(0, nil)
+				arg.BlockChain = nil
+
+				return arg
+			},
+			exError: ErrNilBlockChain,
+		},
+		{
+			name: "ShouldWork",
+			argsFunc: func() ArgGovernanceProcessor {
+				return createMockGovernanceArgs(0, nil)
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGovernanceProcessor(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+		})
+	}
+
+	gp, _ := NewGovernanceProcessor(createMockGovernanceArgs(0, nil))
+	assert.False(t, check.IfNil(gp))
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockGovernanceArgs(15, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals("")
+	require.Nil(t, err)
+	require.Len(t, proposals, 2)
+
+	closedProposal := proposals[0]
+	assert.Equal(t, string(commitHash2), closedProposal.Reference)
+	assert.Equal(t, GovernanceStatusClosed, closedProposal.Status)
+	assert.Equal(t, &common.GovernanceTally{Yes: "1", No: "2", Veto: "3", Total: "6"}, closedProposal.Tally)
+	assert.False(t, closedProposal.Quorum.QuorumReached)
+
+	activeProposal := proposals[1]
+	assert.Equal(t, string(commitHash1), activeProposal.Reference)
+	assert.Equal(t, arg.PublicKeyConverter.Encode(bytes.Repeat([]byte{1}, 32)), activeProposal.Issuer)
+	assert.Equal(t, GovernanceStatusActive, activeProposal.Status)
+	assert.Equal(t, 1, activeProposal.NumVoters)
+	assert.Equal(t, &common.GovernanceQuorumStatus{
+		MinQuorum:        "50",
+		MinPassThreshold: "40",
+		MinVetoThreshold: "30",
+		QuorumReached:    true,
+		Vetoed:           false,
+		Passing:          true,
+	}, activeProposal.Quorum)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsFilteredByStatus(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGovernanceProcessor(createMockGovernanceArgs(25, createGovernanceStorage()))
+
+	proposals, err := gp.GetGovernanceProposals(GovernanceStatusEnded)
+	require.Nil(t, err)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, string(commitHash1), proposals[0].Reference)
+
+	proposals, err = gp.GetGovernanceProposals(GovernanceStatusActive)
+	require.Nil(t, err)
+	assert.Empty(t, proposals)
+
+	proposals, err = gp.GetGovernanceProposals("invalid")
+	assert.Nil(t, proposals)
+	assert.True(t, errors.Is(err, ErrInvalidGovernanceStatus))
+}
+
+func TestGovernanceProcessor_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGovernanceProcessor(createMockGovernanceArgs(5, createGovernanceStorage()))
+
+	proposal, err := gp.GetGovernanceProposal(string(commitHash1))
+	require.Nil(t, err)
+	assert.Equal(t, GovernanceStatusPending, proposal.Status)
+	assert.Equal(t, "70", proposal.Tally.Total)
+	assert.NotNil(t, proposal.Quorum)
+
+	proposal, err = gp.GetGovernanceProposal("3333333333333333333333333333333333333333")
+	assert.Nil(t, proposal)
+	assert.True(t, errors.Is(err, vm.ErrProposalNotFound))
+
+	proposal, err = gp.GetGovernanceProposal("invalid")
+	assert.Nil(t, proposal)
+	assert.True(t, errors.Is(err, ErrInvalidGovernanceReference))
+}
+
+func TestGovernanceProcessor_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockGovernanceArgs(15, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(arg)
+
+	votes, err := gp.GetGovernanceVotes(arg.PublicKeyConverter.Encode(voterAddress))
+	require.Nil(t, err)
+	assert.Equal(t, []*common.GovernanceVote{
+		{
+			Proposal:  string(commitHash1),
+			Value:     "yes",
+			Power:     "60",
+			Balance:   "0",
+			WithFunds: false,
+		},
+		{
+			Proposal:  string(commitHash2),
+			Value:     "veto",
+			Power:     "3",
+			Balance:   "9",
+			WithFunds: true,
+		},
+	}, votes)
+
+	votes, err = gp.GetGovernanceVotes(arg.PublicKeyConverter.Encode(bytes.Repeat([]byte{8}, 32)))
+	require.Nil(t, err)
+	assert.Empty(t, votes)
+}
+
+func TestGovernanceProcessor_QueryServiceErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockGovernanceArgs(15, createGovernanceStorage())
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals("")
+	assert.Nil(t, proposals)
+	assert.Equal(t, expectedErr, err)
+
+	votes, err := gp.GetGovernanceVotes(arg.PublicKeyConverter.Encode(voterAddress))
+	assert.Nil(t, votes)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGovernanceProcessor_QueriesShouldNotHoldTheAccountsLock(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockGovernanceArgs(15, createGovernanceStorage())
+	queryService := createGovernanceQueryService()
+	executeQuery := queryService.ExecuteQueryCalled
+	numQueriesWithLockHeld := 0
+	queryService.ExecuteQueryCalled = func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		chanLocked := make(chan struct{})
+		go func() {
+			arg.Accounts.Lock()
+			arg.Accounts.Unlock()
+			close(chanLocked)
+		}()
+
+		select {
+		case <-chanLocked:
+		case <-time.After(time.Second):
+			numQueriesWithLockHeld++
+		}
+
+		return executeQuery(query)
+	}
+	arg.QueryService = queryService
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals("")
+	require.Nil(t, err)
+	assert.Len(t, proposals, 2)
+
+	votes, err := gp.GetGovernanceVotes(arg.PublicKeyConverter.Encode(voterAddress))
+	require.Nil(t, err)
+	assert.Len(t, votes, 2)
+	assert.Equal(t, 0, numQueriesWithLockHeld)
+}
@@ -0,0 +1,149 @@
+package blockdata
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+)
+
+// ErrNilArgsSaveBlockData signals that nil save block arguments have been provided
+var ErrNilArgsSaveBlockData = errors.New("nil args save block data")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// BlockData is a serialization friendly representation of indexer.ArgsSaveBlockData. All the hashes
+// used as map keys are hex encoded so the structure can be safely marshaled as JSON
+type BlockData struct {
+	HeaderHash             string                             `json:"headerHash"`
+	Nonce                  uint64                             `json:"nonce"`
+	Round                  uint64                             `json:"round"`
+	Epoch                  uint32                             `json:"epoch"`
+	ShardID                uint32                             `json:"shardID"`
+	TimeStamp              uint64                             `json:"timeStamp"`
+	Header                 data.HeaderHandler                 `json:"header"`
+	Body                   data.BodyHandler                   `json:"body,omitempty"`
+	SignersIndexes         []uint64                           `json:"signersIndexes,omitempty"`
+	NotarizedHeadersHashes []string                           `json:"notarizedHeadersHashes,omitempty"`
+	HeaderGasConsumption   indexer.HeaderGasConsumption       `json:"headerGasConsumption"`
+	Transactions           map[string]data.TransactionHandler `json:"transactions,omitempty"`
+	SmartContractResults   map[string]data.TransactionHandler `json:"smartContractResults,omitempty"`
+	Rewards                map[string]data.TransactionHandler `json:"rewards,omitempty"`
+	InvalidTransactions    map[string]data.TransactionHandler `json:"invalidTransactions,omitempty"`
+	Receipts               map[string]data.TransactionHandler `json:"receipts,omitempty"`
+	Logs                   []*Log                             `json:"logs,omitempty"`
+	AlteredAccounts        map[string]*indexer.AlteredAccount `json:"alteredAccounts,omitempty"`
+}
+
+// Log holds a transaction log together with the hex encoded hash of the transaction that generated it
+type Log struct {
+	TxHash string          `json:"txHash"`
+	Log    data.LogHandler `json:"log"`
+}
+
+// HeaderInfo holds the identification data of a header
+type HeaderInfo struct {
+	HeaderHash string `json:"headerHash"`
+	Nonce      uint64 `json:"nonce"`
+	Round      uint64 `json:"round"`
+	Epoch      uint32 `json:"epoch"`
+	ShardID    uint32 `json:"shardID"`
+	TimeStamp  uint64 `json:"timeStamp"`
+}
+
+// NewBlockData converts the provided save block arguments into a serialization friendly structure
+func NewBlockData(args *indexer.ArgsSaveBlockData) (*BlockData, error) {
+	if args == nil {
+		return nil, ErrNilArgsSaveBlockData
+	}
+	if check.IfNil(args.Header) {
+		return nil, ErrNilHeader
+	}
+
+	blockData := &BlockData{
+		HeaderHash:             hex.EncodeToString(args.HeaderHash),
+		Nonce:                  args.Header.GetNonce(),
+		Round:                  args.Header.GetRound(),
+		Epoch:                  args.Header.GetEpoch(),
+		ShardID:                args.Header.GetShardID(),
+		TimeStamp:              args.Header.GetTimeStamp(),
+		Header:                 args.Header,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		HeaderGasConsumption:   args.HeaderGasConsumption,
+		AlteredAccounts:        args.AlteredAccounts,
+	}
+	if !check.IfNil(args.Body) {
+		blockData.Body = args.Body
+	}
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		return blockData, nil
+	}
+
+	blockData.Transactions = hexEncodeKeys(pool.Txs)
+	blockData.SmartContractResults = hexEncodeKeys(pool.Scrs)
+	blockData.Rewards = hexEncodeKeys(pool.Rewards)
+	blockData.InvalidTransactions = hexEncodeKeys(pool.Invalid)
+	blockData.Receipts = hexEncodeKeys(pool.Receipts)
+	blockData.Logs = convertLogs(pool.Logs)
+
+	return blockData, nil
+}
+
+// NewHeaderInfo returns the identification data of the provided header
+func NewHeaderInfo(headerHash []byte, header data.HeaderHandler) (*HeaderInfo, error) {
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	return &HeaderInfo{
+		HeaderHash: hex.EncodeToString(headerHash),
+		Nonce:      header.GetNonce(),
+		Round:      header.GetRound(),
+		Epoch:      header.GetEpoch(),
+		ShardID:    header.GetShardID(),
+		TimeStamp:  header.GetTimeStamp(),
+	}, nil
+}
+
+func hexEncodeKeys(txs map[string]data.TransactionHandler) map[string]data.TransactionHandler {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]data.TransactionHandler, len(txs))
+	for hash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		converted[hex.EncodeToString([]byte(hash))] = tx
+	}
+
+	return converted
+}
+
+func convertLogs(logs []*data.LogData) []*Log {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	converted := make([]*Log, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		converted = append(converted, &Log{
+			TxHash: hex.EncodeToString([]byte(logData.TxHash)),
+			Log:    logData.LogHandler,
+		})
+	}
+
+	return converted
+}
@@ -0,0 +1,86 @@
+package blockdata
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		blockData, err := NewBlockData(nil)
+		assert.Nil(t, blockData)
+		assert.Equal(t, ErrNilArgsSaveBlockData, err)
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		blockData, err := NewBlockData(&indexer.ArgsSaveBlockData{})
+		assert.Nil(t, blockData)
+		assert.Equal(t, ErrNilHeader, err)
+	})
+	t.Run("nil pool should work", func(t *testing.T) {
+		blockData, err := NewBlockData(&indexer.ArgsSaveBlockData{
+			HeaderHash: []byte("hash"),
+			Header:     &block.Header{Nonce: 7, Epoch: 2, ShardID: 1},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString([]byte("hash")), blockData.HeaderHash)
+		assert.Equal(t, uint64(7), blockData.Nonce)
+		assert.Equal(t, uint32(2), blockData.Epoch)
+		assert.Equal(t, uint32(1), blockData.ShardID)
+		assert.Nil(t, blockData.Transactions)
+	})
+	t.Run("should hex encode the hashes and skip nil entries", func(t *testing.T) {
+		tx := &transaction.Transaction{Nonce: 1}
+		scr := &smartContractResult.SmartContractResult{Nonce: 2}
+		txLog := &transaction.Log{Address: []byte("address")}
+		args := &indexer.ArgsSaveBlockData{
+			HeaderHash: []byte("hash"),
+			Header:     &block.Header{Nonce: 7},
+			Body:       &block.Body{},
+			TransactionsPool: &indexer.Pool{
+				Txs:  map[string]data.TransactionHandler{"tx\x00hash": tx, "nil": nil},
+				Scrs: map[string]data.TransactionHandler{"scrHash": scr},
+				Logs: []*data.LogData{{LogHandler: txLog, TxHash: "tx\x00hash"}, nil},
+			},
+		}
+
+		blockData, err := NewBlockData(args)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]data.TransactionHandler{hex.EncodeToString([]byte("tx\x00hash")): tx}, blockData.Transactions)
+		assert.Equal(t, map[string]data.TransactionHandler{hex.EncodeToString([]byte("scrHash")): scr}, blockData.SmartContractResults)
+		assert.Nil(t, blockData.Rewards)
+		require.Equal(t, 1, len(blockData.Logs))
+		assert.Equal(t, hex.EncodeToString([]byte("tx\x00hash")), blockData.Logs[0].TxHash)
+
+		_, err = json.Marshal(blockData)
+		assert.Nil(t, err)
+	})
+}
+
+func TestNewHeaderInfo(t *testing.T) {
+	t.Parallel()
+
+	headerInfo, err := NewHeaderInfo(nil, nil)
+	assert.Nil(t, headerInfo)
+	assert.Equal(t, ErrNilHeader, err)
+
+	headerInfo, err = NewHeaderInfo([]byte("hash"), &block.MetaBlock{Nonce: 3, Round: 4, Epoch: 1})
+	require.Nil(t, err)
+	assert.Equal(t, &HeaderInfo{
+		HeaderHash: hex.EncodeToString([]byte("hash")),
+		Nonce:      3,
+		Round:      4,
+		Epoch:      1,
+		ShardID:    0xFFFFFFFF,
+	}, headerInfo)
+}
@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -60,3 +62,8 @@ func (n *disabledOutport) SubscribeDriver(_ outport.Driver) error {
 func (n *disabledOutport) HasDrivers() bool {
 	return false
 }
+
+// GetRetryingDuration returns 0
+func (n *disabledOutport) GetRetryingDuration() time.Duration {
+	return 0
+}
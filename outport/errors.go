@@ -10,3 +10,6 @@ var ErrNilArgsOutportFactory = errors.New("nil args outport driver factory")
 
 // ErrInvalidRetrialInterval signals that an invalid retrial interval was provided
 var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrNilPersisterFactory signals that a nil persister factory has been provided
+var ErrNilPersisterFactory = errors.New("nil persister factory")
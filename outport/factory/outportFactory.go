@@ -1,11 +1,18 @@
 package factory
 
 import (
+	"path/filepath"
 	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/filedriver"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+	"github.com/ElrondNetwork/elrond-go/outport/wsdriver"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 )
 
@@ -15,8 +22,30 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *notifierFactory.EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	WebSocketDriverFactoryArgs *wsdriver.ArgsWebSocketDriverFactory
+	FileDriverFactoryArgs      *filedriver.ArgsFileDriverFactory
+	QueueFactoryArgs           *OutportQueueFactoryArgs
+	SubscriptionsDriver        outport.Driver
 }
 
+// OutportQueueFactoryArgs holds the arguments needed to place every driver behind a durable queue
+type OutportQueueFactoryArgs struct {
+	Enabled          bool
+	MaxQueueSize     uint64
+	MaxDrainWait     time.Duration
+	BasePath         string
+	PersisterFactory storage.PersisterFactory
+	StatusHandler    core.AppStatusHandler
+}
+
+const (
+	elasticDriverName   = "elastic"
+	notifierDriverName  = "notifier"
+	covalentDriverName  = "covalent"
+	webSocketDriverName = "websocket"
+	fileDriverName      = "file"
+)
+
 // CreateOutport will create a new instance of OutportHandler
 func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 	err := checkArguments(args)
@@ -38,26 +67,84 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	subscriber := &driverSubscriber{
+		outport:         outport,
+		queueArgs:       args.QueueFactoryArgs,
+		retrialInterval: args.RetrialInterval,
+	}
+
+	err := createAndSubscribeElasticDriverIfNeeded(subscriber, args.ElasticIndexerFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeEventNotifierIfNeeded(subscriber, args.EventNotifierFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeCovalentDriverIfNeeded(subscriber, args.CovalentIndexerFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeWebSocketDriverIfNeeded(subscriber, args.WebSocketDriverFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs)
+	err = createAndSubscribeFileDriverIfNeeded(subscriber, args.FileDriverFactoryArgs)
 	if err != nil {
 		return err
 	}
 
+	// the subscriptions driver only delivers live notifications, so it is never placed behind the durable queue
+	if !check.IfNil(args.SubscriptionsDriver) {
+		return outport.SubscribeDriver(args.SubscriptionsDriver)
+	}
+
 	return nil
 }
 
+// driverSubscriber subscribes the drivers to the outport, placing them behind a durable queue if enabled
+type driverSubscriber struct {
+	outport         outport.OutportHandler
+	queueArgs       *OutportQueueFactoryArgs
+	retrialInterval time.Duration
+}
+
+func (ds *driverSubscriber) subscribe(name string, driver outport.Driver) error {
+	if ds.queueArgs == nil || !ds.queueArgs.Enabled {
+		return ds.outport.SubscribeDriver(driver)
+	}
+	if check.IfNil(ds.queueArgs.PersisterFactory) {
+		return outport.ErrNilPersisterFactory
+	}
+
+	persister, err := ds.queueArgs.PersisterFactory.Create(filepath.Join(ds.queueArgs.BasePath, name))
+	if err != nil {
+		return err
+	}
+
+	queuedDriver, err := queue.NewQueuedDriver(queue.ArgsQueuedDriver{
+		Name:            name,
+		Driver:          driver,
+		Persister:       persister,
+		StatusHandler:   ds.queueArgs.StatusHandler,
+		MaxQueueSize:    ds.queueArgs.MaxQueueSize,
+		MaxDrainWait:    ds.queueArgs.MaxDrainWait,
+		RetrialInterval: ds.retrialInterval,
+	})
+	if err != nil {
+		_ = persister.Close()
+		return err
+	}
+
+	return ds.outport.SubscribeDriver(queuedDriver)
+}
+
 func createAndSubscribeCovalentDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *covalentFactory.ArgsCovalentIndexerFactory,
 ) error {
 	if !args.Enabled {
@@ -69,11 +156,11 @@ func createAndSubscribeCovalentDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(covalentDriver)
+	return subscriber.subscribe(covalentDriverName, covalentDriver)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *indexerFactory.ArgsIndexerFactory,
 ) error {
 	if !args.Enabled {
@@ -85,11 +172,11 @@ func createAndSubscribeElasticDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscriber.subscribe(elasticDriverName, elasticDriver)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *notifierFactory.EventNotifierFactoryArgs,
 ) error {
 	if !args.Enabled {
@@ -101,7 +188,39 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscriber.subscribe(notifierDriverName, eventNotifier)
+}
+
+func createAndSubscribeWebSocketDriverIfNeeded(
+	subscriber *driverSubscriber,
+	args *wsdriver.ArgsWebSocketDriverFactory,
+) error {
+	if args == nil || !args.Enabled {
+		return nil
+	}
+
+	webSocketDriver, err := wsdriver.CreateWebSocketDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.subscribe(webSocketDriverName, webSocketDriver)
+}
+
+func createAndSubscribeFileDriverIfNeeded(
+	subscriber *driverSubscriber,
+	args *filedriver.ArgsFileDriverFactory,
+) error {
+	if args == nil || !args.Enabled {
+		return nil
+	}
+
+	fileDriver, err := filedriver.CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.subscribe(fileDriverName, fileDriver)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
@@ -2,6 +2,7 @@ package factory_test
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -9,9 +10,16 @@ import (
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/filedriver"
+	outportMock "github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/wsdriver"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	storageMock "github.com/ElrondNetwork/elrond-go/storage/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 	"github.com/stretchr/testify/require"
 )
@@ -135,3 +143,150 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeWebSocketDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.WebSocketDriverFactoryArgs = &wsdriver.ArgsWebSocketDriverFactory{
+		Enabled:               true,
+		URL:                   "localhost:0",
+		Route:                 "/blocks",
+		BufferSize:            10,
+		MaxUnackedEvents:      1,
+		WriteTimeoutInSeconds: 1,
+		Marshaller:            &mock.MarshalizerMock{},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+}
+
+func TestCreateOutport_WebSocketDriverInvalidArgsShouldErr(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.WebSocketDriverFactoryArgs = &wsdriver.ArgsWebSocketDriverFactory{
+		Enabled: true,
+	}
+
+	_, err := factory.CreateOutport(args)
+	require.Equal(t, wsdriver.ErrEmptyURL, err)
+}
+
+func TestCreateOutport_SubscribeQueuedDriver(t *testing.T) {
+	createdPaths := make([]string, 0)
+	args := createMockArgsOutportHandler(false, false, false)
+	args.WebSocketDriverFactoryArgs = &wsdriver.ArgsWebSocketDriverFactory{
+		Enabled:               true,
+		URL:                   "localhost:0",
+		Route:                 "/blocks",
+		BufferSize:            10,
+		MaxUnackedEvents:      1,
+		WriteTimeoutInSeconds: 1,
+		Marshaller:            &mock.MarshalizerMock{},
+	}
+	args.QueueFactoryArgs = &factory.OutportQueueFactoryArgs{
+		Enabled:      true,
+		MaxQueueSize: 10,
+		MaxDrainWait: time.Second,
+		BasePath:     "OutportQueue",
+		PersisterFactory: &storageMock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				createdPaths = append(createdPaths, path)
+				return memorydb.New(), nil
+			},
+		},
+		StatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Equal(t, []string{filepath.Join("OutportQueue", "websocket")}, createdPaths)
+}
+
+func TestCreateOutport_QueuedDriverNilPersisterFactoryShouldErr(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.WebSocketDriverFactoryArgs = &wsdriver.ArgsWebSocketDriverFactory{
+		Enabled:               true,
+		URL:                   "localhost:0",
+		Route:                 "/blocks",
+		BufferSize:            10,
+		MaxUnackedEvents:      1,
+		WriteTimeoutInSeconds: 1,
+		Marshaller:            &mock.MarshalizerMock{},
+	}
+	args.QueueFactoryArgs = &factory.OutportQueueFactoryArgs{
+		Enabled:      true,
+		MaxQueueSize: 10,
+		MaxDrainWait: time.Second,
+	}
+
+	_, err := factory.CreateOutport(args)
+	require.Equal(t, outport.ErrNilPersisterFactory, err)
+}
+
+func TestCreateOutport_SubscribeFileDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.FileDriverFactoryArgs = &filedriver.ArgsFileDriverFactory{
+		Enabled:               true,
+		Path:                  t.TempDir(),
+		MaxFileSizeInMB:       1,
+		NumWritesBetweenSyncs: 10,
+		PubKeyConverter:       mock.NewPubkeyConverterMock(32),
+		Marshalizer:           &mock.MarshalizerMock{},
+		Hasher:                &hashingMocks.HasherMock{},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+}
+
+func TestCreateOutport_FileDriverInvalidArgsShouldErr(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.FileDriverFactoryArgs = &filedriver.ArgsFileDriverFactory{
+		Enabled: true,
+	}
+
+	_, err := factory.CreateOutport(args)
+	require.Equal(t, filedriver.ErrEmptyBasePath, err)
+}
+
+func TestCreateOutport_SubscriptionsDriverShouldNotBeQueued(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.SubscriptionsDriver = &outportMock.DriverStub{}
+	args.QueueFactoryArgs = &factory.OutportQueueFactoryArgs{
+		Enabled:      true,
+		MaxQueueSize: 10,
+		MaxDrainWait: time.Second,
+		PersisterFactory: &storageMock.PersisterFactoryStub{
+			CreateCalled: func(path string) (storage.Persister, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+}
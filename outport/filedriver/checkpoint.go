@@ -0,0 +1,121 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const checkpointFileName = "checkpoint.json"
+
+// filePosition is the end of the last complete write in the files of a table
+type filePosition struct {
+	Sequence int    `json:"sequence"`
+	Size     uint64 `json:"size"`
+}
+
+// checkpoint is saved in the epoch directory after each complete write. When the directory is opened again, the
+// files are truncated to the saved positions, dropping the records of an interrupted write, and the last write is
+// skipped if it is delivered again
+type checkpoint struct {
+	LastWriteKey string                  `json:"lastWriteKey"`
+	Positions    map[string]filePosition `json:"positions"`
+}
+
+// loadCheckpoint reads the checkpoint of the provided directory, returning false if there is none
+func loadCheckpoint(dir string) (*checkpoint, bool, error) {
+	cp := &checkpoint{
+		Positions: make(map[string]filePosition),
+	}
+
+	buff, err := ioutil.ReadFile(filepath.Join(dir, checkpointFileName))
+	if os.IsNotExist(err) {
+		return cp, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = json.Unmarshal(buff, cp)
+	if err != nil {
+		return nil, false, err
+	}
+	if cp.Positions == nil {
+		cp.Positions = make(map[string]filePosition)
+	}
+
+	return cp, true, nil
+}
+
+// save replaces the checkpoint of the provided directory. The new content is written in a temporary file which
+// is renamed afterwards, so an interrupted save keeps the previous checkpoint
+func (cp *checkpoint) save(dir string, shouldSync bool) error {
+	buff, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	tempPath := filepath.Join(dir, checkpointFileName+".tmp")
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err == nil && shouldSync {
+		err = file.Sync()
+	}
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, filepath.Join(dir, checkpointFileName))
+}
+
+// truncateTable drops the records written in the files of the table after the provided position. All the files
+// of the table are removed if the checkpoint has no position for it
+func truncateTable(dir string, table string, position filePosition, hasPosition bool) error {
+	sequences, err := listSequences(dir, table)
+	if err != nil {
+		return err
+	}
+
+	for _, sequence := range sequences {
+		path := filepath.Join(dir, fileName(table, sequence))
+		if !hasPosition || sequence > position.Sequence {
+			log.Debug("file driver: removing file written after the checkpoint", "path", path)
+			err = os.Remove(path)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if sequence < position.Sequence {
+			continue
+		}
+
+		info, errStat := os.Stat(path)
+		if errStat != nil {
+			return errStat
+		}
+		if uint64(info.Size()) <= position.Size {
+			continue
+		}
+
+		log.Debug("file driver: truncating file to the checkpoint", "path", path,
+			"size", info.Size(), "checkpoint size", position.Size)
+		err = os.Truncate(path, int64(position.Size))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
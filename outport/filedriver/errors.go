@@ -0,0 +1,27 @@
+package filedriver
+
+import "errors"
+
+// ErrEmptyBasePath signals that an empty base path has been provided
+var ErrEmptyBasePath = errors.New("empty base path")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrInvalidNumWritesBetweenSyncs signals that an invalid number of writes between syncs has been provided
+var ErrInvalidNumWritesBetweenSyncs = errors.New("invalid number of writes between syncs")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilArgsFileDriverFactory signals that nil arguments have been provided to the driver factory
+var ErrNilArgsFileDriverFactory = errors.New("nil args file driver factory")
+
+// ErrDriverClosed signals that the driver has been closed
+var ErrDriverClosed = errors.New("driver closed")
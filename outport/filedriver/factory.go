@@ -0,0 +1,45 @@
+package filedriver
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+const bytesInMB = 1024 * 1024
+
+// ArgsFileDriverFactory holds the arguments needed to create a file driver
+type ArgsFileDriverFactory struct {
+	Enabled               bool
+	Path                  string
+	MaxFileSizeInMB       uint64
+	NumWritesBetweenSyncs uint32
+	PubKeyConverter       core.PubkeyConverter
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+}
+
+// CreateFileDriver creates a file driver writing under the configured path
+func CreateFileDriver(args *ArgsFileDriverFactory) (outport.Driver, error) {
+	if args == nil {
+		return nil, ErrNilArgsFileDriverFactory
+	}
+
+	driver, err := NewFileDriver(ArgsFileDriver{
+		BasePath:              args.Path,
+		MaxFileSize:           args.MaxFileSizeInMB * bytesInMB,
+		NumWritesBetweenSyncs: args.NumWritesBetweenSyncs,
+		PubKeyConverter:       args.PubKeyConverter,
+		Marshalizer:           args.Marshalizer,
+		Hasher:                args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("file driver started", "path", args.Path, "max file size in MB", args.MaxFileSizeInMB,
+		"num writes between syncs", args.NumWritesBetweenSyncs)
+
+	return driver, nil
+}
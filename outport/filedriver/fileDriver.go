@@ -0,0 +1,441 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/blockdata"
+)
+
+var _ outport.Driver = (*fileDriver)(nil)
+
+var log = logger.GetOrCreate("outport/filedriver")
+
+const epochDirectoryPrefix = "epoch_"
+
+// ArgsFileDriver holds the arguments needed to create a new file driver
+type ArgsFileDriver struct {
+	BasePath              string
+	MaxFileSize           uint64
+	NumWritesBetweenSyncs uint32
+	PubKeyConverter       core.PubkeyConverter
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+}
+
+// fileDriver is an outport driver that exports the saved blocks into local newline delimited JSON files, meant
+// to be loaded by batch analytics tools. The files are partitioned by epoch, each entity type (blocks,
+// transactions, smart contract results, logs, accounts and so on) having its own files inside the epoch
+// directory. The files are rotated once they reach the maximum size. Reverted blocks are not removed from the
+// files, a revert record being appended instead. Every write is recorded in the checkpoint of the epoch directory,
+// so a write interrupted by a crash is dropped and a write delivered again after a restart is not duplicated. The
+// files are synced to the disk once every NumWritesBetweenSyncs writes and on close
+type fileDriver struct {
+	mut                   sync.Mutex
+	basePath              string
+	maxFileSize           uint64
+	numWritesBetweenSyncs uint32
+	pubKeyConverter       core.PubkeyConverter
+	marshalizer           marshal.Marshalizer
+	hasher                hashing.Hasher
+	epoch                 uint32
+	files                 map[string]*rotatingFile
+	checkpoint            *checkpoint
+	numUnsyncedWrites     uint32
+	closed                bool
+}
+
+// NewFileDriver creates a new file driver
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.BasePath, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileDriver{
+		basePath:              args.BasePath,
+		maxFileSize:           args.MaxFileSize,
+		numWritesBetweenSyncs: args.NumWritesBetweenSyncs,
+		pubKeyConverter:       args.PubKeyConverter,
+		marshalizer:           args.Marshalizer,
+		hasher:                args.Hasher,
+		files:                 make(map[string]*rotatingFile),
+	}, nil
+}
+
+func checkArgs(args ArgsFileDriver) error {
+	if len(args.BasePath) == 0 {
+		return ErrEmptyBasePath
+	}
+	if args.MaxFileSize == 0 {
+		return ErrInvalidMaxFileSize
+	}
+	if args.NumWritesBetweenSyncs == 0 {
+		return ErrInvalidNumWritesBetweenSyncs
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// SaveBlock writes the block together with its transactions, smart contract results, rewards, invalid
+// transactions, receipts and logs in the partition of the block's epoch
+func (fd *fileDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	blockData, err := blockdata.NewBlockData(args)
+	if err != nil {
+		return err
+	}
+
+	headerInfo, err := blockdata.NewHeaderInfo(args.HeaderHash, args.Header)
+	if err != nil {
+		return err
+	}
+
+	records := map[string][]interface{}{
+		tableBlocks: {&blockRecord{
+			HeaderInfo:             headerInfo,
+			Header:                 blockData.Header,
+			Body:                   blockData.Body,
+			SignersIndexes:         blockData.SignersIndexes,
+			NotarizedHeadersHashes: blockData.NotarizedHeadersHashes,
+			HeaderGasConsumption:   blockData.HeaderGasConsumption,
+		}},
+		tableTransactions:         createTransactionRecords(headerInfo, blockData.Transactions),
+		tableSmartContractResults: createTransactionRecords(headerInfo, blockData.SmartContractResults),
+		tableRewards:              createTransactionRecords(headerInfo, blockData.Rewards),
+		tableInvalidTransactions:  createTransactionRecords(headerInfo, blockData.InvalidTransactions),
+		tableReceipts:             createTransactionRecords(headerInfo, blockData.Receipts),
+		tableLogs:                 createLogRecords(headerInfo, blockData.Logs),
+	}
+
+	return fd.write(headerInfo.Epoch, "block_"+headerInfo.HeaderHash, records)
+}
+
+func createTransactionRecords(headerInfo *blockdata.HeaderInfo, txs map[string]data.TransactionHandler) []interface{} {
+	hashes := make([]string, 0, len(txs))
+	for hash := range txs {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	records := make([]interface{}, 0, len(hashes))
+	for _, hash := range hashes {
+		records = append(records, &transactionRecord{
+			BlockHash:   headerInfo.HeaderHash,
+			BlockNonce:  headerInfo.Nonce,
+			Epoch:       headerInfo.Epoch,
+			ShardID:     headerInfo.ShardID,
+			Hash:        hash,
+			Transaction: txs[hash],
+		})
+	}
+
+	return records
+}
+
+func createLogRecords(headerInfo *blockdata.HeaderInfo, logs []*blockdata.Log) []interface{} {
+	records := make([]interface{}, 0, len(logs))
+	for _, txLog := range logs {
+		records = append(records, &logRecord{
+			BlockHash:  headerInfo.HeaderHash,
+			BlockNonce: headerInfo.Nonce,
+			Epoch:      headerInfo.Epoch,
+			ShardID:    headerInfo.ShardID,
+			TxHash:     txLog.TxHash,
+			Log:        txLog.Log,
+		})
+	}
+
+	return records
+}
+
+// RevertIndexedBlock appends a revert record for the provided header in the partition of the header's epoch
+func (fd *fileDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return blockdata.ErrNilHeader
+	}
+
+	// the outport does not provide the reverted header hash, so it is computed the same way the saved block hash was
+	headerHash, err := core.CalculateHash(fd.marshalizer, fd.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	headerInfo, err := blockdata.NewHeaderInfo(headerHash, header)
+	if err != nil {
+		return err
+	}
+
+	records := map[string][]interface{}{
+		tableReverts: {&revertRecord{
+			HeaderInfo: headerInfo,
+			Reverted:   true,
+		}},
+	}
+
+	return fd.write(headerInfo.Epoch, "revert_"+headerInfo.HeaderHash, records)
+}
+
+// SaveAccounts writes the provided accounts in the partition of the last written epoch
+func (fd *fileDriver) SaveAccounts(blockTimestamp uint64, accounts []data.UserAccountHandler) error {
+	fd.mut.Lock()
+	epoch := fd.epoch
+	fd.mut.Unlock()
+
+	accountRecords := make([]interface{}, 0, len(accounts))
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+
+		accountRecords = append(accountRecords, &accountRecord{
+			BlockTimestamp: blockTimestamp,
+			Epoch:          epoch,
+			Address:        fd.pubKeyConverter.Encode(account.AddressBytes()),
+			Nonce:          account.GetNonce(),
+			Balance:        account.GetBalance().String(),
+		})
+	}
+
+	writeKey := fmt.Sprintf("accounts_%d", blockTimestamp)
+
+	return fd.write(epoch, writeKey, map[string][]interface{}{tableAccounts: accountRecords})
+}
+
+// SaveRoundsInfo does nothing
+func (fd *fileDriver) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (fd *fileDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (fd *fileDriver) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// FinalizedBlock does nothing
+func (fd *fileDriver) FinalizedBlock(_ []byte) error {
+	return nil
+}
+
+// write marshals and appends the records of each table in the partition of the provided epoch, then saves the
+// checkpoint of the partition. The write is skipped if its key is the last one written in the partition, as it
+// is delivered again after a restart. The records are flushed to the operating system before returning, so they
+// survive a node crash, being synced to the disk once every numWritesBetweenSyncs writes
+func (fd *fileDriver) write(epoch uint32, writeKey string, records map[string][]interface{}) error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.closed {
+		return ErrDriverClosed
+	}
+
+	err := fd.switchToEpoch(epoch)
+	if err != nil {
+		return err
+	}
+
+	err = fd.loadCheckpointIfNeeded()
+	if err != nil {
+		return err
+	}
+	if fd.checkpoint.LastWriteKey == writeKey {
+		log.Debug("file driver: skipping already written entry", "key", writeKey, "epoch", epoch)
+		return nil
+	}
+
+	tables := make([]string, 0, len(records))
+	for table, tableRecords := range records {
+		if len(tableRecords) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+
+	for _, table := range tables {
+		file, errGet := fd.getFile(table)
+		if errGet != nil {
+			return errGet
+		}
+
+		for _, record := range records[table] {
+			buff, errMarshal := json.Marshal(record)
+			if errMarshal != nil {
+				return errMarshal
+			}
+
+			err = file.write(buff)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = file.flush()
+		if err != nil {
+			return err
+		}
+
+		fd.checkpoint.Positions[table] = file.position()
+	}
+
+	fd.checkpoint.LastWriteKey = writeKey
+	fd.numUnsyncedWrites++
+	if fd.numUnsyncedWrites >= fd.numWritesBetweenSyncs {
+		return fd.syncFiles()
+	}
+
+	return fd.checkpoint.save(fd.epochDir(), false)
+}
+
+// syncFiles commits the written records to the disk, followed by the checkpoint pointing to them
+func (fd *fileDriver) syncFiles() error {
+	for _, file := range fd.files {
+		err := file.sync()
+		if err != nil {
+			return err
+		}
+	}
+
+	fd.numUnsyncedWrites = 0
+
+	return fd.checkpoint.save(fd.epochDir(), true)
+}
+
+func (fd *fileDriver) epochDir() string {
+	return filepath.Join(fd.basePath, fmt.Sprintf("%s%d", epochDirectoryPrefix, fd.epoch))
+}
+
+func (fd *fileDriver) loadCheckpointIfNeeded() error {
+	if fd.checkpoint != nil {
+		return nil
+	}
+
+	dir := fd.epochDir()
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	cp, hasCheckpoint, err := loadCheckpoint(dir)
+	if err != nil {
+		return err
+	}
+
+	if hasCheckpoint {
+		for _, table := range allTables {
+			position, hasPosition := cp.Positions[table]
+			err = truncateTable(dir, table, position, hasPosition)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	fd.checkpoint = cp
+
+	return nil
+}
+
+func (fd *fileDriver) switchToEpoch(epoch uint32) error {
+	if epoch == fd.epoch {
+		return nil
+	}
+
+	err := fd.closeFiles()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("file driver: new epoch partition", "epoch", epoch)
+	fd.epoch = epoch
+	fd.checkpoint = nil
+
+	return nil
+}
+
+func (fd *fileDriver) getFile(table string) (*rotatingFile, error) {
+	file, ok := fd.files[table]
+	if ok {
+		return file, nil
+	}
+
+	file, err := newRotatingFile(fd.epochDir(), table, fd.maxFileSize)
+	if err != nil {
+		return nil, err
+	}
+
+	fd.files[table] = file
+
+	return file, nil
+}
+
+// closeFiles syncs and closes the files of the current partition, saving its checkpoint afterwards
+func (fd *fileDriver) closeFiles() error {
+	if len(fd.files) == 0 {
+		return nil
+	}
+
+	var lastErr error
+	for table, file := range fd.files {
+		err := file.close()
+		if err != nil {
+			log.Warn("file driver: can not close file", "table", table, "error", err.Error())
+			lastErr = err
+		}
+	}
+
+	fd.files = make(map[string]*rotatingFile)
+	fd.numUnsyncedWrites = 0
+	if lastErr != nil {
+		return lastErr
+	}
+
+	return fd.checkpoint.save(fd.epochDir(), true)
+}
+
+// Close flushes and closes all the opened files
+func (fd *fileDriver) Close() error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.closed {
+		return nil
+	}
+	fd.closed = true
+
+	return fd.closeFiles()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
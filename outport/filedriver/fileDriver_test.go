@@ -0,0 +1,314 @@
+package filedriver
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileDriver(basePath string) ArgsFileDriver {
+	return ArgsFileDriver{
+		BasePath:              basePath,
+		MaxFileSize:           1024 * 1024,
+		NumWritesBetweenSyncs: 10,
+		PubKeyConverter:       testscommon.NewPubkeyConverterMock(32),
+		Marshalizer:           &testscommon.MarshalizerMock{},
+		Hasher:                &hashingMocks.HasherMock{},
+	}
+}
+
+func createSaveBlockArgs(headerHash string, nonce uint64, epoch uint32) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte(headerHash),
+		Header:     &block.Header{Nonce: nonce, Round: nonce, Epoch: epoch},
+		Body:       &block.Body{},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx2": &transaction.Transaction{Nonce: 2, Value: big.NewInt(2)},
+				"tx1": &transaction.Transaction{Nonce: 1, Value: big.NewInt(1)},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				"scr": &smartContractResult.SmartContractResult{Nonce: 3, Value: big.NewInt(3)},
+			},
+			Logs: []*data.LogData{
+				{TxHash: "tx1", LogHandler: &transaction.Log{Address: []byte("addr")}},
+			},
+		},
+	}
+}
+
+func readRecords(t *testing.T, path string) []map[string]interface{} {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Nil(t, scanner.Err())
+
+	return records
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty base path should error", func(t *testing.T) {
+		args := createMockArgsFileDriver("")
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrEmptyBasePath, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("invalid max file size should error", func(t *testing.T) {
+		args := createMockArgsFileDriver(t.TempDir())
+		args.MaxFileSize = 0
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrInvalidMaxFileSize, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("invalid num writes between syncs should error", func(t *testing.T) {
+		args := createMockArgsFileDriver(t.TempDir())
+		args.NumWritesBetweenSyncs = 0
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrInvalidNumWritesBetweenSyncs, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		args := createMockArgsFileDriver(t.TempDir())
+		args.PubKeyConverter = nil
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Marshalizer = nil
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Hasher = nil
+
+		driver, err := NewFileDriver(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(driver))
+	})
+	t.Run("should work", func(t *testing.T) {
+		driver, err := NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(driver))
+		assert.Nil(t, driver.Close())
+	})
+}
+
+func TestFileDriver_SaveBlockWritesAllTables(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+
+	err := driver.SaveBlock(createSaveBlockArgs("hash", 5, 2))
+	require.Nil(t, err)
+
+	epochDir := filepath.Join(basePath, "epoch_2")
+
+	blocks := readRecords(t, filepath.Join(epochDir, "blocks_000000.ndjson"))
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, "68617368", blocks[0]["headerHash"])
+	assert.Equal(t, float64(5), blocks[0]["nonce"])
+	assert.Equal(t, float64(2), blocks[0]["epoch"])
+
+	txs := readRecords(t, filepath.Join(epochDir, "transactions_000000.ndjson"))
+	require.Equal(t, 2, len(txs))
+	assert.Equal(t, "747831", txs[0]["hash"])
+	assert.Equal(t, "747832", txs[1]["hash"])
+	assert.Equal(t, "68617368", txs[0]["blockHash"])
+
+	scrs := readRecords(t, filepath.Join(epochDir, "smartContractResults_000000.ndjson"))
+	require.Equal(t, 1, len(scrs))
+	assert.Equal(t, "736372", scrs[0]["hash"])
+
+	logs := readRecords(t, filepath.Join(epochDir, "logs_000000.ndjson"))
+	require.Equal(t, 1, len(logs))
+	assert.Equal(t, "747831", logs[0]["txHash"])
+
+	_, err = os.Stat(filepath.Join(epochDir, "rewards_000000.ndjson"))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Nil(t, driver.Close())
+}
+
+func TestFileDriver_PartitionsByEpoch(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h1", 1, 0)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h2", 2, 1)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h3", 3, 1)))
+	assert.Nil(t, driver.Close())
+
+	assert.Equal(t, 1, len(readRecords(t, filepath.Join(basePath, "epoch_0", "blocks_000000.ndjson"))))
+	assert.Equal(t, 2, len(readRecords(t, filepath.Join(basePath, "epoch_1", "blocks_000000.ndjson"))))
+}
+
+func TestFileDriver_RevertIndexedBlockWritesRevertRecord(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	args := createMockArgsFileDriver(basePath)
+	driver, _ := NewFileDriver(args)
+
+	header := &block.Header{Nonce: 7, Round: 7, Epoch: 3}
+	headerHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+	require.Nil(t, err)
+
+	saveBlockArgs := createSaveBlockArgs("", 7, 3)
+	saveBlockArgs.HeaderHash = headerHash
+	saveBlockArgs.Header = header
+	require.Nil(t, driver.SaveBlock(saveBlockArgs))
+	require.Nil(t, driver.RevertIndexedBlock(header, &block.Body{}))
+	assert.Nil(t, driver.Close())
+
+	blocks := readRecords(t, filepath.Join(basePath, "epoch_3", "blocks_000000.ndjson"))
+	reverts := readRecords(t, filepath.Join(basePath, "epoch_3", "reverts_000000.ndjson"))
+	require.Equal(t, 1, len(reverts))
+	assert.Equal(t, float64(7), reverts[0]["nonce"])
+	assert.Equal(t, true, reverts[0]["reverted"])
+	assert.NotEmpty(t, reverts[0]["headerHash"])
+	assert.Equal(t, blocks[0]["headerHash"], reverts[0]["headerHash"])
+}
+
+func TestFileDriver_SaveAccountsUsesTheLastEpoch(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	_ = account.AddToBalance(big.NewInt(100))
+	account.IncreaseNonce(4)
+
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h1", 1, 4)))
+	require.Nil(t, driver.SaveAccounts(1234, []data.UserAccountHandler{account}))
+	assert.Nil(t, driver.Close())
+
+	accounts := readRecords(t, filepath.Join(basePath, "epoch_4", "accounts_000000.ndjson"))
+	require.Equal(t, 1, len(accounts))
+	assert.Equal(t, "61646472657373", accounts[0]["address"])
+	assert.Equal(t, "100", accounts[0]["balance"])
+	assert.Equal(t, float64(4), accounts[0]["nonce"])
+	assert.Equal(t, float64(1234), accounts[0]["blockTimestamp"])
+}
+
+func TestFileDriver_ResumesAfterReopen(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h1", 1, 0)))
+	assert.Nil(t, driver.Close())
+
+	driver, _ = NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h2", 2, 0)))
+	assert.Nil(t, driver.Close())
+
+	blocks := readRecords(t, filepath.Join(basePath, "epoch_0", "blocks_000000.ndjson"))
+	require.Equal(t, 2, len(blocks))
+}
+
+func TestFileDriver_BlockDeliveredAgainAfterReopenShouldBeSkipped(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h1", 1, 0)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h2", 2, 0)))
+	assert.Nil(t, driver.Close())
+
+	driver, _ = NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h2", 2, 0)))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h3", 3, 0)))
+	assert.Nil(t, driver.Close())
+
+	epochDir := filepath.Join(basePath, "epoch_0")
+	blocks := readRecords(t, filepath.Join(epochDir, "blocks_000000.ndjson"))
+	require.Equal(t, 3, len(blocks))
+	assert.Equal(t, float64(1), blocks[0]["nonce"])
+	assert.Equal(t, float64(2), blocks[1]["nonce"])
+	assert.Equal(t, float64(3), blocks[2]["nonce"])
+	assert.Equal(t, 6, len(readRecords(t, filepath.Join(epochDir, "transactions_000000.ndjson"))))
+}
+
+func TestFileDriver_ReopenDropsTheRecordsOfAnInterruptedWrite(t *testing.T) {
+	t.Parallel()
+
+	basePath := t.TempDir()
+	driver, _ := NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h1", 1, 0)))
+	assert.Nil(t, driver.Close())
+
+	// simulate a crash while writing the next block: some of its records reached the files, the checkpoint was
+	// not updated
+	epochDir := filepath.Join(basePath, "epoch_0")
+	blocksFile, err := os.OpenFile(filepath.Join(epochDir, "blocks_000000.ndjson"), os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, err = blocksFile.WriteString("{\"nonce\":2}\n")
+	require.Nil(t, err)
+	require.Nil(t, blocksFile.Close())
+	require.Nil(t, ioutil.WriteFile(filepath.Join(epochDir, "rewards_000000.ndjson"), []byte("{\"nonce\":2}\n"), 0644))
+
+	driver, _ = NewFileDriver(createMockArgsFileDriver(basePath))
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs("h2", 2, 0)))
+	assert.Nil(t, driver.Close())
+
+	blocks := readRecords(t, filepath.Join(epochDir, "blocks_000000.ndjson"))
+	require.Equal(t, 2, len(blocks))
+	assert.Equal(t, "6831", blocks[0]["headerHash"])
+	assert.Equal(t, "6832", blocks[1]["headerHash"])
+	assert.Equal(t, 4, len(readRecords(t, filepath.Join(epochDir, "transactions_000000.ndjson"))))
+
+	_, err = os.Stat(filepath.Join(epochDir, "rewards_000000.ndjson"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFileDriver_WriteAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+	assert.Nil(t, driver.Close())
+
+	err := driver.SaveBlock(createSaveBlockArgs("h1", 1, 0))
+	assert.Equal(t, ErrDriverClosed, err)
+}
@@ -0,0 +1,78 @@
+package filedriver
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport/blockdata"
+)
+
+const (
+	tableBlocks               = "blocks"
+	tableTransactions         = "transactions"
+	tableSmartContractResults = "smartContractResults"
+	tableRewards              = "rewards"
+	tableInvalidTransactions  = "invalidTransactions"
+	tableReceipts             = "receipts"
+	tableLogs                 = "logs"
+	tableAccounts             = "accounts"
+	tableReverts              = "reverts"
+)
+
+var allTables = []string{
+	tableBlocks,
+	tableTransactions,
+	tableSmartContractResults,
+	tableRewards,
+	tableInvalidTransactions,
+	tableReceipts,
+	tableLogs,
+	tableAccounts,
+	tableReverts,
+}
+
+// blockRecord is the line written in the blocks table for every saved block
+type blockRecord struct {
+	*blockdata.HeaderInfo
+	Header                 data.HeaderHandler           `json:"header"`
+	Body                   data.BodyHandler             `json:"body,omitempty"`
+	SignersIndexes         []uint64                     `json:"signersIndexes,omitempty"`
+	NotarizedHeadersHashes []string                     `json:"notarizedHeadersHashes,omitempty"`
+	HeaderGasConsumption   indexer.HeaderGasConsumption `json:"headerGasConsumption"`
+}
+
+// transactionRecord is the line written for every transaction, smart contract result, reward, invalid
+// transaction or receipt of a saved block
+type transactionRecord struct {
+	BlockHash   string                  `json:"blockHash"`
+	BlockNonce  uint64                  `json:"blockNonce"`
+	Epoch       uint32                  `json:"epoch"`
+	ShardID     uint32                  `json:"shardID"`
+	Hash        string                  `json:"hash"`
+	Transaction data.TransactionHandler `json:"transaction"`
+}
+
+// logRecord is the line written for every transaction log of a saved block
+type logRecord struct {
+	BlockHash  string          `json:"blockHash"`
+	BlockNonce uint64          `json:"blockNonce"`
+	Epoch      uint32          `json:"epoch"`
+	ShardID    uint32          `json:"shardID"`
+	TxHash     string          `json:"txHash"`
+	Log        data.LogHandler `json:"log"`
+}
+
+// accountRecord is the line written for every account provided through SaveAccounts
+type accountRecord struct {
+	BlockTimestamp uint64 `json:"blockTimestamp"`
+	Epoch          uint32 `json:"epoch"`
+	Address        string `json:"address"`
+	Nonce          uint64 `json:"nonce"`
+	Balance        string `json:"balance"`
+}
+
+// revertRecord marks a block previously written in the blocks table as reverted. The consumers should discard
+// the block, together with its transactions and logs, when a revert record with the same header hash follows it
+type revertRecord struct {
+	*blockdata.HeaderInfo
+	Reverted bool `json:"reverted"`
+}
@@ -0,0 +1,199 @@
+package filedriver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const fileExtension = ".ndjson"
+
+// rotatingFile appends newline delimited records to <dir>/<table>_<sequence>.ndjson files, moving to the next
+// sequence once the current file would exceed the maximum size
+type rotatingFile struct {
+	dir         string
+	table       string
+	maxFileSize uint64
+	sequence    int
+	file        *os.File
+	writer      *bufio.Writer
+	size        uint64
+}
+
+func newRotatingFile(dir string, table string, maxFileSize uint64) (*rotatingFile, error) {
+	rf := &rotatingFile{
+		dir:         dir,
+		table:       table,
+		maxFileSize: maxFileSize,
+	}
+
+	sequence, err := rf.lastSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rf.open(sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	// a file which does not end with a complete record was interrupted by a crash, appending to it would glue
+	// the next record to the partial one
+	endsWithNewLine, err := rf.endsWithNewLine()
+	if err != nil {
+		return nil, err
+	}
+	if rf.size >= rf.maxFileSize || !endsWithNewLine {
+		err = rf.rotate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rf, nil
+}
+
+func fileName(table string, sequence int) string {
+	return fmt.Sprintf("%s_%06d%s", table, sequence, fileExtension)
+}
+
+// listSequences returns the sorted sequences of the files written for the table in the provided directory
+func listSequences(dir string, table string) ([]int, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, table+"_*"+fileExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]int, 0, len(matches))
+	for _, match := range matches {
+		name := strings.TrimSuffix(filepath.Base(match), fileExtension)
+		sequence, errConvert := strconv.Atoi(strings.TrimPrefix(name, table+"_"))
+		if errConvert != nil {
+			continue
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	sort.Ints(sequences)
+
+	return sequences, nil
+}
+
+func (rf *rotatingFile) lastSequence() (int, error) {
+	sequences, err := listSequences(rf.dir, rf.table)
+	if err != nil {
+		return 0, err
+	}
+	if len(sequences) == 0 {
+		return 0, nil
+	}
+
+	return sequences[len(sequences)-1], nil
+}
+
+func (rf *rotatingFile) open(sequence int) error {
+	file, err := os.OpenFile(filepath.Join(rf.dir, fileName(rf.table, sequence)), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	rf.sequence = sequence
+	rf.file = file
+	rf.writer = bufio.NewWriter(file)
+	rf.size = uint64(info.Size())
+
+	return nil
+}
+
+func (rf *rotatingFile) endsWithNewLine() (bool, error) {
+	if rf.size == 0 {
+		return true, nil
+	}
+
+	lastByte := make([]byte, 1)
+	_, err := rf.file.ReadAt(lastByte, int64(rf.size)-1)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	return lastByte[0] == '\n', nil
+}
+
+func (rf *rotatingFile) rotate() error {
+	err := rf.close()
+	if err != nil {
+		return err
+	}
+
+	return rf.open(rf.sequence + 1)
+}
+
+// write appends one record, the new line delimiter being added by this function
+func (rf *rotatingFile) write(record []byte) error {
+	recordSize := uint64(len(record)) + 1
+	if rf.size > 0 && rf.size+recordSize > rf.maxFileSize {
+		err := rf.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := rf.writer.Write(record)
+	if err != nil {
+		return err
+	}
+	err = rf.writer.WriteByte('\n')
+	if err != nil {
+		return err
+	}
+
+	rf.size += recordSize
+
+	return nil
+}
+
+// flush hands the buffered records to the operating system
+func (rf *rotatingFile) flush() error {
+	return rf.writer.Flush()
+}
+
+// sync commits the flushed records to the disk
+func (rf *rotatingFile) sync() error {
+	return rf.file.Sync()
+}
+
+// position returns the end of the records written so far
+func (rf *rotatingFile) position() filePosition {
+	return filePosition{
+		Sequence: rf.sequence,
+		Size:     rf.size,
+	}
+}
+
+func (rf *rotatingFile) close() error {
+	err := rf.writer.Flush()
+	if err != nil {
+		_ = rf.file.Close()
+		return err
+	}
+
+	err = rf.file.Sync()
+	if err != nil {
+		_ = rf.file.Close()
+		return err
+	}
+
+	return rf.file.Close()
+}
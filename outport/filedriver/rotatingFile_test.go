@@ -0,0 +1,84 @@
+package filedriver
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile_RotatesWhenMaxSizeIsReached(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rf, err := newRotatingFile(dir, "blocks", 10)
+	require.Nil(t, err)
+
+	require.Nil(t, rf.write([]byte("aaaa")))
+	require.Nil(t, rf.write([]byte("bbbb")))
+	require.Nil(t, rf.write([]byte("cccc")))
+	require.Nil(t, rf.close())
+
+	buff, err := ioutil.ReadFile(filepath.Join(dir, "blocks_000000.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "aaaa\nbbbb\n", string(buff))
+
+	buff, err = ioutil.ReadFile(filepath.Join(dir, "blocks_000001.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "cccc\n", string(buff))
+}
+
+func TestRotatingFile_RecordLargerThanMaxSizeIsWrittenAlone(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rf, err := newRotatingFile(dir, "blocks", 4)
+	require.Nil(t, err)
+
+	require.Nil(t, rf.write([]byte("large record")))
+	require.Nil(t, rf.write([]byte("a")))
+	require.Nil(t, rf.close())
+
+	buff, err := ioutil.ReadFile(filepath.Join(dir, "blocks_000000.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "large record\n", string(buff))
+
+	buff, err = ioutil.ReadFile(filepath.Join(dir, "blocks_000001.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "a\n", string(buff))
+}
+
+func TestRotatingFile_ReopenAppendsToTheLastFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blocks_000000.ndjson"), []byte("a\n"), 0644))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blocks_000003.ndjson"), []byte("b\n"), 0644))
+
+	rf, err := newRotatingFile(dir, "blocks", 100)
+	require.Nil(t, err)
+	require.Nil(t, rf.write([]byte("c")))
+	require.Nil(t, rf.close())
+
+	buff, err := ioutil.ReadFile(filepath.Join(dir, "blocks_000003.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "b\nc\n", string(buff))
+}
+
+func TestRotatingFile_ReopenAfterPartialRecordStartsNewFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blocks_000000.ndjson"), []byte("a\npartial"), 0644))
+
+	rf, err := newRotatingFile(dir, "blocks", 100)
+	require.Nil(t, err)
+	require.Nil(t, rf.write([]byte("b")))
+	require.Nil(t, rf.close())
+
+	buff, err := ioutil.ReadFile(filepath.Join(dir, "blocks_000001.ndjson"))
+	require.Nil(t, err)
+	assert.Equal(t, "b\n", string(buff))
+}
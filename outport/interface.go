@@ -1,6 +1,8 @@
 package outport
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 )
@@ -31,6 +33,7 @@ type OutportHandler interface {
 	FinalizedBlock(headerHash []byte)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
+	GetRetryingDuration() time.Duration
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -20,6 +20,8 @@ type outport struct {
 	drivers         []Driver
 	retrialInterval time.Duration
 	chanClose       chan struct{}
+	mutRetrying     sync.RWMutex
+	retryingSince   map[Driver]time.Time
 }
 
 // NewOutport will create a new instance of proxy
@@ -33,6 +35,7 @@ func NewOutport(retrialInterval time.Duration) (*outport, error) {
 		mutex:           sync.RWMutex{},
 		retrialInterval: retrialInterval,
 		chanClose:       make(chan struct{}),
+		retryingSince:   make(map[Driver]time.Time),
 	}, nil
 }
 
@@ -50,9 +53,11 @@ func (o *outport) saveBlockBlocking(args *indexer.ArgsSaveBlockData, driver Driv
 	for {
 		err := driver.SaveBlock(args)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling SaveBlock, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -87,9 +92,11 @@ func (o *outport) revertIndexedBlockBlocking(header data.HeaderHandler, body dat
 	for {
 		err := driver.RevertIndexedBlock(header, body)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling RevertIndexedBlock, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -115,9 +122,11 @@ func (o *outport) saveRoundsInfoBlocking(roundsInfo []*indexer.RoundInfo, driver
 	for {
 		err := driver.SaveRoundsInfo(roundsInfo)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling SaveRoundsInfo, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -143,9 +152,11 @@ func (o *outport) saveValidatorsPubKeysBlocking(validatorsPubKeys map[uint32][][
 	for {
 		err := driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling SaveValidatorsPubKeys, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -171,9 +182,11 @@ func (o *outport) saveValidatorsRatingBlocking(indexID string, infoRating []*ind
 	for {
 		err := driver.SaveValidatorsRating(indexID, infoRating)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling SaveValidatorsRating, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -199,9 +212,11 @@ func (o *outport) saveAccountsBlocking(blockTimestamp uint64, acc []data.UserAcc
 	for {
 		err := driver.SaveAccounts(blockTimestamp, acc)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling SaveAccounts, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -227,9 +242,11 @@ func (o *outport) finalizedBlockBlocking(headerHash []byte, driver Driver) {
 	for {
 		err := driver.FinalizedBlock(headerHash)
 		if err == nil {
+			o.clearRetrying(driver)
 			return
 		}
 
+		o.markRetrying(driver)
 		log.Error("error calling FinalizedBlock, will retry",
 			"driver", driverString(driver),
 			"retrial in", o.retrialInterval,
@@ -261,6 +278,40 @@ func (o *outport) Close() error {
 	return err
 }
 
+// markRetrying records the moment the driver started failing, if it was not already retrying
+func (o *outport) markRetrying(driver Driver) {
+	o.mutRetrying.Lock()
+	defer o.mutRetrying.Unlock()
+
+	_, isRetrying := o.retryingSince[driver]
+	if !isRetrying {
+		o.retryingSince[driver] = time.Now()
+	}
+}
+
+func (o *outport) clearRetrying(driver Driver) {
+	o.mutRetrying.Lock()
+	delete(o.retryingSince, driver)
+	o.mutRetrying.Unlock()
+}
+
+// GetRetryingDuration returns for how long the outport has been retrying to push data to a driver, the driver retrying
+// for the longest time being considered. Returns 0 if the last call of each driver succeeded
+func (o *outport) GetRetryingDuration() time.Duration {
+	o.mutRetrying.RLock()
+	defer o.mutRetrying.RUnlock()
+
+	var longestDuration time.Duration
+	for _, retryingSince := range o.retryingSince {
+		duration := time.Since(retryingSince)
+		if duration > longestDuration {
+			longestDuration = duration
+		}
+	}
+
+	return longestDuration
+}
+
 // HasDrivers returns true if there is at least one driver in the outport
 func (o *outport) HasDrivers() bool {
 	o.mutex.RLock()
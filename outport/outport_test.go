@@ -95,6 +95,65 @@ func TestOutport_SaveBlock(t *testing.T) {
 	assert.Equal(t, 1, numCalled2)
 }
 
+func TestOutport_GetRetryingDuration(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	numCalled := 0
+	var outportHandler *outport
+	var retryingDuration time.Duration
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			numCalled++
+			if numCalled < 3 {
+				return expectedError
+			}
+
+			retryingDuration = outportHandler.GetRetryingDuration()
+			return nil
+		},
+	}
+	outportHandler, _ = NewOutport(minimumRetrialInterval)
+	_ = outportHandler.SubscribeDriver(driver)
+	assert.Equal(t, time.Duration(0), outportHandler.GetRetryingDuration())
+
+	outportHandler.SaveBlock(nil)
+	assert.True(t, retryingDuration >= minimumRetrialInterval)
+	assert.Equal(t, time.Duration(0), outportHandler.GetRetryingDuration())
+}
+
+func TestOutport_GetRetryingDurationShouldNotBeClearedByTheSuccessOfOtherDriver(t *testing.T) {
+	t.Parallel()
+
+	chanRetrying := make(chan struct{}, 1)
+	failingDriver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			select {
+			case chanRetrying <- struct{}{}:
+			default:
+			}
+			return errors.New("driver unavailable")
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	_ = outportHandler.SubscribeDriver(&mock.DriverStub{})
+	_ = outportHandler.SubscribeDriver(failingDriver)
+
+	chanDone := make(chan struct{})
+	go func() {
+		outportHandler.SaveBlock(nil)
+		close(chanDone)
+	}()
+	<-chanRetrying
+
+	outportHandler.FinalizedBlock([]byte("hash"))
+	time.Sleep(minimumRetrialInterval * 2)
+	assert.True(t, outportHandler.GetRetryingDuration() > 0)
+
+	_ = outportHandler.Close()
+	<-chanDone
+}
+
 func TestOutport_SaveRoundsInfo(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,33 @@
+package queue
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrEmptyName signals that an empty driver name has been provided
+var ErrEmptyName = errors.New("empty driver name")
+
+// ErrInvalidMaxQueueSize signals that an invalid maximum queue size has been provided
+var ErrInvalidMaxQueueSize = errors.New("invalid maximum queue size")
+
+// ErrInvalidMaxDrainWait signals that an invalid maximum drain wait has been provided
+var ErrInvalidMaxDrainWait = errors.New("invalid maximum drain wait")
+
+// ErrQueueNotDrained signals that the queue was not drained in the maximum drain wait
+var ErrQueueNotDrained = errors.New("outport queue not drained")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval has been provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrQueueClosed signals that the queue has been closed
+var ErrQueueClosed = errors.New("outport queue closed")
+
+// ErrUnknownOperation signals that a queue entry holds an unknown operation
+var ErrUnknownOperation = errors.New("unknown operation")
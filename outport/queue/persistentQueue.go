@@ -0,0 +1,175 @@
+package queue
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var headKey = []byte("head")
+var tailKey = []byte("tail")
+
+const entryKeyPrefix = "entry_"
+
+// persistentQueue is a FIFO of encoded entries kept in a persister. The head is the index of the oldest
+// entry not yet delivered while the tail is the index the next entry will be written at
+type persistentQueue struct {
+	mut              sync.RWMutex
+	persister        storage.Persister
+	head             uint64
+	tail             uint64
+	chanQueueChanged chan struct{}
+}
+
+func newPersistentQueue(persister storage.Persister) (*persistentQueue, error) {
+	head, err := readIndex(persister, headKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tail, err := readIndex(persister, tailKey)
+	if err != nil {
+		return nil, err
+	}
+	if tail < head {
+		tail = head
+	}
+
+	return &persistentQueue{
+		persister:        persister,
+		head:             head,
+		tail:             tail,
+		chanQueueChanged: make(chan struct{}),
+	}, nil
+}
+
+func readIndex(persister storage.Persister, key []byte) (uint64, error) {
+	if persister.Has(key) != nil {
+		return 0, nil
+	}
+
+	buff, err := persister.Get(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func encodeIndex(index uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, index)
+
+	return buff
+}
+
+func entryKey(index uint64) []byte {
+	return append([]byte(entryKeyPrefix), encodeIndex(index)...)
+}
+
+// push appends an entry at the tail of the queue. If the queue already holds maxSize entries, the oldest ones are
+// dropped to make room for the new entry. It returns the number of dropped entries
+func (pq *persistentQueue) push(buff []byte, maxSize uint64) (uint64, error) {
+	pq.mut.Lock()
+	defer pq.mut.Unlock()
+
+	numDropped := uint64(0)
+	if pq.tail-pq.head >= maxSize {
+		numDropped = pq.tail - pq.head - maxSize + 1
+		err := pq.removeFromHead(numDropped)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err := pq.persister.Put(entryKey(pq.tail), buff)
+	if err != nil {
+		return numDropped, err
+	}
+
+	err = pq.persister.Put(tailKey, encodeIndex(pq.tail+1))
+	if err != nil {
+		return numDropped, err
+	}
+
+	pq.tail++
+	pq.notifyChange()
+
+	return numDropped, nil
+}
+
+// peek returns the entry at the head of the queue along with its index. If the queue is empty, it returns a nil
+// entry and a channel that will be closed on the next change of the queue
+func (pq *persistentQueue) peek() ([]byte, uint64, <-chan struct{}, error) {
+	pq.mut.RLock()
+	defer pq.mut.RUnlock()
+
+	if pq.head == pq.tail {
+		return nil, 0, pq.chanQueueChanged, nil
+	}
+
+	buff, err := pq.persister.Get(entryKey(pq.head))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return buff, pq.head, nil, nil
+}
+
+// pop removes the entry with the provided index if it is still at the head of the queue. The entry might have
+// already been dropped by a push on a full queue, in which case the call is a no-operation
+func (pq *persistentQueue) pop(index uint64) error {
+	pq.mut.Lock()
+	defer pq.mut.Unlock()
+
+	if pq.head == pq.tail || pq.head != index {
+		return nil
+	}
+
+	err := pq.removeFromHead(1)
+	if err != nil {
+		return err
+	}
+
+	pq.notifyChange()
+
+	return nil
+}
+
+// removeFromHead persists the new head first, so a crash in between leaves only orphan entries behind, never
+// a head pointing to removed entries
+func (pq *persistentQueue) removeFromHead(numEntries uint64) error {
+	newHead := pq.head + numEntries
+	err := pq.persister.Put(headKey, encodeIndex(newHead))
+	if err != nil {
+		return err
+	}
+
+	for index := pq.head; index < newHead; index++ {
+		err = pq.persister.Remove(entryKey(index))
+		if err != nil {
+			log.Debug("persistentQueue: remove entry", "index", index, "error", err.Error())
+		}
+	}
+	pq.head = newHead
+
+	return nil
+}
+
+// len returns the number of entries in the queue and a channel that will be closed on the next change of the queue
+func (pq *persistentQueue) len() (uint64, <-chan struct{}) {
+	pq.mut.RLock()
+	defer pq.mut.RUnlock()
+
+	return pq.tail - pq.head, pq.chanQueueChanged
+}
+
+func (pq *persistentQueue) notifyChange() {
+	close(pq.chanQueueChanged)
+	pq.chanQueueChanged = make(chan struct{})
+}
+
+func (pq *persistentQueue) close() error {
+	return pq.persister.Close()
+}
@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pushEntry(t *testing.T, pq *persistentQueue, entry string, maxSize uint64) {
+	numDropped, err := pq.push([]byte(entry), maxSize)
+	require.Nil(t, err)
+	require.Zero(t, numDropped)
+}
+
+func TestPersistentQueue_PushPeekPop(t *testing.T) {
+	t.Parallel()
+
+	pq, err := newPersistentQueue(memorydb.New())
+	require.Nil(t, err)
+
+	buff, _, chanQueueChanged, err := pq.peek()
+	assert.Nil(t, err)
+	assert.Nil(t, buff)
+	assert.NotNil(t, chanQueueChanged)
+
+	pushEntry(t, pq, "a", 10)
+	pushEntry(t, pq, "b", 10)
+
+	select {
+	case <-chanQueueChanged:
+	default:
+		assert.Fail(t, "the change channel should have been closed")
+	}
+
+	numEntries, _ := pq.len()
+	assert.Equal(t, uint64(2), numEntries)
+
+	buff, index, _, err := pq.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a"), buff)
+	assert.Equal(t, uint64(0), index)
+
+	require.Nil(t, pq.pop(index))
+	buff, index, _, err = pq.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), buff)
+	assert.Equal(t, uint64(1), index)
+
+	require.Nil(t, pq.pop(index))
+	numEntries, _ = pq.len()
+	assert.Equal(t, uint64(0), numEntries)
+
+	// popping an empty queue is a no-operation
+	assert.Nil(t, pq.pop(index))
+}
+
+func TestPersistentQueue_PushOnFullQueueShouldDropTheOldestEntries(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	pq, err := newPersistentQueue(persister)
+	require.Nil(t, err)
+
+	pushEntry(t, pq, "a", 2)
+	pushEntry(t, pq, "b", 2)
+
+	numDropped, err := pq.push([]byte("c"), 2)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), numDropped)
+	assert.NotNil(t, persister.Has(entryKey(0)))
+
+	numEntries, _ := pq.len()
+	assert.Equal(t, uint64(2), numEntries)
+
+	buff, index, _, err := pq.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), buff)
+	assert.Equal(t, uint64(1), index)
+
+	// a smaller maximum size drops all the entries over it
+	numDropped, err = pq.push([]byte("d"), 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), numDropped)
+
+	buff, _, _, err = pq.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("d"), buff)
+}
+
+func TestPersistentQueue_PopOfDroppedEntryShouldNotRemoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	pq, err := newPersistentQueue(memorydb.New())
+	require.Nil(t, err)
+
+	pushEntry(t, pq, "a", 1)
+	_, index, _, _ := pq.peek()
+
+	// the entry being delivered is dropped in the meantime
+	numDropped, err := pq.push([]byte("b"), 1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), numDropped)
+	require.Nil(t, pq.pop(index))
+
+	numEntries, _ := pq.len()
+	assert.Equal(t, uint64(1), numEntries)
+
+	buff, _, _, err := pq.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), buff)
+}
+
+func TestPersistentQueue_ReloadFromPersister(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	pq, err := newPersistentQueue(persister)
+	require.Nil(t, err)
+
+	pushEntry(t, pq, "a", 10)
+	pushEntry(t, pq, "b", 10)
+	pushEntry(t, pq, "c", 10)
+	require.Nil(t, pq.pop(0))
+
+	reloaded, err := newPersistentQueue(persister)
+	require.Nil(t, err)
+
+	numEntries, _ := reloaded.len()
+	assert.Equal(t, uint64(2), numEntries)
+
+	buff, _, _, err := reloaded.peek()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), buff)
+}
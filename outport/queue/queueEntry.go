@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+type operation uint8
+
+const (
+	operationSaveBlock operation = iota + 1
+	operationRevertIndexedBlock
+	operationSaveRoundsInfo
+	operationSaveValidatorsPubKeys
+	operationSaveValidatorsRating
+	operationFinalizedBlock
+)
+
+// queueEntry holds the arguments of one driver call. The outport arguments carry interfaces (headers, bodies,
+// transactions, logs) so the entries are gob encoded: gob is able to restore the concrete types of the
+// registered implementations
+type queueEntry struct {
+	Operation         operation
+	SaveBlockArgs     *indexer.ArgsSaveBlockData
+	Header            data.HeaderHandler
+	Body              data.BodyHandler
+	RoundsInfo        []*indexer.RoundInfo
+	ValidatorsPubKeys map[uint32][][]byte
+	Epoch             uint32
+	IndexID           string
+	ValidatorsRating  []*indexer.ValidatorRatingInfo
+	HeaderHash        []byte
+}
+
+func init() {
+	gob.RegisterName("block.Header", &block.Header{})
+	gob.RegisterName("block.HeaderV2", &block.HeaderV2{})
+	gob.RegisterName("block.MetaBlock", &block.MetaBlock{})
+	gob.RegisterName("block.Body", &block.Body{})
+	gob.RegisterName("transaction.Transaction", &transaction.Transaction{})
+	gob.RegisterName("transaction.Log", &transaction.Log{})
+	gob.RegisterName("smartContractResult.SmartContractResult", &smartContractResult.SmartContractResult{})
+	gob.RegisterName("rewardTx.RewardTx", &rewardTx.RewardTx{})
+	gob.RegisterName("receipt.Receipt", &receipt.Receipt{})
+}
+
+func encodeEntry(entry *queueEntry) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	err := gob.NewEncoder(buff).Encode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func decodeEntry(buff []byte) (*queueEntry, error) {
+	entry := &queueEntry{}
+	err := gob.NewDecoder(bytes.NewReader(buff)).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,354 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ outport.Driver = (*queuedDriver)(nil)
+
+var log = logger.GetOrCreate("outport/queue")
+
+const minimumRetrialInterval = time.Millisecond * 10
+
+// ArgsQueuedDriver holds the arguments needed to create a new queued driver
+type ArgsQueuedDriver struct {
+	Name            string
+	Driver          outport.Driver
+	Persister       storage.Persister
+	StatusHandler   core.AppStatusHandler
+	MaxQueueSize    uint64
+	MaxDrainWait    time.Duration
+	RetrialInterval time.Duration
+}
+
+// queuedDriver places a write-ahead queue, kept in a persister, in front of an outport driver. The outport calls
+// only append entries to the queue while a separate go routine delivers them to the wrapped driver, retrying
+// on errors. The driver can lag behind the node up to the maximum queue size and resumes, after a restart, with
+// the first entry that was not delivered. When the queue is full the oldest entries are dropped, so a lagging
+// driver never stalls the block processing, and the dropped entries are counted in a metric
+type queuedDriver struct {
+	name              string
+	driver            outport.Driver
+	queue             *persistentQueue
+	statusHandler     core.AppStatusHandler
+	maxQueueSize      uint64
+	maxDrainWait      time.Duration
+	retrialInterval   time.Duration
+	lagMetric         string
+	droppedMetric     string
+	undecodableMetric string
+	mutDriver         sync.Mutex
+	cancel            context.CancelFunc
+	chanLoopDone      chan struct{}
+}
+
+// NewQueuedDriver creates a new queued driver and starts delivering the already persisted entries
+func NewQueuedDriver(args ArgsQueuedDriver) (*queuedDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := newPersistentQueue(args.Persister)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	qd := &queuedDriver{
+		name:              args.Name,
+		driver:            args.Driver,
+		queue:             queue,
+		statusHandler:     args.StatusHandler,
+		maxQueueSize:      args.MaxQueueSize,
+		maxDrainWait:      args.MaxDrainWait,
+		retrialInterval:   args.RetrialInterval,
+		lagMetric:         fmt.Sprintf("%s_%s", common.MetricOutportQueueLag, args.Name),
+		droppedMetric:     fmt.Sprintf("%s_%s", common.MetricOutportQueueDropped, args.Name),
+		undecodableMetric: fmt.Sprintf("%s_%s", common.MetricOutportQueueUndecodable, args.Name),
+		cancel:            cancel,
+		chanLoopDone:      make(chan struct{}),
+	}
+
+	lag, _ := queue.len()
+	log.Debug("outport queue loaded", "driver", args.Name, "num entries", lag)
+	qd.updateLagMetric()
+
+	go qd.processLoop(ctx)
+
+	return qd, nil
+}
+
+func checkArgs(args ArgsQueuedDriver) error {
+	if len(args.Name) == 0 {
+		return ErrEmptyName
+	}
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Persister) {
+		return ErrNilPersister
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.MaxQueueSize == 0 {
+		return ErrInvalidMaxQueueSize
+	}
+	if args.MaxDrainWait <= 0 {
+		return ErrInvalidMaxDrainWait
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+
+	return nil
+}
+
+// SaveBlock appends the save block call to the queue
+func (qd *queuedDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	return qd.enqueue(&queueEntry{
+		Operation:     operationSaveBlock,
+		SaveBlockArgs: args,
+	}, func() error {
+		return qd.driver.SaveBlock(args)
+	})
+}
+
+// RevertIndexedBlock appends the revert call to the queue
+func (qd *queuedDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	return qd.enqueue(&queueEntry{
+		Operation: operationRevertIndexedBlock,
+		Header:    header,
+		Body:      body,
+	}, func() error {
+		return qd.driver.RevertIndexedBlock(header, body)
+	})
+}
+
+// SaveRoundsInfo appends the save rounds info call to the queue
+func (qd *queuedDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return qd.enqueue(&queueEntry{
+		Operation:  operationSaveRoundsInfo,
+		RoundsInfo: roundsInfos,
+	}, func() error {
+		return qd.driver.SaveRoundsInfo(roundsInfos)
+	})
+}
+
+// SaveValidatorsPubKeys appends the save validators public keys call to the queue
+func (qd *queuedDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return qd.enqueue(&queueEntry{
+		Operation:         operationSaveValidatorsPubKeys,
+		ValidatorsPubKeys: validatorsPubKeys,
+		Epoch:             epoch,
+	}, func() error {
+		return qd.driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	})
+}
+
+// SaveValidatorsRating appends the save validators rating call to the queue
+func (qd *queuedDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return qd.enqueue(&queueEntry{
+		Operation:        operationSaveValidatorsRating,
+		IndexID:          indexID,
+		ValidatorsRating: infoRating,
+	}, func() error {
+		return qd.driver.SaveValidatorsRating(indexID, infoRating)
+	})
+}
+
+// SaveAccounts calls the driver directly, after the queue is drained, as the accounts can not be persisted. It
+// returns an error if the queue is not drained in the maximum drain wait
+func (qd *queuedDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return qd.callWhenDrained(func() error {
+		return qd.driver.SaveAccounts(blockTimestamp, acc)
+	})
+}
+
+// FinalizedBlock appends the finalized block call to the queue
+func (qd *queuedDriver) FinalizedBlock(headerHash []byte) error {
+	return qd.enqueue(&queueEntry{
+		Operation:  operationFinalizedBlock,
+		HeaderHash: headerHash,
+	}, func() error {
+		return qd.driver.FinalizedBlock(headerHash)
+	})
+}
+
+// enqueue persists the entry. If the entry can not be encoded, the direct call is done after the queue is drained,
+// keeping the calls order, or an error is returned if the queue is not drained in the maximum drain wait
+func (qd *queuedDriver) enqueue(entry *queueEntry, directCall func() error) error {
+	buff, err := encodeEntry(entry)
+	if err != nil {
+		log.Debug("outport queue: entry can not be encoded, calling the driver directly",
+			"driver", qd.name, "error", err.Error())
+		return qd.callWhenDrained(directCall)
+	}
+
+	numDropped, err := qd.queue.push(buff, qd.maxQueueSize)
+	if numDropped > 0 {
+		log.Warn("outport queue is full, dropped the oldest entries",
+			"driver", qd.name, "num dropped", numDropped, "max queue size", qd.maxQueueSize)
+		qd.statusHandler.AddUint64(qd.droppedMetric, numDropped)
+	}
+	if err != nil {
+		return fmt.Errorf("%w for driver %s", err, qd.name)
+	}
+
+	qd.updateLagMetric()
+
+	return nil
+}
+
+func (qd *queuedDriver) callWhenDrained(directCall func() error) error {
+	timeout := time.NewTimer(qd.maxDrainWait)
+	defer timeout.Stop()
+
+	for {
+		numEntries, chanQueueChanged := qd.queue.len()
+		if numEntries == 0 {
+			break
+		}
+
+		select {
+		case <-chanQueueChanged:
+		case <-timeout.C:
+			return fmt.Errorf("%w for driver %s after %v, num entries: %d", ErrQueueNotDrained, qd.name, qd.maxDrainWait, numEntries)
+		case <-qd.chanLoopDone:
+			return ErrQueueClosed
+		}
+	}
+
+	qd.mutDriver.Lock()
+	defer qd.mutDriver.Unlock()
+
+	return directCall()
+}
+
+func (qd *queuedDriver) processLoop(ctx context.Context) {
+	defer close(qd.chanLoopDone)
+
+	for {
+		buff, index, chanQueueChanged, err := qd.queue.peek()
+		if err != nil {
+			log.Error("outport queue: can not read the next entry", "driver", qd.name, "error", err.Error())
+			if !qd.waitRetrial(ctx) {
+				return
+			}
+			continue
+		}
+		if buff == nil {
+			select {
+			case <-chanQueueChanged:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		err = qd.deliver(index, buff)
+		if err != nil {
+			log.Error("outport queue: error calling the driver, will retry",
+				"driver", qd.name,
+				"retrial in", qd.retrialInterval,
+				"error", err)
+			if !qd.waitRetrial(ctx) {
+				return
+			}
+			continue
+		}
+
+		err = qd.queue.pop(index)
+		if err != nil {
+			log.Error("outport queue: can not remove the delivered entry", "driver", qd.name, "error", err.Error())
+		}
+		qd.updateLagMetric()
+	}
+}
+
+func (qd *queuedDriver) waitRetrial(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(qd.retrialInterval):
+		return true
+	}
+}
+
+func (qd *queuedDriver) deliver(index uint64, buff []byte) error {
+	entry, err := decodeEntry(buff)
+	if err != nil {
+		// a corrupted entry can not be delivered, retrying would block the queue forever
+		log.Error("outport queue: dropping undecodable entry",
+			"driver", qd.name, "index", index, "size", len(buff), "error", err.Error())
+		qd.statusHandler.Increment(qd.undecodableMetric)
+		return nil
+	}
+
+	qd.mutDriver.Lock()
+	defer qd.mutDriver.Unlock()
+
+	switch entry.Operation {
+	case operationSaveBlock:
+		return qd.driver.SaveBlock(entry.SaveBlockArgs)
+	case operationRevertIndexedBlock:
+		return qd.driver.RevertIndexedBlock(entry.Header, entry.Body)
+	case operationSaveRoundsInfo:
+		return qd.driver.SaveRoundsInfo(entry.RoundsInfo)
+	case operationSaveValidatorsPubKeys:
+		return qd.driver.SaveValidatorsPubKeys(entry.ValidatorsPubKeys, entry.Epoch)
+	case operationSaveValidatorsRating:
+		return qd.driver.SaveValidatorsRating(entry.IndexID, entry.ValidatorsRating)
+	case operationFinalizedBlock:
+		return qd.driver.FinalizedBlock(entry.HeaderHash)
+	default:
+		log.Error("outport queue: dropping entry",
+			"driver", qd.name, "index", index, "error", ErrUnknownOperation, "operation", entry.Operation)
+		qd.statusHandler.Increment(qd.undecodableMetric)
+		return nil
+	}
+}
+
+func (qd *queuedDriver) updateLagMetric() {
+	numEntries, _ := qd.queue.len()
+	qd.statusHandler.SetUInt64Value(qd.lagMetric, numEntries)
+}
+
+// Lag returns the number of entries not yet delivered to the driver
+func (qd *queuedDriver) Lag() uint64 {
+	numEntries, _ := qd.queue.len()
+
+	return numEntries
+}
+
+// Close stops the delivery, closes the persister keeping the undelivered entries and closes the wrapped driver
+func (qd *queuedDriver) Close() error {
+	qd.cancel()
+	<-qd.chanLoopDone
+
+	errQueue := qd.queue.close()
+	errDriver := qd.driver.Close()
+	if errQueue != nil {
+		return errQueue
+	}
+
+	return errDriver
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qd *queuedDriver) IsInterfaceNil() bool {
+	return qd == nil
+}
@@ -0,0 +1,474 @@
+package queue
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = time.Second * 5
+
+func createMockArgsQueuedDriver() ArgsQueuedDriver {
+	return ArgsQueuedDriver{
+		Name:            "test",
+		Driver:          &mock.DriverStub{},
+		Persister:       memorydb.New(),
+		StatusHandler:   &statusHandler.AppStatusHandlerStub{},
+		MaxQueueSize:    100,
+		MaxDrainWait:    testTimeout,
+		RetrialInterval: minimumRetrialInterval,
+	}
+}
+
+func waitForLag(t *testing.T, qd *queuedDriver, lag uint64) {
+	deadline := time.Now().Add(testTimeout)
+	for time.Now().Before(deadline) {
+		if qd.Lag() == lag {
+			return
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+
+	require.Fail(t, "timeout waiting for the queue lag", "expected %d, got %d", lag, qd.Lag())
+}
+
+func TestNewQueuedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Name = ""
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrEmptyName, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("nil driver should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Driver = nil
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilDriver, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.Persister = nil
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilPersister, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.StatusHandler = nil
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrNilStatusHandler, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid max queue size should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.MaxQueueSize = 0
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrInvalidMaxQueueSize, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid max drain wait should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.MaxDrainWait = 0
+
+		qd, err := NewQueuedDriver(args)
+		assert.Equal(t, ErrInvalidMaxDrainWait, err)
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		args := createMockArgsQueuedDriver()
+		args.RetrialInterval = time.Millisecond
+
+		qd, err := NewQueuedDriver(args)
+		assert.True(t, errors.Is(err, ErrInvalidRetrialInterval))
+		assert.True(t, check.IfNil(qd))
+	})
+	t.Run("should work", func(t *testing.T) {
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(qd))
+		assert.Nil(t, qd.Close())
+	})
+}
+
+func TestQueuedDriver_DeliversCallsInOrder(t *testing.T) {
+	t.Parallel()
+
+	mutCalls := sync.Mutex{}
+	calls := make([]string, 0)
+	addCall := func(call string) {
+		mutCalls.Lock()
+		calls = append(calls, call)
+		mutCalls.Unlock()
+	}
+
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			addCall("saveBlock " + string(args.HeaderHash))
+			return nil
+		},
+		RevertBlockCalled: func(header data.HeaderHandler, body data.BodyHandler) error {
+			addCall("revert " + string(header.GetPrevHash()))
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos []*indexer.RoundInfo) error {
+			addCall("roundsInfo")
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+			addCall("validatorsPubKeys")
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+			addCall("validatorsRating " + indexID)
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			addCall("finalized " + string(headerHash))
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.SaveBlock(&indexer.ArgsSaveBlockData{HeaderHash: []byte("h1"), Header: &block.Header{Nonce: 1}}))
+	assert.Nil(t, qd.RevertIndexedBlock(&block.Header{PrevHash: []byte("h0")}, &block.Body{}))
+	assert.Nil(t, qd.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 1}}))
+	assert.Nil(t, qd.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk")}}, 1))
+	assert.Nil(t, qd.SaveValidatorsRating("0_1", []*indexer.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50}}))
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+
+	expectedCalls := []string{
+		"saveBlock h1",
+		"revert h0",
+		"roundsInfo",
+		"validatorsPubKeys",
+		"validatorsRating 0_1",
+		"finalized h1",
+	}
+	mutCalls.Lock()
+	assert.Equal(t, expectedCalls, calls)
+	mutCalls.Unlock()
+}
+
+func TestQueuedDriver_RetriesOnDriverError(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			if atomic.AddUint32(&numCalls, 1) < 3 {
+				return errors.New("driver unavailable")
+			}
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("hash")))
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+}
+
+func TestQueuedDriver_FullQueueShouldDropTheOldestEntries(t *testing.T) {
+	t.Parallel()
+
+	mutDelivered := sync.Mutex{}
+	delivered := make([]string, 0)
+	driverAvailable := uint32(0)
+	chanSecondEntryTried := make(chan struct{})
+	secondEntryTried := sync.Once{}
+
+	numDropped := uint64(0)
+	args := createMockArgsQueuedDriver()
+	args.MaxQueueSize = 2
+	args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+		AddUint64Handler: func(key string, value uint64) {
+			assert.Equal(t, common.MetricOutportQueueDropped+"_test", key)
+			atomic.AddUint64(&numDropped, value)
+		},
+	}
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			if atomic.LoadUint32(&driverAvailable) == 0 {
+				if string(headerHash) == "h2" {
+					secondEntryTried.Do(func() {
+						close(chanSecondEntryTried)
+					})
+				}
+				return errors.New("driver unavailable")
+			}
+
+			mutDelivered.Lock()
+			delivered = append(delivered, string(headerHash))
+			mutDelivered.Unlock()
+
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+	assert.Nil(t, qd.FinalizedBlock([]byte("h2")))
+	assert.Nil(t, qd.FinalizedBlock([]byte("h3")))
+	assert.Equal(t, uint64(2), qd.Lag())
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&numDropped))
+
+	select {
+	case <-chanSecondEntryTried:
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the delivery of the second entry")
+	}
+	atomic.StoreUint32(&driverAvailable, 1)
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+
+	mutDelivered.Lock()
+	defer mutDelivered.Unlock()
+	assert.Equal(t, []string{"h2", "h3"}, delivered)
+}
+
+func TestQueuedDriver_UndecodableEntryShouldBeSkippedAndCounted(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	require.Nil(t, persister.Put(entryKey(0), []byte("not a gob encoded entry")))
+	require.Nil(t, persister.Put(tailKey, encodeIndex(1)))
+
+	numUndecodable := uint32(0)
+	numCalls := uint32(0)
+	args := createMockArgsQueuedDriver()
+	args.Persister = persister
+	args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			assert.Equal(t, common.MetricOutportQueueUndecodable+"_test", key)
+			atomic.AddUint32(&numUndecodable, 1)
+		},
+	}
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			atomic.AddUint32(&numCalls, 1)
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numUndecodable))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestQueuedDriver_LagMetric(t *testing.T) {
+	t.Parallel()
+
+	mutValues := sync.Mutex{}
+	values := make([]uint64, 0)
+	releaseDriver := make(chan struct{})
+
+	args := createMockArgsQueuedDriver()
+	args.Name = "elastic"
+	args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			assert.Equal(t, common.MetricOutportQueueLag+"_elastic", key)
+
+			mutValues.Lock()
+			values = append(values, value)
+			mutValues.Unlock()
+		},
+	}
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			<-releaseDriver
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+	assert.Nil(t, qd.FinalizedBlock([]byte("h2")))
+	close(releaseDriver)
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+
+	mutValues.Lock()
+	defer mutValues.Unlock()
+	assert.Equal(t, []uint64{0, 1, 2, 1, 0}, values)
+}
+
+func TestQueuedDriver_SaveAccountsWaitsForTheQueueToDrain(t *testing.T) {
+	t.Parallel()
+
+	mutCalls := sync.Mutex{}
+	calls := make([]string, 0)
+	addCall := func(call string) {
+		mutCalls.Lock()
+		calls = append(calls, call)
+		mutCalls.Unlock()
+	}
+
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			time.Sleep(time.Millisecond * 50)
+			addCall("finalized")
+			return nil
+		},
+		SaveAccountsCalled: func(timestamp uint64, acc []data.UserAccountHandler) error {
+			addCall("saveAccounts")
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+	assert.Nil(t, qd.SaveAccounts(0, nil))
+	assert.Nil(t, qd.Close())
+
+	mutCalls.Lock()
+	assert.Equal(t, []string{"finalized", "saveAccounts"}, calls)
+	mutCalls.Unlock()
+}
+
+func TestQueuedDriver_SaveAccountsOnClosedQueueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			return errors.New("driver unavailable")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+
+	chanErr := make(chan error)
+	go func() {
+		chanErr <- qd.SaveAccounts(0, nil)
+	}()
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Nil(t, qd.Close())
+
+	select {
+	case err := <-chanErr:
+		assert.Equal(t, ErrQueueClosed, err)
+	case <-time.After(testTimeout):
+		assert.Fail(t, "timeout waiting for SaveAccounts to return")
+	}
+}
+
+func TestQueuedDriver_SaveAccountsOnQueueNotDrainedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver()
+	args.MaxDrainWait = time.Millisecond * 100
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			return errors.New("driver unavailable")
+		},
+		SaveAccountsCalled: func(timestamp uint64, acc []data.UserAccountHandler) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	assert.Nil(t, qd.FinalizedBlock([]byte("h1")))
+	err := qd.SaveAccounts(0, nil)
+	assert.True(t, errors.Is(err, ErrQueueNotDrained))
+	assert.Equal(t, uint64(1), qd.Lag())
+	assert.Nil(t, qd.Close())
+}
+
+func TestQueuedDriver_ResumesAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "queue")
+	persister, err := leveldb.NewSerialDB(dbPath, 1, 1, 10)
+	require.Nil(t, err)
+
+	args := createMockArgsQueuedDriver()
+	args.Persister = persister
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			return errors.New("driver unavailable")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	saveBlockArgs := &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Body:       &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx")}}}},
+		Header:     &block.Header{Nonce: 7, Round: 8},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"tx": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), Data: []byte("data")},
+			},
+			Logs: []*data.LogData{
+				{TxHash: "tx", LogHandler: &transaction.Log{Address: []byte("addr")}},
+			},
+		},
+	}
+	assert.Nil(t, qd.SaveBlock(saveBlockArgs))
+	assert.Nil(t, qd.Close())
+
+	persister, err = leveldb.NewSerialDB(dbPath, 1, 1, 10)
+	require.Nil(t, err)
+
+	var delivered *indexer.ArgsSaveBlockData
+	args.Persister = persister
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			delivered = args
+			return nil
+		},
+	}
+	qd, _ = NewQueuedDriver(args)
+
+	waitForLag(t, qd, 0)
+	assert.Nil(t, qd.Close())
+
+	require.NotNil(t, delivered)
+	assert.Equal(t, saveBlockArgs.HeaderHash, delivered.HeaderHash)
+	assert.Equal(t, saveBlockArgs.Header, delivered.Header)
+	assert.Equal(t, saveBlockArgs.Body, delivered.Body)
+	assert.Equal(t, saveBlockArgs.TransactionsPool.Txs, delivered.TransactionsPool.Txs)
+	assert.Equal(t, saveBlockArgs.TransactionsPool.Logs, delivered.TransactionsPool.Logs)
+}
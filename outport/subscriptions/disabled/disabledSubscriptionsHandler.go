@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+
+type disabledSubscriptionsHandler struct {
+}
+
+// NewDisabledSubscriptionsHandler returns a subscriptions handler that rejects all the subscriptions
+func NewDisabledSubscriptionsHandler() *disabledSubscriptionsHandler {
+	return &disabledSubscriptionsHandler{}
+}
+
+// Subscribe returns the subscriptions disabled error
+func (dsh *disabledSubscriptionsHandler) Subscribe(_ subscriptions.Filter) (*subscriptions.Subscription, error) {
+	return nil, subscriptions.ErrSubscriptionsDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *disabledSubscriptionsHandler) IsInterfaceNil() bool {
+	return dsh == nil
+}
@@ -0,0 +1,27 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrEmptyFilter signals that a subscription without any topic has been requested
+var ErrEmptyFilter = errors.New("empty subscription filter")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrSubscriberTooSlow signals that the subscription was dropped because the subscriber did not consume its events
+var ErrSubscriberTooSlow = errors.New("subscriber too slow, events buffer full")
+
+// ErrHubClosed signals that the subscriptions hub has been closed
+var ErrHubClosed = errors.New("subscriptions hub closed")
+
+// ErrSubscriptionsDisabled signals that the subscriptions are not enabled on this node
+var ErrSubscriptionsDisabled = errors.New("subscriptions are disabled")
@@ -0,0 +1,57 @@
+package subscriptions
+
+const (
+	// TopicBlocks is the topic of the events emitted when a block is committed
+	TopicBlocks = "blocks"
+	// TopicFinalizedBlocks is the topic of the events emitted when a block is finalized
+	TopicFinalizedBlocks = "finalizedBlocks"
+	// TopicTransactions is the topic of the events emitted when the status of a subscribed transaction changes
+	TopicTransactions = "transactions"
+	// TopicAddressEvents is the topic of the smart contract events generated by a subscribed address
+	TopicAddressEvents = "addressEvents"
+
+	// TxStatusExecuted is the status of a transaction included in a committed block
+	TxStatusExecuted = "executed"
+	// TxStatusInvalid is the status of a transaction included in a committed block as invalid
+	TxStatusInvalid = "invalid"
+	// TxStatusReverted is the status of a transaction whose block has been reverted
+	TxStatusReverted = "reverted"
+	// TxStatusFinalized is the status of a transaction whose block has been finalized
+	TxStatusFinalized = "finalized"
+)
+
+// Filter holds the topics a subscriber is interested in
+type Filter struct {
+	Blocks          bool
+	FinalizedBlocks bool
+	TxHashes        [][]byte
+	Addresses       [][]byte
+}
+
+// Event is the message delivered to the subscribers
+type Event struct {
+	Topic      string      `json:"topic"`
+	Nonce      uint64      `json:"nonce"`
+	HeaderHash string      `json:"headerHash,omitempty"`
+	Data       interface{} `json:"data"`
+}
+
+// FinalizedBlockData is the payload of a finalized block event
+type FinalizedBlockData struct {
+	HeaderHash string `json:"headerHash"`
+}
+
+// TransactionData is the payload of a transaction status event
+type TransactionData struct {
+	TxHash string `json:"txHash"`
+	Status string `json:"status"`
+}
+
+// AddressEventData is the payload of an address event
+type AddressEventData struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
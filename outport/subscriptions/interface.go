@@ -0,0 +1,7 @@
+package subscriptions
+
+// SubscriptionsHandler defines the actions of the component registering the subscriptions made through the API
+type SubscriptionsHandler interface {
+	Subscribe(filter Filter) (*Subscription, error)
+	IsInterfaceNil() bool
+}
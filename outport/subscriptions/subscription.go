@@ -0,0 +1,93 @@
+package subscriptions
+
+import (
+	"sync"
+)
+
+// Subscription delivers the events matching its filter. The events channel is closed when the subscription is
+// closed, either by the subscriber or by the hub, case in which Err returns the reason
+type Subscription struct {
+	id              uint64
+	blocks          bool
+	finalizedBlocks bool
+	txHashes        map[string]struct{}
+	addresses       map[string]struct{}
+	events          chan *Event
+	hub             *subscriptionsHub
+
+	mutErr sync.RWMutex
+	err    error
+}
+
+func newSubscription(id uint64, filter Filter, bufferSize int, hub *subscriptionsHub) *Subscription {
+	s := &Subscription{
+		id:              id,
+		blocks:          filter.Blocks,
+		finalizedBlocks: filter.FinalizedBlocks,
+		txHashes:        make(map[string]struct{}, len(filter.TxHashes)),
+		addresses:       make(map[string]struct{}, len(filter.Addresses)),
+		events:          make(chan *Event, bufferSize),
+		hub:             hub,
+	}
+	for _, txHash := range filter.TxHashes {
+		s.txHashes[string(txHash)] = struct{}{}
+	}
+	for _, address := range filter.Addresses {
+		s.addresses[string(address)] = struct{}{}
+	}
+
+	return s
+}
+
+// Events returns the channel the matching events are delivered on
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Err returns the reason the hub closed the subscription, if any
+func (s *Subscription) Err() error {
+	s.mutErr.RLock()
+	defer s.mutErr.RUnlock()
+
+	return s.err
+}
+
+// Close unregisters the subscription from the hub
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s, nil)
+}
+
+func (s *Subscription) isWatchingTx(txHash []byte) bool {
+	_, found := s.txHashes[string(txHash)]
+	return found
+}
+
+func (s *Subscription) isWatchingAddress(address []byte) bool {
+	_, found := s.addresses[string(address)]
+	return found
+}
+
+func (s *Subscription) wants(event *Event, key []byte) bool {
+	switch event.Topic {
+	case TopicBlocks:
+		return s.blocks
+	case TopicFinalizedBlocks:
+		return s.finalizedBlocks
+	case TopicTransactions:
+		return s.isWatchingTx(key)
+	case TopicAddressEvents:
+		return s.isWatchingAddress(key)
+	default:
+		return false
+	}
+}
+
+// closeWithError closes the events channel. It is only called by the hub, under its lock, so no event
+// is sent on the closed channel
+func (s *Subscription) closeWithError(err error) {
+	s.mutErr.Lock()
+	s.err = err
+	s.mutErr.Unlock()
+
+	close(s.events)
+}
@@ -0,0 +1,377 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/blockdata"
+)
+
+var _ outport.Driver = (*subscriptionsHub)(nil)
+var _ SubscriptionsHandler = (*subscriptionsHub)(nil)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+// ArgsSubscriptionsHub holds the arguments needed to create a new subscriptions hub
+type ArgsSubscriptionsHub struct {
+	PubKeyConverter      core.PubkeyConverter
+	MaxSubscribers       int
+	SubscriberBufferSize int
+}
+
+// maxPendingBlocks bounds the committed blocks tracked until finalization, for the case the finalization
+// notifications are not received
+const maxPendingBlocks = 1000
+
+// pendingBlock holds the nonce and the subscribed transactions of a committed block, waiting for the block to be
+// finalized or reverted
+type pendingBlock struct {
+	nonce    uint64
+	txHashes [][]byte
+}
+
+// subscriptionsHub is an in-process outport driver that fans out the committed, finalized and reverted blocks to
+// the subscribers registered through the node API. Delivering never blocks the outport: a subscriber that does not
+// consume its events fast enough is dropped.
+// The hub is fed by the outport and not by the block tracker: the block processor calls the outport on commit, on
+// finality (the finalized header hash) and on rollback, carrying the block body, the transactions pool and the logs
+// needed by the status and address events, while the notarized headers reported by the tracker only carry the headers
+// and would duplicate the finality notifications
+type subscriptionsHub struct {
+	pubKeyConverter core.PubkeyConverter
+	maxSubscribers  int
+	bufferSize      int
+
+	mut           sync.Mutex
+	nextID        uint64
+	subscriptions map[uint64]*Subscription
+	pendingBlocks map[string]*pendingBlock
+	closed        bool
+}
+
+// NewSubscriptionsHub creates a new subscriptions hub
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		pubKeyConverter: args.PubKeyConverter,
+		maxSubscribers:  args.MaxSubscribers,
+		bufferSize:      args.SubscriberBufferSize,
+		subscriptions:   make(map[uint64]*Subscription),
+		pendingBlocks:   make(map[string]*pendingBlock),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.MaxSubscribers < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+	if args.SubscriberBufferSize < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidSubscriberBufferSize, args.SubscriberBufferSize)
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscription for the provided filter
+func (hub *subscriptionsHub) Subscribe(filter Filter) (*Subscription, error) {
+	isEmpty := !filter.Blocks && !filter.FinalizedBlocks && len(filter.TxHashes) == 0 && len(filter.Addresses) == 0
+	if isEmpty {
+		return nil, ErrEmptyFilter
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	if hub.closed {
+		return nil, ErrHubClosed
+	}
+	if len(hub.subscriptions) >= hub.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum: %d", ErrTooManySubscribers, hub.maxSubscribers)
+	}
+
+	hub.nextID++
+	subscription := newSubscription(hub.nextID, filter, hub.bufferSize, hub)
+	hub.subscriptions[subscription.id] = subscription
+
+	log.Debug("subscriptions hub: new subscription", "id", subscription.id, "num subscriptions", len(hub.subscriptions))
+
+	return subscription, nil
+}
+
+func (hub *subscriptionsHub) unsubscribe(subscription *Subscription, reason error) {
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	hub.removeSubscription(subscription, reason)
+}
+
+func (hub *subscriptionsHub) removeSubscription(subscription *Subscription, reason error) {
+	_, found := hub.subscriptions[subscription.id]
+	if !found {
+		return
+	}
+
+	delete(hub.subscriptions, subscription.id)
+	subscription.closeWithError(reason)
+}
+
+// SaveBlock delivers the committed block, the status of the subscribed transactions and the events of the
+// subscribed addresses
+func (hub *subscriptionsHub) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return blockdata.ErrNilArgsSaveBlockData
+	}
+
+	headerInfo, err := blockdata.NewHeaderInfo(args.HeaderHash, args.Header)
+	if err != nil {
+		return err
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	newEvent := func(topic string, eventData interface{}) *Event {
+		return &Event{
+			Topic:      topic,
+			Nonce:      headerInfo.Nonce,
+			HeaderHash: headerInfo.HeaderHash,
+			Data:       eventData,
+		}
+	}
+
+	hub.publish(newEvent(TopicBlocks, headerInfo), nil)
+
+	pending := &pendingBlock{
+		nonce: headerInfo.Nonce,
+	}
+	hub.addPendingBlock(string(args.HeaderHash), pending)
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		return nil
+	}
+
+	publishTxs := func(txs map[string]data.TransactionHandler, status string) {
+		for _, txHash := range sortedKeys(txs) {
+			if !hub.isAnyWatchingTx(txHash) {
+				continue
+			}
+
+			pending.txHashes = append(pending.txHashes, txHash)
+			hub.publish(newEvent(TopicTransactions, &TransactionData{
+				TxHash: hex.EncodeToString(txHash),
+				Status: status,
+			}), txHash)
+		}
+	}
+
+	publishTxs(pool.Txs, TxStatusExecuted)
+	publishTxs(pool.Scrs, TxStatusExecuted)
+	publishTxs(pool.Rewards, TxStatusExecuted)
+	publishTxs(pool.Invalid, TxStatusInvalid)
+
+	for _, logData := range pool.Logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			hub.publish(newEvent(TopicAddressEvents, &AddressEventData{
+				TxHash:     hex.EncodeToString([]byte(logData.TxHash)),
+				Address:    hub.pubKeyConverter.Encode(event.GetAddress()),
+				Identifier: string(event.GetIdentifier()),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+			}), event.GetAddress())
+		}
+	}
+
+	return nil
+}
+
+// RevertIndexedBlock notifies the subscribed transactions of the reverted block
+func (hub *subscriptionsHub) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return blockdata.ErrNilHeader
+	}
+
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	for headerHash, pending := range hub.pendingBlocks {
+		if pending.nonce != header.GetNonce() {
+			continue
+		}
+
+		hub.publishTxsStatus(headerHash, pending, TxStatusReverted)
+		delete(hub.pendingBlocks, headerHash)
+	}
+
+	return nil
+}
+
+// FinalizedBlock delivers the finalized block and notifies the subscribed transactions of the finalized block and
+// of the blocks with lower nonces. The event nonce is the one of the matching committed block, if still tracked
+func (hub *subscriptionsHub) FinalizedBlock(headerHash []byte) error {
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	finalized, found := hub.pendingBlocks[string(headerHash)]
+	var nonce uint64
+	if found {
+		nonce = finalized.nonce
+	}
+
+	hub.publish(&Event{
+		Topic:      TopicFinalizedBlocks,
+		Nonce:      nonce,
+		HeaderHash: hex.EncodeToString(headerHash),
+		Data:       &FinalizedBlockData{HeaderHash: hex.EncodeToString(headerHash)},
+	}, nil)
+
+	if !found {
+		return nil
+	}
+
+	finalizedHashes := make([]string, 0)
+	for hash, pending := range hub.pendingBlocks {
+		if pending.nonce <= finalized.nonce {
+			finalizedHashes = append(finalizedHashes, hash)
+		}
+	}
+	sort.Slice(finalizedHashes, func(i, j int) bool {
+		return hub.pendingBlocks[finalizedHashes[i]].nonce < hub.pendingBlocks[finalizedHashes[j]].nonce
+	})
+
+	for _, hash := range finalizedHashes {
+		hub.publishTxsStatus(hash, hub.pendingBlocks[hash], TxStatusFinalized)
+		delete(hub.pendingBlocks, hash)
+	}
+
+	return nil
+}
+
+func (hub *subscriptionsHub) addPendingBlock(headerHash string, pending *pendingBlock) {
+	hub.pendingBlocks[headerHash] = pending
+	if len(hub.pendingBlocks) <= maxPendingBlocks {
+		return
+	}
+
+	oldestHash, oldestNonce := "", uint64(0)
+	for hash, block := range hub.pendingBlocks {
+		if oldestHash == "" || block.nonce < oldestNonce {
+			oldestHash, oldestNonce = hash, block.nonce
+		}
+	}
+	delete(hub.pendingBlocks, oldestHash)
+}
+
+func (hub *subscriptionsHub) publishTxsStatus(headerHash string, pending *pendingBlock, status string) {
+	for _, txHash := range pending.txHashes {
+		hub.publish(&Event{
+			Topic:      TopicTransactions,
+			Nonce:      pending.nonce,
+			HeaderHash: hex.EncodeToString([]byte(headerHash)),
+			Data: &TransactionData{
+				TxHash: hex.EncodeToString(txHash),
+				Status: status,
+			},
+		}, txHash)
+	}
+}
+
+func (hub *subscriptionsHub) isAnyWatchingTx(txHash []byte) bool {
+	for _, subscription := range hub.subscriptions {
+		if subscription.isWatchingTx(txHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// publish delivers the event to the interested subscribers, dropping the ones with a full buffer. The hub's lock
+// should be held by the caller
+func (hub *subscriptionsHub) publish(event *Event, key []byte) {
+	for _, subscription := range hub.subscriptions {
+		if !subscription.wants(event, key) {
+			continue
+		}
+
+		select {
+		case subscription.events <- event:
+		default:
+			log.Debug("subscriptions hub: dropping slow subscriber", "id", subscription.id)
+			hub.removeSubscription(subscription, ErrSubscriberTooSlow)
+		}
+	}
+}
+
+func sortedKeys(txs map[string]data.TransactionHandler) [][]byte {
+	keys := make([][]byte, 0, len(txs))
+	for key := range txs {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return string(keys[i]) < string(keys[j])
+	})
+
+	return keys
+}
+
+// SaveRoundsInfo returns nil
+func (hub *subscriptionsHub) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (hub *subscriptionsHub) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (hub *subscriptionsHub) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// Close closes all the subscriptions
+func (hub *subscriptionsHub) Close() error {
+	hub.mut.Lock()
+	defer hub.mut.Unlock()
+
+	hub.closed = true
+	for _, subscription := range hub.subscriptions {
+		hub.removeSubscription(subscription, ErrHubClosed)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
@@ -0,0 +1,252 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSubscriptionsHub() ArgsSubscriptionsHub {
+	return ArgsSubscriptionsHub{
+		PubKeyConverter:      testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:       2,
+		SubscriberBufferSize: 10,
+	}
+}
+
+func createArgsSaveBlock(nonce uint64, headerHash string, pool *indexer.Pool) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash:       []byte(headerHash),
+		Header:           &block.Header{Nonce: nonce, Round: nonce + 1},
+		Body:             &block.Body{},
+		TransactionsPool: pool,
+	}
+}
+
+func readEvents(subscription *Subscription) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func getTxStatuses(events []*Event) []string {
+	statuses := make([]string, 0, len(events))
+	for _, event := range events {
+		txData := event.Data.(*TransactionData)
+		statuses = append(statuses, txData.TxHash+":"+txData.Status)
+	}
+
+	return statuses
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.PubKeyConverter = nil
+
+		hub, err := NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+
+		hub, err := NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.True(t, errors.Is(err, ErrInvalidMaxSubscribers))
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.SubscriberBufferSize = 0
+
+		hub, err := NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.True(t, errors.Is(err, ErrInvalidSubscriberBufferSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hub, err := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		assert.False(t, check.IfNil(hub))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSubscriptionsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+
+	subscription, err := hub.Subscribe(Filter{})
+	assert.Nil(t, subscription)
+	assert.Equal(t, ErrEmptyFilter, err)
+
+	first, err := hub.Subscribe(Filter{Blocks: true})
+	require.Nil(t, err)
+	_, err = hub.Subscribe(Filter{Blocks: true})
+	require.Nil(t, err)
+
+	subscription, err = hub.Subscribe(Filter{Blocks: true})
+	assert.Nil(t, subscription)
+	assert.True(t, errors.Is(err, ErrTooManySubscribers))
+
+	first.Close()
+	_, isOpen := <-first.Events()
+	assert.False(t, isOpen)
+	assert.Nil(t, first.Err())
+
+	first.Close()
+	_, err = hub.Subscribe(Filter{Blocks: true})
+	assert.Nil(t, err)
+}
+
+func TestSubscriptionsHub_BlocksAndFinalizedBlocks(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	blocksSubscription, _ := hub.Subscribe(Filter{Blocks: true})
+	finalizedSubscription, _ := hub.Subscribe(Filter{FinalizedBlocks: true})
+
+	err := hub.SaveBlock(createArgsSaveBlock(7, "hash7", nil))
+	require.Nil(t, err)
+	err = hub.FinalizedBlock([]byte("hash7"))
+	require.Nil(t, err)
+
+	events := readEvents(blocksSubscription)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicBlocks, events[0].Topic)
+	assert.Equal(t, uint64(7), events[0].Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash7")), events[0].HeaderHash)
+
+	events = readEvents(finalizedSubscription)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicFinalizedBlocks, events[0].Topic)
+	assert.Equal(t, uint64(7), events[0].Nonce)
+	assert.Equal(t, &FinalizedBlockData{HeaderHash: hex.EncodeToString([]byte("hash7"))}, events[0].Data)
+}
+
+func TestSubscriptionsHub_TransactionsStatus(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	subscription, _ := hub.Subscribe(Filter{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}})
+
+	txHex := func(txHash string) string {
+		return hex.EncodeToString([]byte(txHash))
+	}
+
+	_ = hub.SaveBlock(createArgsSaveBlock(1, "hash1", &indexer.Pool{
+		Txs:     map[string]data.TransactionHandler{"tx1": &transaction.Transaction{}, "other": &transaction.Transaction{}},
+		Invalid: map[string]data.TransactionHandler{"tx2": &transaction.Transaction{}},
+	}))
+	_ = hub.SaveBlock(createArgsSaveBlock(2, "hash2", &indexer.Pool{
+		Txs: map[string]data.TransactionHandler{"tx3": &transaction.Transaction{}},
+	}))
+	assert.Equal(t, []string{txHex("tx1") + ":executed", txHex("tx2") + ":invalid", txHex("tx3") + ":executed"},
+		getTxStatuses(readEvents(subscription)))
+
+	err := hub.RevertIndexedBlock(&block.Header{Nonce: 2}, &block.Body{})
+	require.Nil(t, err)
+	assert.Equal(t, []string{txHex("tx3") + ":reverted"}, getTxStatuses(readEvents(subscription)))
+
+	_ = hub.SaveBlock(createArgsSaveBlock(2, "hash2b", &indexer.Pool{
+		Txs: map[string]data.TransactionHandler{"tx3": &transaction.Transaction{}},
+	}))
+	_ = readEvents(subscription)
+
+	err = hub.FinalizedBlock([]byte("hash2b"))
+	require.Nil(t, err)
+	events := readEvents(subscription)
+	assert.Equal(t, []string{txHex("tx1") + ":finalized", txHex("tx2") + ":finalized", txHex("tx3") + ":finalized"},
+		getTxStatuses(events))
+	assert.Equal(t, uint64(1), events[0].Nonce)
+	assert.Equal(t, uint64(2), events[2].Nonce)
+	assert.Equal(t, 0, len(hub.pendingBlocks))
+}
+
+func TestSubscriptionsHub_AddressEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	subscription, _ := hub.Subscribe(Filter{Addresses: [][]byte{[]byte("contract")}})
+
+	_ = hub.SaveBlock(createArgsSaveBlock(1, "hash1", &indexer.Pool{
+		Logs: []*data.LogData{
+			{
+				TxHash: "tx",
+				LogHandler: &transaction.Log{
+					Events: []*transaction.Event{
+						{Address: []byte("contract"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("alice")}},
+						{Address: []byte("other"), Identifier: []byte("transfer")},
+					},
+				},
+			},
+		},
+	}))
+
+	events := readEvents(subscription)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicAddressEvents, events[0].Topic)
+	expectedData := &AddressEventData{
+		TxHash:     hex.EncodeToString([]byte("tx")),
+		Address:    hex.EncodeToString([]byte("contract")),
+		Identifier: "transfer",
+		Topics:     [][]byte{[]byte("alice")},
+	}
+	assert.Equal(t, expectedData, events[0].Data)
+}
+
+func TestSubscriptionsHub_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSubscriptionsHub()
+	args.SubscriberBufferSize = 1
+	hub, _ := NewSubscriptionsHub(args)
+	subscription, _ := hub.Subscribe(Filter{Blocks: true})
+
+	_ = hub.SaveBlock(createArgsSaveBlock(1, "hash1", nil))
+	_ = hub.SaveBlock(createArgsSaveBlock(2, "hash2", nil))
+
+	events := readEvents(subscription)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(1), events[0].Nonce)
+	assert.Equal(t, ErrSubscriberTooSlow, subscription.Err())
+	assert.Equal(t, 0, len(hub.subscriptions))
+}
+
+func TestSubscriptionsHub_Close(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	subscription, _ := hub.Subscribe(Filter{Blocks: true})
+
+	err := hub.Close()
+	assert.Nil(t, err)
+
+	_, isOpen := <-subscription.Events()
+	assert.False(t, isOpen)
+	assert.Equal(t, ErrHubClosed, subscription.Err())
+
+	subscription.Close()
+	_, err = hub.Subscribe(Filter{Blocks: true})
+	assert.Equal(t, ErrHubClosed, err)
+}
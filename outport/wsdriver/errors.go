@@ -0,0 +1,33 @@
+package wsdriver
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrInvalidBufferSize signals that an invalid events buffer size has been provided
+var ErrInvalidBufferSize = errors.New("invalid events buffer size")
+
+// ErrInvalidMaxUnackedEvents signals that an invalid maximum number of unacknowledged events has been provided
+var ErrInvalidMaxUnackedEvents = errors.New("invalid maximum number of unacknowledged events")
+
+// ErrInvalidWriteTimeout signals that an invalid write timeout has been provided
+var ErrInvalidWriteTimeout = errors.New("invalid write timeout")
+
+// ErrEmptyURL signals that an empty URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrNilArgsWebSocketDriverFactory signals that nil arguments have been provided to the driver factory
+var ErrNilArgsWebSocketDriverFactory = errors.New("nil args web socket driver factory")
+
+// ErrEventEvicted signals that the requested event is no longer held in the events buffer
+var ErrEventEvicted = errors.New("event evicted from the events buffer")
+
+// ErrNonceNotAvailable signals that the events starting with the requested nonce can not be replayed anymore
+var ErrNonceNotAvailable = errors.New("nonce not available for replay")
+
+// ErrInvalidFromNonce signals that an invalid fromNonce parameter has been provided
+var ErrInvalidFromNonce = errors.New("invalid fromNonce parameter")
+
+// ErrDriverClosed signals that the driver has been closed
+var ErrDriverClosed = errors.New("driver closed")
@@ -0,0 +1,43 @@
+package wsdriver
+
+const (
+	// EventSaveBlock is the type of the event emitted when a block is saved
+	EventSaveBlock = "saveBlock"
+	// EventRevertIndexedBlock is the type of the event emitted when a block is reverted
+	EventRevertIndexedBlock = "revertIndexedBlock"
+	// EventFinalizedBlock is the type of the event emitted when a block is finalized
+	EventFinalizedBlock = "finalizedBlock"
+	// EventError is the type of the event emitted right before the server closes a connection because of an error
+	EventError = "error"
+
+	// ActionAck is the client action that acknowledges all the events up to, and including, the provided ID
+	ActionAck = "ack"
+
+	// FromNonceParameter is the URL query parameter used by clients to request the replay of the buffered events
+	FromNonceParameter = "fromNonce"
+)
+
+// Event is the message pushed to the subscribed clients
+type Event struct {
+	ID         uint64      `json:"id"`
+	Type       string      `json:"type"`
+	Nonce      uint64      `json:"nonce"`
+	HeaderHash string      `json:"headerHash,omitempty"`
+	Data       interface{} `json:"data,omitempty"`
+}
+
+// ErrorData is the payload of an error event
+type ErrorData struct {
+	Message string `json:"message"`
+}
+
+// FinalizedBlockData is the payload of a finalized block event
+type FinalizedBlockData struct {
+	HeaderHash string `json:"headerHash"`
+}
+
+// ClientMessage is the message sent by the clients to the server
+type ClientMessage struct {
+	Action string `json:"action"`
+	ID     uint64 `json:"id"`
+}
@@ -0,0 +1,151 @@
+package wsdriver
+
+import (
+	"sync"
+)
+
+type bufferedEvent struct {
+	id         uint64
+	eventType  string
+	nonce      uint64
+	headerHash string
+	payload    []byte
+}
+
+// eventsBuffer holds the last marshaled events so they can be pushed to, or replayed for, any client.
+// The IDs are consecutive and start at 1
+type eventsBuffer struct {
+	mut          sync.RWMutex
+	events       []*bufferedEvent
+	capacity     int
+	lastID       uint64
+	numEvicted   uint64
+	chanNewEvent chan struct{}
+	closed       bool
+}
+
+func newEventsBuffer(capacity int) *eventsBuffer {
+	return &eventsBuffer{
+		events:       make([]*bufferedEvent, 0, capacity),
+		capacity:     capacity,
+		chanNewEvent: make(chan struct{}),
+	}
+}
+
+// nextID returns the ID that will be assigned to the next added event
+func (eb *eventsBuffer) nextID() uint64 {
+	eb.mut.RLock()
+	defer eb.mut.RUnlock()
+
+	return eb.lastID + 1
+}
+
+// add appends a new event, evicting the oldest one if the buffer is full, and wakes up all the waiting clients.
+// The payload is built through the provided function that receives the ID assigned to the event
+func (eb *eventsBuffer) add(
+	eventType string,
+	nonce uint64,
+	headerHash string,
+	createPayload func(id uint64) ([]byte, error),
+) error {
+	eb.mut.Lock()
+	defer eb.mut.Unlock()
+
+	if eb.closed {
+		return ErrDriverClosed
+	}
+
+	id := eb.lastID + 1
+	payload, err := createPayload(id)
+	if err != nil {
+		return err
+	}
+
+	if len(eb.events) == eb.capacity {
+		eb.events[0] = nil
+		eb.events = eb.events[1:]
+		eb.numEvicted++
+	}
+
+	eb.events = append(eb.events, &bufferedEvent{
+		id:         id,
+		eventType:  eventType,
+		nonce:      nonce,
+		headerHash: headerHash,
+		payload:    payload,
+	})
+	eb.lastID = id
+
+	close(eb.chanNewEvent)
+	eb.chanNewEvent = make(chan struct{})
+
+	return nil
+}
+
+// get returns the event with the provided ID. If the event was not yet added, it returns a nil event and a
+// channel that will be closed when a new event is added
+func (eb *eventsBuffer) get(id uint64) (*bufferedEvent, <-chan struct{}, error) {
+	eb.mut.RLock()
+	defer eb.mut.RUnlock()
+
+	if eb.closed {
+		return nil, nil, ErrDriverClosed
+	}
+	if id > eb.lastID {
+		return nil, eb.chanNewEvent, nil
+	}
+
+	oldestID := eb.numEvicted + 1
+	if id < oldestID {
+		return nil, nil, ErrEventEvicted
+	}
+
+	return eb.events[id-oldestID], nil, nil
+}
+
+// firstIDForNonce returns the ID of the oldest buffered event having a nonce higher or equal to the provided one.
+// It errors if events with the requested nonce might have been already evicted
+func (eb *eventsBuffer) firstIDForNonce(nonce uint64) (uint64, error) {
+	eb.mut.RLock()
+	defer eb.mut.RUnlock()
+
+	if eb.numEvicted > 0 && (len(eb.events) == 0 || eb.events[0].nonce > nonce) {
+		return 0, ErrNonceNotAvailable
+	}
+
+	for _, event := range eb.events {
+		if event.nonce >= nonce {
+			return event.id, nil
+		}
+	}
+
+	return eb.lastID + 1, nil
+}
+
+// nonceForHeaderHash returns the nonce of the most recent saved block having the provided header hash
+func (eb *eventsBuffer) nonceForHeaderHash(headerHash string) (uint64, bool) {
+	eb.mut.RLock()
+	defer eb.mut.RUnlock()
+
+	for i := len(eb.events) - 1; i >= 0; i-- {
+		event := eb.events[i]
+		if event.eventType == EventSaveBlock && event.headerHash == headerHash {
+			return event.nonce, true
+		}
+	}
+
+	return 0, false
+}
+
+// close marks the buffer as closed and wakes up all the waiting clients
+func (eb *eventsBuffer) close() {
+	eb.mut.Lock()
+	defer eb.mut.Unlock()
+
+	if eb.closed {
+		return
+	}
+
+	eb.closed = true
+	close(eb.chanNewEvent)
+}
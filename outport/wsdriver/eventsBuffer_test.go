@@ -0,0 +1,135 @@
+package wsdriver
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addEvent(t *testing.T, buffer *eventsBuffer, eventType string, nonce uint64, headerHash string) {
+	err := buffer.add(eventType, nonce, headerHash, func(id uint64) ([]byte, error) {
+		return []byte(fmt.Sprintf("%d", id)), nil
+	})
+	require.Nil(t, err)
+}
+
+func TestEventsBuffer_AddAndGet(t *testing.T) {
+	t.Parallel()
+
+	buffer := newEventsBuffer(2)
+	assert.Equal(t, uint64(1), buffer.nextID())
+
+	event, chanNewEvent, err := buffer.get(1)
+	assert.Nil(t, event)
+	assert.Nil(t, err)
+	require.NotNil(t, chanNewEvent)
+
+	addEvent(t, buffer, EventSaveBlock, 10, "aa")
+	select {
+	case <-chanNewEvent:
+	default:
+		assert.Fail(t, "the wait channel should have been closed")
+	}
+
+	addEvent(t, buffer, EventSaveBlock, 11, "bb")
+	addEvent(t, buffer, EventSaveBlock, 12, "cc")
+	assert.Equal(t, uint64(4), buffer.nextID())
+
+	_, _, err = buffer.get(1)
+	assert.Equal(t, ErrEventEvicted, err)
+
+	event, _, err = buffer.get(3)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(12), event.nonce)
+	assert.Equal(t, []byte("3"), event.payload)
+}
+
+func TestEventsBuffer_AddWithPayloadErrorShouldNotAdd(t *testing.T) {
+	t.Parallel()
+
+	buffer := newEventsBuffer(2)
+	expectedErr := errors.New("expected error")
+	err := buffer.add(EventSaveBlock, 1, "", func(_ uint64) ([]byte, error) {
+		return nil, expectedErr
+	})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, uint64(1), buffer.nextID())
+}
+
+func TestEventsBuffer_FirstIDForNonce(t *testing.T) {
+	t.Parallel()
+
+	buffer := newEventsBuffer(3)
+	id, err := buffer.firstIDForNonce(100)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	addEvent(t, buffer, EventSaveBlock, 10, "aa")
+	addEvent(t, buffer, EventSaveBlock, 11, "bb")
+
+	id, err = buffer.firstIDForNonce(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), id)
+
+	id, err = buffer.firstIDForNonce(11)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), id)
+
+	id, err = buffer.firstIDForNonce(12)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), id)
+
+	addEvent(t, buffer, EventSaveBlock, 12, "cc")
+	addEvent(t, buffer, EventSaveBlock, 13, "dd")
+
+	_, err = buffer.firstIDForNonce(10)
+	assert.Equal(t, ErrNonceNotAvailable, err)
+
+	id, err = buffer.firstIDForNonce(11)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), id)
+}
+
+func TestEventsBuffer_NonceForHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	buffer := newEventsBuffer(10)
+	addEvent(t, buffer, EventSaveBlock, 10, "aa")
+	addEvent(t, buffer, EventFinalizedBlock, 0, "bb")
+	addEvent(t, buffer, EventSaveBlock, 11, "bb")
+
+	nonce, found := buffer.nonceForHeaderHash("bb")
+	assert.True(t, found)
+	assert.Equal(t, uint64(11), nonce)
+
+	_, found = buffer.nonceForHeaderHash("cc")
+	assert.False(t, found)
+}
+
+func TestEventsBuffer_Close(t *testing.T) {
+	t.Parallel()
+
+	buffer := newEventsBuffer(10)
+	_, chanNewEvent, _ := buffer.get(1)
+
+	buffer.close()
+	buffer.close()
+
+	select {
+	case <-chanNewEvent:
+	default:
+		assert.Fail(t, "the wait channel should have been closed")
+	}
+
+	_, _, err := buffer.get(1)
+	assert.Equal(t, ErrDriverClosed, err)
+
+	err = buffer.add(EventSaveBlock, 1, "", func(_ uint64) ([]byte, error) {
+		return nil, nil
+	})
+	assert.Equal(t, ErrDriverClosed, err)
+}
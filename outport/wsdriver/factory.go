@@ -0,0 +1,91 @@
+package wsdriver
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+// ArgsWebSocketDriverFactory holds the arguments needed to create a web socket driver listening on its own server
+type ArgsWebSocketDriverFactory struct {
+	Enabled               bool
+	URL                   string
+	Route                 string
+	BufferSize            int
+	MaxUnackedEvents      int
+	WriteTimeoutInSeconds int
+	AllowedOrigins        []string
+	Marshaller            marshal.Marshalizer
+}
+
+type webSocketDriverWithServer struct {
+	*webSocketDriver
+	server *http.Server
+}
+
+// CreateWebSocketDriver creates a web socket driver and starts the http server the clients connect to
+func CreateWebSocketDriver(args *ArgsWebSocketDriverFactory) (outport.Driver, error) {
+	if args == nil {
+		return nil, ErrNilArgsWebSocketDriverFactory
+	}
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+
+	driver, err := NewWebSocketDriver(ArgsWebSocketDriver{
+		Marshaller:       args.Marshaller,
+		BufferSize:       args.BufferSize,
+		MaxUnackedEvents: args.MaxUnackedEvents,
+		WriteTimeout:     time.Duration(args.WriteTimeoutInSeconds) * time.Second,
+		AllowedOrigins:   args.AllowedOrigins,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", args.URL)
+	if err != nil {
+		_ = driver.Close()
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(args.Route, driver)
+	server := &http.Server{
+		Addr:    args.URL,
+		Handler: mux,
+	}
+
+	go func() {
+		errServe := server.Serve(listener)
+		if errServe != nil && errServe != http.ErrServerClosed {
+			log.Error("web socket driver server", "url", args.URL, "error", errServe.Error())
+		}
+	}()
+
+	log.Info("web socket driver started", "url", listener.Addr().String(), "route", args.Route)
+
+	return &webSocketDriverWithServer{
+		webSocketDriver: driver,
+		server:          server,
+	}, nil
+}
+
+// Close closes the server and disconnects all the clients
+func (driverWithServer *webSocketDriverWithServer) Close() error {
+	err := driverWithServer.server.Close()
+	errClose := driverWithServer.webSocketDriver.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (driverWithServer *webSocketDriverWithServer) IsInterfaceNil() bool {
+	return driverWithServer == nil
+}
@@ -0,0 +1,63 @@
+package wsdriver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsWebSocketDriverFactory(url string) *ArgsWebSocketDriverFactory {
+	return &ArgsWebSocketDriverFactory{
+		Enabled:               true,
+		URL:                   url,
+		Route:                 "/blocks",
+		BufferSize:            10,
+		MaxUnackedEvents:      2,
+		WriteTimeoutInSeconds: 1,
+		Marshaller:            &mock.MarshalizerMock{},
+	}
+}
+
+func TestCreateWebSocketDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := CreateWebSocketDriver(nil)
+		assert.True(t, check.IfNil(driver))
+		assert.Equal(t, ErrNilArgsWebSocketDriverFactory, err)
+	})
+	t.Run("address already in use should error", func(t *testing.T) {
+		t.Parallel()
+
+		listener, err := net.Listen("tcp", "localhost:0")
+		require.Nil(t, err)
+		defer func() {
+			_ = listener.Close()
+		}()
+
+		driver, err := CreateWebSocketDriver(createMockArgsWebSocketDriverFactory(listener.Addr().String()))
+		assert.True(t, check.IfNil(driver))
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := CreateWebSocketDriver(createMockArgsWebSocketDriverFactory("invalid address"))
+		assert.True(t, check.IfNil(driver))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := CreateWebSocketDriver(createMockArgsWebSocketDriverFactory("localhost:0"))
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(driver))
+		assert.Nil(t, driver.Close())
+	})
+}
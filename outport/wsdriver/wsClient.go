@@ -0,0 +1,174 @@
+package wsdriver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/gorilla/websocket"
+)
+
+// wsClient pushes the buffered events, starting with a given ID, on one web socket connection. At most
+// maxUnackedEvents events are in flight, the client needs to acknowledge them in order to receive new ones
+type wsClient struct {
+	conn             *websocket.Conn
+	buffer           *eventsBuffer
+	marshaller       marshal.Marshalizer
+	writeTimeout     time.Duration
+	maxUnackedEvents uint64
+	nextID           uint64
+
+	mutAck      sync.RWMutex
+	lastAckedID uint64
+	chanAck     chan struct{}
+}
+
+func newWSClient(
+	conn *websocket.Conn,
+	buffer *eventsBuffer,
+	marshaller marshal.Marshalizer,
+	writeTimeout time.Duration,
+	maxUnackedEvents uint64,
+	firstID uint64,
+) *wsClient {
+	return &wsClient{
+		conn:             conn,
+		buffer:           buffer,
+		marshaller:       marshaller,
+		writeTimeout:     writeTimeout,
+		maxUnackedEvents: maxUnackedEvents,
+		nextID:           firstID,
+		lastAckedID:      firstID - 1,
+		chanAck:          make(chan struct{}, 1),
+	}
+}
+
+// run blocks until the connection is closed, the context is done or an unrecoverable error occurs
+func (client *wsClient) run(ctx context.Context) {
+	ctxClient, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctxClient.Done()
+		_ = client.conn.Close()
+	}()
+
+	go client.readLoop(cancel)
+
+	client.writeLoop(ctxClient)
+}
+
+func (client *wsClient) readLoop(cancel context.CancelFunc) {
+	defer cancel()
+
+	for {
+		_, message, err := client.conn.ReadMessage()
+		if err != nil {
+			log.Debug("web socket client read", "remote address", client.conn.RemoteAddr(), "error", err.Error())
+			return
+		}
+
+		clientMessage := &ClientMessage{}
+		err = client.marshaller.Unmarshal(clientMessage, message)
+		if err != nil {
+			log.Debug("web socket client sent an invalid message", "remote address", client.conn.RemoteAddr(), "error", err.Error())
+			continue
+		}
+
+		if clientMessage.Action == ActionAck {
+			client.acknowledge(clientMessage.ID)
+		}
+	}
+}
+
+func (client *wsClient) acknowledge(id uint64) {
+	client.mutAck.Lock()
+	if id > client.lastAckedID {
+		client.lastAckedID = id
+	}
+	client.mutAck.Unlock()
+
+	select {
+	case client.chanAck <- struct{}{}:
+	default:
+	}
+}
+
+func (client *wsClient) numUnackedEvents() uint64 {
+	client.mutAck.RLock()
+	defer client.mutAck.RUnlock()
+
+	lastSentID := client.nextID - 1
+	if client.lastAckedID >= lastSentID {
+		return 0
+	}
+
+	return lastSentID - client.lastAckedID
+}
+
+func (client *wsClient) writeLoop(ctx context.Context) {
+	for {
+		if client.numUnackedEvents() >= client.maxUnackedEvents {
+			select {
+			case <-client.chanAck:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		event, chanNewEvent, err := client.buffer.get(client.nextID)
+		if err != nil {
+			client.sendError(err)
+			return
+		}
+		if event == nil {
+			select {
+			case <-chanNewEvent:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		err = client.write(event.payload)
+		if err != nil {
+			log.Debug("web socket client write", "remote address", client.conn.RemoteAddr(), "error", err.Error())
+			return
+		}
+
+		client.mutAck.Lock()
+		client.nextID++
+		client.mutAck.Unlock()
+	}
+}
+
+func (client *wsClient) write(payload []byte) error {
+	err := client.conn.SetWriteDeadline(time.Now().Add(client.writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return client.conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+func (client *wsClient) sendError(errToSend error) {
+	payload, err := marshalErrorEvent(client.marshaller, errToSend)
+	if err != nil {
+		log.Debug("web socket client marshal error event", "error", err.Error())
+		return
+	}
+
+	err = client.write(payload)
+	if err != nil {
+		log.Debug("web socket client write error event", "remote address", client.conn.RemoteAddr(), "error", err.Error())
+	}
+}
+
+func marshalErrorEvent(marshaller marshal.Marshalizer, errToSend error) ([]byte, error) {
+	return marshaller.Marshal(&Event{
+		Type: EventError,
+		Data: &ErrorData{Message: errToSend.Error()},
+	})
+}
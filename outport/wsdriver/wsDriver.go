@@ -0,0 +1,216 @@
+package wsdriver
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/blockdata"
+	"github.com/gorilla/websocket"
+)
+
+var _ outport.Driver = (*webSocketDriver)(nil)
+
+var log = logger.GetOrCreate("outport/wsdriver")
+
+// ArgsWebSocketDriver holds the arguments needed to create a new web socket driver
+type ArgsWebSocketDriver struct {
+	Marshaller       marshal.Marshalizer
+	BufferSize       int
+	MaxUnackedEvents int
+	WriteTimeout     time.Duration
+	AllowedOrigins   []string
+}
+
+// webSocketDriver is an outport driver that pushes the saved, reverted and finalized blocks to the local
+// clients connected through web sockets. The driver never blocks the outport: the events are kept in a bounded
+// buffer from which every client consumes at its own pace, acknowledging the received events. A client can
+// request the replay of the buffered events starting with a given nonce when connecting
+type webSocketDriver struct {
+	marshaller       marshal.Marshalizer
+	buffer           *eventsBuffer
+	maxUnackedEvents uint64
+	writeTimeout     time.Duration
+	upgrader         websocket.Upgrader
+	ctx              context.Context
+	cancel           context.CancelFunc
+	wgClients        sync.WaitGroup
+}
+
+// NewWebSocketDriver creates a new web socket driver. The returned instance is also a http.Handler that
+// should be registered on the route the clients connect to
+func NewWebSocketDriver(args ArgsWebSocketDriver) (*webSocketDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &webSocketDriver{
+		marshaller:       args.Marshaller,
+		buffer:           newEventsBuffer(args.BufferSize),
+		maxUnackedEvents: uint64(args.MaxUnackedEvents),
+		writeTimeout:     args.WriteTimeout,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: common.NewWebSocketOriginChecker(args.AllowedOrigins),
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+func checkArgs(args ArgsWebSocketDriver) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if args.BufferSize < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidBufferSize, args.BufferSize)
+	}
+	if args.MaxUnackedEvents < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxUnackedEvents, args.MaxUnackedEvents)
+	}
+	if args.WriteTimeout <= 0 {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidWriteTimeout, args.WriteTimeout)
+	}
+
+	return nil
+}
+
+// ServeHTTP upgrades the connection to a web socket and starts pushing events on it. Only the new events are
+// pushed unless the fromNonce query parameter is provided, case in which the buffered events are replayed first
+func (driver *webSocketDriver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	firstID, err := driver.firstIDForRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := driver.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("web socket driver upgrade", "remote address", r.RemoteAddr, "error", err.Error())
+		return
+	}
+
+	log.Debug("web socket driver: client connected", "remote address", r.RemoteAddr, "first event ID", firstID)
+
+	driver.wgClients.Add(1)
+	defer driver.wgClients.Done()
+
+	client := newWSClient(conn, driver.buffer, driver.marshaller, driver.writeTimeout, driver.maxUnackedEvents, firstID)
+	client.run(driver.ctx)
+
+	log.Debug("web socket driver: client disconnected", "remote address", r.RemoteAddr)
+}
+
+func (driver *webSocketDriver) firstIDForRequest(r *http.Request) (uint64, error) {
+	fromNonceString := r.URL.Query().Get(FromNonceParameter)
+	if len(fromNonceString) == 0 {
+		return driver.buffer.nextID(), nil
+	}
+
+	fromNonce, err := strconv.ParseUint(fromNonceString, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidFromNonce, fromNonceString)
+	}
+
+	return driver.buffer.firstIDForNonce(fromNonce)
+}
+
+// SaveBlock pushes the block data to the connected clients
+func (driver *webSocketDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	blockData, err := blockdata.NewBlockData(args)
+	if err != nil {
+		return err
+	}
+
+	return driver.addEvent(EventSaveBlock, blockData.Nonce, blockData.HeaderHash, blockData)
+}
+
+// RevertIndexedBlock pushes the reverted header information to the connected clients
+func (driver *webSocketDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	headerInfo, err := blockdata.NewHeaderInfo(nil, header)
+	if err != nil {
+		return err
+	}
+
+	return driver.addEvent(EventRevertIndexedBlock, headerInfo.Nonce, "", headerInfo)
+}
+
+// FinalizedBlock pushes the finalized header hash to the connected clients. The event nonce is the one of the
+// matching saved block. If the saved block is no longer buffered, the nonce is unknown and the event is skipped
+func (driver *webSocketDriver) FinalizedBlock(headerHash []byte) error {
+	hexHeaderHash := hex.EncodeToString(headerHash)
+	nonce, found := driver.buffer.nonceForHeaderHash(hexHeaderHash)
+	if !found {
+		log.Debug("web socket driver: finalized block not found in buffer, skipping event", "hash", headerHash)
+		return nil
+	}
+
+	return driver.addEvent(EventFinalizedBlock, nonce, hexHeaderHash, &FinalizedBlockData{HeaderHash: hexHeaderHash})
+}
+
+func (driver *webSocketDriver) addEvent(eventType string, nonce uint64, headerHash string, eventData interface{}) error {
+	marshaledData, err := driver.marshaller.Marshal(eventData)
+	if err != nil {
+		return err
+	}
+
+	createPayload := func(id uint64) ([]byte, error) {
+		return driver.marshaller.Marshal(&Event{
+			ID:         id,
+			Type:       eventType,
+			Nonce:      nonce,
+			HeaderHash: headerHash,
+			Data:       json.RawMessage(marshaledData),
+		})
+	}
+
+	return driver.buffer.add(eventType, nonce, headerHash, createPayload)
+}
+
+// SaveRoundsInfo returns nil
+func (driver *webSocketDriver) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (driver *webSocketDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (driver *webSocketDriver) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (driver *webSocketDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// Close disconnects all the clients
+func (driver *webSocketDriver) Close() error {
+	driver.buffer.close()
+	driver.cancel()
+	driver.wgClients.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (driver *webSocketDriver) IsInterfaceNil() bool {
+	return driver == nil
+}
@@ -0,0 +1,311 @@
+package wsdriver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readTimeout = time.Second * 5
+
+func createMockArgsWebSocketDriver() ArgsWebSocketDriver {
+	return ArgsWebSocketDriver{
+		Marshaller:       &mock.MarshalizerMock{},
+		BufferSize:       100,
+		MaxUnackedEvents: 2,
+		WriteTimeout:     time.Second,
+	}
+}
+
+func createArgsSaveBlock(nonce uint64, headerHash string) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte(headerHash),
+		Header:     &block.Header{Nonce: nonce, Round: nonce + 1},
+		Body:       &block.Body{},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"txHash": &transaction.Transaction{Nonce: 1, Value: nil},
+			},
+		},
+	}
+}
+
+func startServer(t *testing.T, driver *webSocketDriver) *httptest.Server {
+	server := httptest.NewServer(driver)
+	t.Cleanup(func() {
+		_ = driver.Close()
+		server.Close()
+	})
+
+	return server
+}
+
+func connect(t *testing.T, server *httptest.Server, query string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/" + query
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+
+	return conn
+}
+
+func readEvent(t *testing.T, conn *websocket.Conn) *Event {
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, message, err := conn.ReadMessage()
+	require.Nil(t, err)
+
+	event := &Event{}
+	err = json.Unmarshal(message, event)
+	require.Nil(t, err)
+
+	return event
+}
+
+func ack(t *testing.T, conn *websocket.Conn, id uint64) {
+	message, _ := json.Marshal(&ClientMessage{Action: ActionAck, ID: id})
+	err := conn.WriteMessage(websocket.TextMessage, message)
+	require.Nil(t, err)
+}
+
+func TestNewWebSocketDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createMockArgsWebSocketDriver()
+		args.Marshaller = nil
+
+		driver, err := NewWebSocketDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		args := createMockArgsWebSocketDriver()
+		args.BufferSize = 0
+
+		driver, err := NewWebSocketDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.True(t, errors.Is(err, ErrInvalidBufferSize))
+	})
+	t.Run("invalid max unacked events should error", func(t *testing.T) {
+		args := createMockArgsWebSocketDriver()
+		args.MaxUnackedEvents = 0
+
+		driver, err := NewWebSocketDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.True(t, errors.Is(err, ErrInvalidMaxUnackedEvents))
+	})
+	t.Run("invalid write timeout should error", func(t *testing.T) {
+		args := createMockArgsWebSocketDriver()
+		args.WriteTimeout = 0
+
+		driver, err := NewWebSocketDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.True(t, errors.Is(err, ErrInvalidWriteTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		driver, err := NewWebSocketDriver(createMockArgsWebSocketDriver())
+		assert.False(t, check.IfNil(driver))
+		assert.Nil(t, err)
+		assert.Nil(t, driver.Close())
+	})
+}
+
+func TestWebSocketDriver_SaveBlockNilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewWebSocketDriver(createMockArgsWebSocketDriver())
+	err := driver.SaveBlock(nil)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(1), driver.buffer.nextID())
+}
+
+func TestWebSocketDriver_ShouldPushEventsToConnectedClients(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewWebSocketDriver(createMockArgsWebSocketDriver())
+	server := startServer(t, driver)
+	conn := connect(t, server, "")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// wait for the server side of the connection to be set up before saving the block
+	time.Sleep(time.Millisecond * 100)
+
+	require.Nil(t, driver.SaveBlock(createArgsSaveBlock(5, "hash5")))
+	require.Nil(t, driver.FinalizedBlock([]byte("hash5")))
+	require.Nil(t, driver.RevertIndexedBlock(&block.Header{Nonce: 5}, &block.Body{}))
+
+	event := readEvent(t, conn)
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, EventSaveBlock, event.Type)
+	assert.Equal(t, uint64(5), event.Nonce)
+	assert.Equal(t, "6861736835", event.HeaderHash)
+	saveBlockData := event.Data.(map[string]interface{})
+	assert.Contains(t, saveBlockData["transactions"], "747848617368")
+
+	event = readEvent(t, conn)
+	assert.Equal(t, uint64(2), event.ID)
+	assert.Equal(t, EventFinalizedBlock, event.Type)
+	assert.Equal(t, uint64(5), event.Nonce)
+
+	ack(t, conn, 2)
+
+	event = readEvent(t, conn)
+	assert.Equal(t, uint64(3), event.ID)
+	assert.Equal(t, EventRevertIndexedBlock, event.Type)
+	assert.Equal(t, uint64(5), event.Nonce)
+}
+
+func TestWebSocketDriver_ShouldWaitForAcknowledgements(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.MaxUnackedEvents = 1
+	driver, _ := NewWebSocketDriver(args)
+	server := startServer(t, driver)
+
+	require.Nil(t, driver.SaveBlock(createArgsSaveBlock(1, "hash1")))
+	require.Nil(t, driver.SaveBlock(createArgsSaveBlock(2, "hash2")))
+
+	conn := connect(t, server, "?fromNonce=1")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	event := readEvent(t, conn)
+	assert.Equal(t, uint64(1), event.Nonce)
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Millisecond * 200))
+	_, _, err := conn.ReadMessage()
+	require.NotNil(t, err, "the second event should not be pushed before the first one is acknowledged")
+	_ = conn.Close()
+
+	conn = connect(t, server, "?fromNonce=1")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	event = readEvent(t, conn)
+	assert.Equal(t, uint64(1), event.Nonce)
+	ack(t, conn, event.ID)
+	event = readEvent(t, conn)
+	assert.Equal(t, uint64(2), event.Nonce)
+}
+
+func TestWebSocketDriver_FinalizedBlockNotBufferedShouldSkipEvent(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewWebSocketDriver(createMockArgsWebSocketDriver())
+	server := startServer(t, driver)
+
+	conn := connect(t, server, "")
+	defer func() {
+		_ = conn.Close()
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	require.Nil(t, driver.FinalizedBlock([]byte("unknown hash")))
+	require.Nil(t, driver.SaveBlock(createArgsSaveBlock(5, "hash5")))
+
+	event := readEvent(t, conn)
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, EventSaveBlock, event.Type)
+}
+
+func TestWebSocketDriver_CrossOriginConnections(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.AllowedOrigins = []string{"https://explorer.local"}
+	driver, _ := NewWebSocketDriver(args)
+	server := startServer(t, driver)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/"
+
+	header := http.Header{}
+	header.Set("Origin", "https://attacker.example")
+	_, resp, err := websocket.DefaultDialer.Dial(url, header)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	header.Set("Origin", "https://explorer.local")
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	require.Nil(t, err)
+	_ = conn.Close()
+}
+
+func TestWebSocketDriver_ReplayFromNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.BufferSize = 2
+	args.MaxUnackedEvents = 10
+	driver, _ := NewWebSocketDriver(args)
+	server := startServer(t, driver)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(nonce, "hash")))
+	}
+
+	conn := connect(t, server, "?fromNonce=3")
+	event := readEvent(t, conn)
+	assert.Equal(t, uint64(3), event.Nonce)
+	_ = conn.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/?fromNonce=1"
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.NotNil(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestWebSocketDriver_InvalidFromNonceShouldRespondBadRequest(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewWebSocketDriver(createMockArgsWebSocketDriver())
+	server := startServer(t, driver)
+
+	resp, err := http.Get(server.URL + "/?fromNonce=abc")
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestWebSocketDriver_CloseShouldDisconnectClients(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewWebSocketDriver(createMockArgsWebSocketDriver())
+	server := startServer(t, driver)
+	conn := connect(t, server, "")
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	time.Sleep(time.Millisecond * 100)
+	require.Nil(t, driver.Close())
+
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	for {
+		_, _, err := conn.ReadMessage()
+		if err != nil {
+			break
+		}
+	}
+
+	assert.Equal(t, ErrDriverClosed, driver.SaveBlock(createArgsSaveBlock(1, "hash")))
+}
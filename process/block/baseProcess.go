@@ -1556,11 +1556,17 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := make(map[string]data.TransactionHandler)
+	for _, blockType := range []block.Type{block.TxBlock, block.RewardsBlock, block.InvalidBlock} {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txsFromPool[txHash] = tx
+		}
+	}
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 	logs := bp.txCoordinator.GetAllCurrentLogs()
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, logs)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool, logs)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
@@ -1864,3 +1870,10 @@ func (bp *baseProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	bp.flagScheduledMiniBlocks.SetValue(epoch >= bp.scheduledMiniBlocksEnableEpoch)
 	log.Debug("baseProcessor: scheduled mini blocks", "enabled", bp.flagScheduledMiniBlocks.IsSet())
 }
+
+// setBlockOperationDuration records, in the app status handler, the duration of the block operation started at the
+// provided time. It is meant to be deferred right at the start of the operation
+func (bp *baseProcessor) setBlockOperationDuration(operation string, startTime time.Time) {
+	metric := common.MetricBlockProcessingDuration + "_" + operation
+	bp.appStatusHandler.SetUInt64Value(metric, uint64(time.Since(startTime).Milliseconds()))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
@@ -2354,3 +2355,23 @@ func TestMetaProcessor_RestoreBlockBodyIntoPoolsShouldWork(t *testing.T) {
 	err := mp.RestoreBlockBodyIntoPools(&block.Body{})
 	assert.Nil(t, err)
 }
+
+func TestBaseProcessor_SetBlockOperationDuration(t *testing.T) {
+	t.Parallel()
+
+	setKey := ""
+	setValue := uint64(0)
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	coreComponents.StatusField = &statusHandlerMock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			setKey = key
+			setValue = value
+		},
+	}
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	bp.SetBlockOperationDuration(common.BlockOperationProcess, time.Now().Add(-time.Second))
+	assert.Equal(t, common.MetricBlockProcessingDuration+"_"+common.BlockOperationProcess, setKey)
+	assert.GreaterOrEqual(t, setValue, uint64(1000))
+}
@@ -469,3 +469,7 @@ func (bp *baseProcessor) SetMiniBlockHeaderReservedField(
 func (mp *metaProcessor) GetFinalMiniBlockHeaders(miniBlockHeaderHandlers []data.MiniBlockHeaderHandler) []data.MiniBlockHeaderHandler {
 	return mp.getFinalMiniBlockHeaders(miniBlockHeaderHandlers)
 }
+
+func (bp *baseProcessor) SetBlockOperationDuration(operation string, startTime time.Time) {
+	bp.setBlockOperationDuration(operation, startTime)
+}
@@ -2,6 +2,7 @@ package block
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 type blockProcessor interface {
@@ -19,3 +20,11 @@ type gasConsumedProvider interface {
 type peerAccountsDBHandler interface {
 	MarkSnapshotDone()
 }
+
+type txPoolReplacementsCounter interface {
+	GetReplacementCounts() (numReplaced uint64, numRejected uint64)
+}
+
+type txPoolCachesStatisticsProvider interface {
+	GetCachesStatistics() map[string]common.TxCacheStatistics
+}
@@ -185,6 +185,7 @@ func (mp *metaProcessor) ProcessBlock(
 
 	mp.processStatusHandler.SetBusy("metaProcessor.ProcessBlock")
 	defer mp.processStatusHandler.SetIdle()
+	defer mp.setBlockOperationDuration(common.BlockOperationProcess, time.Now())
 
 	err := mp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
@@ -751,6 +752,7 @@ func (mp *metaProcessor) CreateBlock(
 
 	mp.processStatusHandler.SetBusy("metaProcessor.CreateBlock")
 	defer mp.processStatusHandler.SetIdle()
+	defer mp.setBlockOperationDuration(common.BlockOperationCreate, time.Now())
 
 	metaHdr.SoftwareVersion = []byte(mp.headerIntegrityVerifier.GetVersion(metaHdr.Epoch))
 	mp.epochNotifier.CheckEpoch(metaHdr)
@@ -1189,6 +1191,7 @@ func (mp *metaProcessor) CommitBlock(
 		}
 		mp.processStatusHandler.SetIdle()
 	}()
+	defer mp.setBlockOperationDuration(common.BlockOperationCommit, time.Now())
 
 	err = checkForNils(headerHandler, bodyHandler)
 	if err != nil {
@@ -82,6 +82,28 @@ func getMetricsFromHeader(
 	appStatusHandler.SetUInt64Value(common.MetricTxPoolLoad, numTxWithDst)
 }
 
+func getMetricsFromTxPool(txPool interface{}, appStatusHandler core.AppStatusHandler) {
+	replacementsCounter, ok := txPool.(txPoolReplacementsCounter)
+	if ok {
+		numReplaced, numRejected := replacementsCounter.GetReplacementCounts()
+		appStatusHandler.SetUInt64Value(common.MetricTxPoolNumReplacedTxs, numReplaced)
+		appStatusHandler.SetUInt64Value(common.MetricTxPoolNumRejectedReplacements, numRejected)
+	}
+
+	cachesStatisticsProvider, ok := txPool.(txPoolCachesStatisticsProvider)
+	if !ok {
+		return
+	}
+
+	for cacheID, statistics := range cachesStatisticsProvider.GetCachesStatistics() {
+		appStatusHandler.SetUInt64Value(common.MetricTxCacheNumTxs+"_"+cacheID, statistics.NumTxs)
+		appStatusHandler.SetUInt64Value(common.MetricTxCacheNumBytes+"_"+cacheID, statistics.NumBytes)
+		appStatusHandler.SetUInt64Value(common.MetricTxCacheNumSenders+"_"+cacheID, statistics.NumSenders)
+		appStatusHandler.SetUInt64Value(common.MetricTxCacheNumReplacedTxs+"_"+cacheID, statistics.NumReplacedTxs)
+		appStatusHandler.SetUInt64Value(common.MetricTxCacheNumRejectedReplacements+"_"+cacheID, statistics.NumRejectedReplacements)
+	}
+}
+
 func saveMetricsForCommittedShardBlock(
 	nodesCoordinator sharding.NodesCoordinator,
 	appStatusHandler core.AppStatusHandler,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
@@ -91,3 +92,49 @@ func TestMetrics_IncrementCountAcceptedBlocks_ShouldWork(t *testing.T) {
 	incrementCountAcceptedBlocks(nodesCoord, statusHandler, &block.Header{PubKeysBitmap: []byte{2, 0}})
 	assert.True(t, incrementWasCalled)
 }
+
+type txPoolReplacementsCounterStub struct {
+	numReplaced uint64
+	numRejected uint64
+}
+
+func (stub *txPoolReplacementsCounterStub) GetReplacementCounts() (uint64, uint64) {
+	return stub.numReplaced, stub.numRejected
+}
+
+type txPoolCachesStatisticsProviderStub struct {
+	statistics map[string]common.TxCacheStatistics
+}
+
+func (stub *txPoolCachesStatisticsProviderStub) GetCachesStatistics() map[string]common.TxCacheStatistics {
+	return stub.statistics
+}
+
+func TestMetrics_GetMetricsFromTxPool(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	statusHandler := &statusHandlerMock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+
+	getMetricsFromTxPool(&struct{}{}, statusHandler)
+	assert.Equal(t, 0, len(metrics))
+
+	getMetricsFromTxPool(&txPoolReplacementsCounterStub{numReplaced: 3, numRejected: 7}, statusHandler)
+	assert.Equal(t, uint64(3), metrics[common.MetricTxPoolNumReplacedTxs])
+	assert.Equal(t, uint64(7), metrics[common.MetricTxPoolNumRejectedReplacements])
+
+	getMetricsFromTxPool(&txPoolCachesStatisticsProviderStub{
+		statistics: map[string]common.TxCacheStatistics{
+			"0": {NumTxs: 1, NumBytes: 2, NumSenders: 3, NumReplacedTxs: 4, NumRejectedReplacements: 5},
+		},
+	}, statusHandler)
+	assert.Equal(t, uint64(1), metrics[common.MetricTxCacheNumTxs+"_0"])
+	assert.Equal(t, uint64(2), metrics[common.MetricTxCacheNumBytes+"_0"])
+	assert.Equal(t, uint64(3), metrics[common.MetricTxCacheNumSenders+"_0"])
+	assert.Equal(t, uint64(4), metrics[common.MetricTxCacheNumReplacedTxs+"_0"])
+	assert.Equal(t, uint64(5), metrics[common.MetricTxCacheNumRejectedReplacements+"_0"])
+}
@@ -148,6 +148,7 @@ func (sp *shardProcessor) ProcessBlock(
 
 	sp.processStatusHandler.SetBusy("shardProcessor.ProcessBlock")
 	defer sp.processStatusHandler.SetIdle()
+	defer sp.setBlockOperationDuration(common.BlockOperationProcess, time.Now())
 
 	err := sp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
@@ -207,6 +208,7 @@ func (sp *shardProcessor) ProcessBlock(
 	log.Debug("total txs in unsigned pool", "counts", unsignedCounts.String())
 
 	go getMetricsFromHeader(header, uint64(txCounts.GetTotal()), sp.marshalizer, sp.appStatusHandler)
+	go getMetricsFromTxPool(sp.dataPool.Transactions(), sp.appStatusHandler)
 
 	err = sp.createBlockStarted()
 	if err != nil {
@@ -764,6 +766,7 @@ func (sp *shardProcessor) CreateBlock(
 
 	sp.processStatusHandler.SetBusy("shardProcessor.CreateBlock")
 	defer sp.processStatusHandler.SetIdle()
+	defer sp.setBlockOperationDuration(common.BlockOperationCreate, time.Now())
 
 	err := sp.createBlockStarted()
 	if err != nil {
@@ -840,6 +843,7 @@ func (sp *shardProcessor) CommitBlock(
 		}
 		sp.processStatusHandler.SetIdle()
 	}()
+	defer sp.setBlockOperationDuration(common.BlockOperationCommit, time.Now())
 
 	err = checkForNils(headerHandler, bodyHandler)
 	if err != nil {
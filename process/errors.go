@@ -807,6 +807,9 @@ var ErrNilRatingsInfoHandler = errors.New("nil ratings info handler")
 // ErrNilDebugger signals that a nil debug handler has been provided
 var ErrNilDebugger = errors.New("nil debug handler")
 
+// ErrNilTopicStatisticsHandler signals that a nil topic statistics handler has been provided
+var ErrNilTopicStatisticsHandler = errors.New("nil topic statistics handler")
+
 // ErrEmptyFloodPreventerList signals that an empty flood preventer list has been provided
 var ErrEmptyFloodPreventerList = errors.New("empty flood preventer provided")
 
@@ -1073,3 +1076,25 @@ var ErrNumOfMiniBlocksAndMiniBlocksHeadersMismatch = errors.New("num of mini blo
 
 // ErrNilDoubleTransactionsDetector signals that a nil double transactions detector has been provided
 var ErrNilDoubleTransactionsDetector = errors.New("nil double transactions detector")
+
+// ErrNilSCQuery signals that a nil smart contract query has been provided
+var ErrNilSCQuery = errors.New("nil smart contract query")
+
+// ErrEmptyQueriesBatch signals that an empty batch of queries has been provided
+var ErrEmptyQueriesBatch = errors.New("empty batch of queries")
+
+// ErrTooManyQueriesInBatch signals that the batch of queries holds more queries than allowed
+var ErrTooManyQueriesInBatch = errors.New("too many queries in batch")
+
+// ErrHistoricalQueriesNotSupported signals that the query service was not set up to execute queries at a specific block
+var ErrHistoricalQueriesNotSupported = errors.New("historical queries are not supported")
+
+// ErrHistoricalBlockNotFound signals that the block a historical query was requested at could not be found
+var ErrHistoricalBlockNotFound = errors.New("block of historical query not found")
+
+// ErrHistoricalStateNotAvailable signals that the state of the block a historical query was requested at is not
+// available anymore, usually because it was pruned
+var ErrHistoricalStateNotAvailable = errors.New("state of historical query block not available")
+
+// ErrHistoricalQueryNotAllowedInBatch signals that a query requested at a specific block was provided in a batch
+var ErrHistoricalQueryNotAllowedInBatch = errors.New("historical queries are not allowed in batch")
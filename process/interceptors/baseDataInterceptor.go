@@ -10,6 +10,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
+// propagationChecker defines the interceptor processors able to reject the intercepted data before the message holding
+// it is propagated to the other peers
+type propagationChecker interface {
+	CheckPropagation(data process.InterceptedData) error
+}
+
 type baseDataInterceptor struct {
 	throttler            process.InterceptorThrottler
 	antifloodHandler     process.P2PAntifloodHandler
@@ -62,6 +68,17 @@ func (bdi *baseDataInterceptor) isMessageFromSelfToSelf(fromConnectedPeer core.P
 		fromConnectedPeer == bdi.currentPeerId
 }
 
+// checkPropagation is called synchronously, as an error returned by the interceptor stops the message from being
+// propagated, while the intercepted data is validated and saved asynchronously
+func (bdi *baseDataInterceptor) checkPropagation(data process.InterceptedData) error {
+	checker, ok := bdi.processor.(propagationChecker)
+	if !ok {
+		return nil
+	}
+
+	return checker.CheckPropagation(data)
+}
+
 func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedData, msg p2p.MessageP2P) {
 	err := bdi.processor.Validate(data, msg.Peer())
 	if err != nil {
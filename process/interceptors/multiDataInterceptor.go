@@ -147,6 +147,8 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	}
 
 	listInterceptedData := make([]process.InterceptedData, len(multiDataBuff))
+	numRejectedPropagations := 0
+	var errPropagation error
 	errOriginator := mdi.antifloodHandler.IsOriginatorEligibleForTopic(message.Peer(), mdi.topic)
 
 	for index, dataBuff := range multiDataBuff {
@@ -182,10 +184,32 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 			mdi.throttler.EndProcessing()
 			return process.ErrInterceptedDataNotForCurrentShard
 		}
+
+		// a batch is propagated as a whole, so an item rejected for propagation is only skipped from processing,
+		// the batch being dropped only if all its items are rejected
+		err = mdi.checkPropagation(interceptedData)
+		if err != nil {
+			log.Trace("intercepted data rejected for propagation",
+				"hash", interceptedData.Hash(),
+				"topic", mdi.topic,
+				"err", err)
+			mdi.processDebugInterceptedData(interceptedData, err)
+			listInterceptedData[index] = nil
+			numRejectedPropagations++
+			errPropagation = err
+		}
+	}
+	if numRejectedPropagations == len(listInterceptedData) {
+		mdi.throttler.EndProcessing()
+		return errPropagation
 	}
 
 	go func() {
 		for _, interceptedData := range listInterceptedData {
+			if interceptedData == nil {
+				continue
+			}
+
 			mdi.processInterceptedData(interceptedData, message)
 		}
 		mdi.throttler.EndProcessing()
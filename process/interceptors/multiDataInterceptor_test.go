@@ -371,6 +371,128 @@ func testProcessReceiveMessageMultiData(t *testing.T, isForCurrentShard bool, ex
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 }
 
+func TestMultiDataInterceptor_ProcessReceivedMessagePropagationRejectedShouldErrAndNotProcess(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerMock{}
+	throttler := createMockThrottler()
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				CheckValidityCalled: func() error {
+					return nil
+				},
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = &mock.InterceptorProcessorStub{
+		CheckPropagationCalled: func(data process.InterceptedData) error {
+			return expectedErr
+		},
+		ValidateCalled: func(data process.InterceptedData) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	arg.Throttler = throttler
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1"), []byte("buff2")}})
+	msg := &mock.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	time.Sleep(time.Second)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessagePartiallyRejectedPropagationShouldProcessTheOtherItems(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	rejectedData := &testscommon.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return nil
+		},
+		IsForCurrentShardCalled: func() bool {
+			return true
+		},
+		HashCalled: func() []byte {
+			return []byte("rejected")
+		},
+	}
+	acceptedData := &testscommon.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return nil
+		},
+		IsForCurrentShardCalled: func() bool {
+			return true
+		},
+		HashCalled: func() []byte {
+			return []byte("accepted")
+		},
+	}
+
+	marshalizer := &mock.MarshalizerMock{}
+	throttler := createMockThrottler()
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			if string(buff) == "rejected" {
+				return rejectedData, nil
+			}
+
+			return acceptedData, nil
+		},
+	}
+	numSaved := int32(0)
+	arg.Processor = &mock.InterceptorProcessorStub{
+		CheckPropagationCalled: func(data process.InterceptedData) error {
+			if data == rejectedData {
+				return expectedErr
+			}
+
+			return nil
+		},
+		ValidateCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			assert.True(t, data == acceptedData)
+			atomic.AddInt32(&numSaved, 1)
+			return nil
+		},
+	}
+	arg.Throttler = throttler
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("accepted"), []byte("rejected"), []byte("accepted")}})
+	msg := &mock.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	time.Sleep(time.Second)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numSaved))
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
 func TestMultiDataInterceptor_ProcessReceivedMessageCheckBatchErrors(t *testing.T) {
 	buffData := [][]byte{[]byte("buff1"), []byte("buff2")}
 
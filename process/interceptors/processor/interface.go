@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // InterceptedTransactionHandler defines an intercepted data wrapper over transaction handler that has
@@ -20,4 +21,10 @@ type InterceptedTransactionHandler interface {
 // ShardedPool is a perspective of the sharded data pool
 type ShardedPool interface {
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheID string)
+	ShardDataStore(cacheID string) storage.Cacher
+}
+
+// txReplacementChecker defines the caches applying a replacement policy for the transactions with the same sender and nonce
+type txReplacementChecker interface {
+	CheckReplacement(tx data.TransactionHandler, txHash []byte) error
 }
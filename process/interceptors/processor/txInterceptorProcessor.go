@@ -43,6 +43,23 @@ func (txip *TxInterceptorProcessor) Validate(data process.InterceptedData, _ cor
 	return txip.txValidator.CheckTxValidity(interceptedTx)
 }
 
+// CheckPropagation rejects, before being propagated, the transactions which would replace a pending one with the same
+// sender and nonce without paying a sufficiently higher gas price, in the same way the pool itself would do
+func (txip *TxInterceptorProcessor) CheckPropagation(data process.InterceptedData) error {
+	interceptedTx, ok := data.(InterceptedTransactionHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	checker, ok := txip.shardedPool.ShardDataStore(cacherIdentifier).(txReplacementChecker)
+	if !ok {
+		return nil
+	}
+
+	return checker.CheckReplacement(interceptedTx.Transaction(), data.Hash())
+}
+
 // Save will save the received data into the cacher
 func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID, _ string) error {
 	interceptedTx, ok := data.(InterceptedTransactionHandler)
@@ -11,7 +11,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -176,3 +179,71 @@ func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
 
 	assert.True(t, check.IfNil(txip))
 }
+
+func TestTxInterceptorProcessor_CheckPropagationUnderpricedReplacementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "0",
+		NumChunks:                  1,
+		NumBytesPerSenderThreshold: 1_000_000,
+		CountPerSenderThreshold:    100,
+		MinGasPriceBumpPercentage:  10,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       50000,
+		MinimumGasPrice:      1000000000,
+		GasProcessingDivisor: 100,
+	})
+	cache.AddTx(&txcache.WrappedTransaction{
+		Tx:     &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 7, GasPrice: 1000000000},
+		TxHash: []byte("pending"),
+	})
+
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = &testscommon.ShardedDataStub{
+		ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+			assert.Equal(t, "0", cacheID)
+			return cache
+		},
+	}
+	arg.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+			return nil
+		},
+	}
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	createInterceptedTx := func(hash string, gasPrice uint64) process.InterceptedData {
+		return &struct {
+			testscommon.InterceptedDataStub
+			mock.InterceptedTxHandlerStub
+		}{
+			InterceptedDataStub: testscommon.InterceptedDataStub{
+				HashCalled: func() []byte {
+					return []byte(hash)
+				},
+			},
+			InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+				TransactionCalled: func() data.TransactionHandler {
+					return &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 7, GasPrice: gasPrice}
+				},
+			},
+		}
+	}
+
+	err := txip.CheckPropagation(createInterceptedTx("underpriced", 1090000000))
+	assert.True(t, errors.Is(err, storage.ErrTxReplacementUnderpriced))
+	assert.Equal(t, uint64(0), cache.NumRejectedReplacements())
+
+	err = txip.CheckPropagation(createInterceptedTx("replacement", 1100000000))
+	assert.Nil(t, err)
+}
+
+func TestTxInterceptorProcessor_CheckPropagationWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txip, _ := processor.NewTxInterceptorProcessor(createMockTxArgument())
+
+	err := txip.CheckPropagation(&testscommon.InterceptedDataStub{})
+	assert.Equal(t, process.ErrWrongTypeAssertion, err)
+}
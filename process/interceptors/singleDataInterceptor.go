@@ -139,6 +139,13 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		return nil
 	}
 
+	err = sdi.checkPropagation(interceptedData)
+	if err != nil {
+		sdi.throttler.EndProcessing()
+		sdi.processDebugInterceptedData(interceptedData, err)
+		return err
+	}
+
 	go func() {
 		sdi.processInterceptedData(interceptedData, message)
 		sdi.throttler.EndProcessing()
@@ -513,6 +513,7 @@ type RequestHandler interface {
 	RequestMiniBlock(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocks(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
+	RequestTrieNodesBatch(destShardID uint32, hashes [][]byte, topic string)
 	RequestStartOfEpochMetaBlock(epoch uint32)
 	RequestInterval() time.Duration
 	SetNumPeersToQuery(key string, intra int, cross int) error
@@ -688,7 +689,8 @@ type NetworkConnectionWatcher interface {
 	IsInterfaceNil() bool
 }
 
-// SCQuery represents a prepared query for executing a function of the smart contract
+// SCQuery represents a prepared query for executing a function of the smart contract. When a block nonce or a block
+// hash is set, the query is executed against the state of that block instead of the current state
 type SCQuery struct {
 	ScAddress      []byte
 	FuncName       string
@@ -697,6 +699,15 @@ type SCQuery struct {
 	Arguments      [][]byte
 	SameScState    bool
 	ShouldBeSynced bool
+	BlockNonce     common.OptionalUint64
+	BlockHash      []byte
+	BlockRootHash  []byte
+}
+
+// SCQueryResult holds the outcome of a query executed in a batch
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
 }
 
 // GasHandler is able to perform some gas calculation
@@ -838,6 +849,7 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueries(queries []*SCQuery) ([]*SCQueryResult, []byte, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -990,6 +1002,12 @@ type AntifloodDebugger interface {
 	IsInterfaceNil() bool
 }
 
+// TopicStatisticsHandler defines the behavior of a component able to accumulate the received messages statistics per topic
+type TopicStatisticsHandler interface {
+	AddTopicStatistics(topic string, numMessages uint32, totalSize uint64)
+	IsInterfaceNil() bool
+}
+
 // PoolsCleaner defines the functionality to clean pools for old records
 type PoolsCleaner interface {
 	Close() error
@@ -18,12 +18,20 @@ type InterceptedTxHandlerStub struct {
 
 // SenderShardId -
 func (iths *InterceptedTxHandlerStub) SenderShardId() uint32 {
-	return iths.SenderShardIdCalled()
+	if iths.SenderShardIdCalled != nil {
+		return iths.SenderShardIdCalled()
+	}
+
+	return 0
 }
 
 // ReceiverShardId -
 func (iths *InterceptedTxHandlerStub) ReceiverShardId() uint32 {
-	return iths.ReceiverShardIdCalled()
+	if iths.ReceiverShardIdCalled != nil {
+		return iths.ReceiverShardIdCalled()
+	}
+
+	return 0
 }
 
 // Nonce -
@@ -43,5 +51,9 @@ func (iths *InterceptedTxHandlerStub) Fee() *big.Int {
 
 // Transaction -
 func (iths *InterceptedTxHandlerStub) Transaction() data.TransactionHandler {
-	return iths.TransactionCalled()
+	if iths.TransactionCalled != nil {
+		return iths.TransactionCalled()
+	}
+
+	return nil
 }
@@ -7,9 +7,10 @@ import (
 
 // InterceptorProcessorStub -
 type InterceptorProcessorStub struct {
-	ValidateCalled        func(data process.InterceptedData) error
-	SaveCalled            func(data process.InterceptedData) error
-	RegisterHandlerCalled func(handler func(topic string, hash []byte, data interface{}))
+	ValidateCalled         func(data process.InterceptedData) error
+	SaveCalled             func(data process.InterceptedData) error
+	CheckPropagationCalled func(data process.InterceptedData) error
+	RegisterHandlerCalled  func(handler func(topic string, hash []byte, data interface{}))
 }
 
 // Validate -
@@ -22,6 +23,15 @@ func (ips *InterceptorProcessorStub) Save(data process.InterceptedData, _ core.P
 	return ips.SaveCalled(data)
 }
 
+// CheckPropagation -
+func (ips *InterceptorProcessorStub) CheckPropagation(data process.InterceptedData) error {
+	if ips.CheckPropagationCalled != nil {
+		return ips.CheckPropagationCalled(data)
+	}
+
+	return nil
+}
+
 // RegisterHandler -
 func (ips *InterceptorProcessorStub) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if ips.RegisterHandlerCalled != nil {
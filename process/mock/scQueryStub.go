@@ -9,6 +9,7 @@ import (
 // ScQueryStub -
 type ScQueryStub struct {
 	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueriesCalled         func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
@@ -21,6 +22,14 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueries -
+func (s *ScQueryStub) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	if s.ExecuteQueriesCalled != nil {
+		return s.ExecuteQueriesCalled(queries)
+	}
+	return make([]*process.SCQueryResult, 0), nil, nil
+}
+
 // ComputeScCallGasLimit -
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitHandler != nil {
@@ -0,0 +1,18 @@
+package mock
+
+// TopicStatisticsHandlerStub -
+type TopicStatisticsHandlerStub struct {
+	AddTopicStatisticsCalled func(topic string, numMessages uint32, totalSize uint64)
+}
+
+// AddTopicStatistics -
+func (stub *TopicStatisticsHandlerStub) AddTopicStatistics(topic string, numMessages uint32, totalSize uint64) {
+	if stub.AddTopicStatisticsCalled != nil {
+		stub.AddTopicStatisticsCalled(topic, numMessages, totalSize)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TopicStatisticsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
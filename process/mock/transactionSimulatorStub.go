@@ -7,13 +7,13 @@ import (
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
-func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
 	if tss.ProcessTxCalled != nil {
-		return tss.ProcessTxCalled(tx)
+		return tss.ProcessTxCalled(tx, withTrace)
 	}
 
 	return nil, nil
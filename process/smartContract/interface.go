@@ -0,0 +1,13 @@
+package smartContract
+
+import (
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// HistoricalAccountsHandler defines the accounts adapter the historical queries read the state from, at the root hash
+// of the requested block
+type HistoricalAccountsHandler interface {
+	SetRootHash(rootHash []byte)
+	GetExistingAccount(address []byte) (vmcommon.AccountHandler, error)
+	IsInterfaceNil() bool
+}
@@ -2,23 +2,35 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
+const defaultMaxQueriesInBatch = 100
+const maxBatchExecutionAttempts = 3
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
 	vmContainer              process.VirtualMachinesContainer
@@ -28,9 +40,17 @@ type SCQueryService struct {
 	blockChain               data.ChainHandler
 	numQueries               int
 	gasForQuery              uint64
+	maxQueriesInBatch        int
 	arwenChangeLocker        common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	historicalVmContainer    process.VirtualMachinesContainer
+	historicalBlockChainHook process.BlockChainHookHandler
+	historicalAccounts       HistoricalAccountsHandler
+	storageService           dataRetriever.StorageService
+	marshaller               marshal.Marshalizer
+	uint64Converter          typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -43,6 +63,22 @@ type ArgsNewSCQueryService struct {
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
 	MaxGasLimitPerQuery      uint64
+	MaxQueriesInBatch        int
+	// HistoricalQueries holds the components executing the queries at a past block. It can be nil, in which case the
+	// historical queries are rejected
+	HistoricalQueries *ArgsHistoricalQueries
+}
+
+// ArgsHistoricalQueries defines the components needed to execute the queries at a past block. The virtual machines
+// container, its blockchain hook and the built-in functions should all read the state from the provided accounts
+type ArgsHistoricalQueries struct {
+	VmContainer              process.VirtualMachinesContainer
+	BlockChainHook           process.BlockChainHookHandler
+	Accounts                 HistoricalAccountsHandler
+	StorageService           dataRetriever.StorageService
+	Marshaller               marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -70,12 +106,22 @@ func NewSCQueryService(
 	if args.AllowExternalQueriesChan == nil {
 		return nil, process.ErrNilAllowExternalQueriesChan
 	}
+	if args.HistoricalQueries != nil {
+		err := checkArgsHistoricalQueries(args.HistoricalQueries)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	gasForQuery := uint64(math.MaxUint64)
 	if args.MaxGasLimitPerQuery > 0 {
 		gasForQuery = args.MaxGasLimitPerQuery
 	}
-	return &SCQueryService{
+	maxQueriesInBatch := defaultMaxQueriesInBatch
+	if args.MaxQueriesInBatch > 0 {
+		maxQueriesInBatch = args.MaxQueriesInBatch
+	}
+	service := &SCQueryService{
 		vmContainer:              args.VmContainer,
 		economicsFee:             args.EconomicsFee,
 		blockChain:               args.BlockChain,
@@ -83,8 +129,46 @@ func NewSCQueryService(
 		arwenChangeLocker:        args.ArwenChangeLocker,
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              gasForQuery,
+		maxQueriesInBatch:        maxQueriesInBatch,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
-	}, nil
+	}
+	if args.HistoricalQueries != nil {
+		service.historicalVmContainer = args.HistoricalQueries.VmContainer
+		service.historicalBlockChainHook = args.HistoricalQueries.BlockChainHook
+		service.historicalAccounts = args.HistoricalQueries.Accounts
+		service.storageService = args.HistoricalQueries.StorageService
+		service.marshaller = args.HistoricalQueries.Marshaller
+		service.uint64Converter = args.HistoricalQueries.Uint64ByteSliceConverter
+		service.shardCoordinator = args.HistoricalQueries.ShardCoordinator
+	}
+
+	return service, nil
+}
+
+func checkArgsHistoricalQueries(args *ArgsHistoricalQueries) error {
+	if check.IfNil(args.VmContainer) {
+		return process.ErrNoVM
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return process.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.Accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.StorageService) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+
+	return nil
 }
 
 // ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
@@ -93,11 +177,9 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 		return nil, process.ErrQueriesNotAllowedYet
 	}
 
-	if query.ScAddress == nil {
-		return nil, process.ErrNilScAddress
-	}
-	if len(query.FuncName) == 0 {
-		return nil, process.ErrEmptyFunctionName
+	err := checkQuery(query)
+	if err != nil {
+		return nil, err
 	}
 
 	service.mutRunSc.Lock()
@@ -106,6 +188,110 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+// ExecuteQueries executes the provided queries one after another, against the same state. The execution error of a
+// query is returned in its result and does not stop the others. Returns the root hash the queries were executed on.
+// When the historical queries are supported, the batch is pinned to the root hash of the current block. Otherwise, the
+// batch is executed again if a block was committed during its execution and an error is returned if the state kept
+// changing
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	if !service.shouldAllowQueriesExecution() {
+		return nil, nil, process.ErrQueriesNotAllowedYet
+	}
+	if len(queries) == 0 {
+		return nil, nil, process.ErrEmptyQueriesBatch
+	}
+	if len(queries) > service.maxQueriesInBatch {
+		return nil, nil, fmt.Errorf("%w: provided %d, maximum %d",
+			process.ErrTooManyQueriesInBatch, len(queries), service.maxQueriesInBatch)
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	if !check.IfNil(service.historicalAccounts) {
+		return service.executePinnedBatch(queries)
+	}
+
+	for attempt := 1; attempt <= maxBatchExecutionAttempts; attempt++ {
+		rootHash := service.blockChain.GetCurrentBlockRootHash()
+		results := service.executeBatch(queries, service.executeScCall)
+
+		err := service.checkForRootHashChanges(rootHash)
+		if err == nil {
+			return results, rootHash, nil
+		}
+
+		log.Debug("SCQueryService.ExecuteQueries: state changed while executing the batch",
+			"attempt", attempt, "num queries", len(queries))
+	}
+
+	return nil, nil, process.ErrStateChangedWhileExecutingVmQuery
+}
+
+// executePinnedBatch executes all the queries on the historical virtual machines container, at the root hash of the
+// current block, so the blocks committed in the meantime do not change the state the batch is executed against
+func (service *SCQueryService) executePinnedBatch(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	header := service.blockChain.GetCurrentBlockHeader()
+	rootHash := service.blockChain.GetCurrentBlockRootHash()
+
+	results := service.executeBatch(queries, func(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+		shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
+		if shouldEarlyExitBecauseOfSyncState {
+			return nil, process.ErrNodeIsNotSynced
+		}
+
+		return service.executeScCallAtRootHash(query, gasPrice, header, rootHash)
+	})
+
+	return results, rootHash, nil
+}
+
+func (service *SCQueryService) executeBatch(
+	queries []*process.SCQuery,
+	executeHandler func(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error),
+) []*process.SCQueryResult {
+	results := make([]*process.SCQueryResult, 0, len(queries))
+	for _, query := range queries {
+		results = append(results, executeQueryInBatch(query, executeHandler))
+	}
+
+	return results
+}
+
+func executeQueryInBatch(
+	query *process.SCQuery,
+	executeHandler func(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error),
+) *process.SCQueryResult {
+	err := checkQuery(query)
+	if err != nil {
+		return &process.SCQueryResult{Err: err}
+	}
+	if isHistoricalQuery(query) {
+		return &process.SCQueryResult{Err: process.ErrHistoricalQueryNotAllowedInBatch}
+	}
+
+	vmOutput, err := executeHandler(query, 0)
+
+	return &process.SCQueryResult{
+		VMOutput: vmOutput,
+		Err:      err,
+	}
+}
+
+func checkQuery(query *process.SCQuery) error {
+	if query == nil {
+		return process.ErrNilSCQuery
+	}
+	if query.ScAddress == nil {
+		return process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return process.ErrEmptyFunctionName
+	}
+
+	return nil
+}
+
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	select {
 	case <-service.allowExternalQueriesChan:
@@ -124,6 +310,10 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, process.ErrNodeIsNotSynced
 	}
 
+	if isHistoricalQuery(query) {
+		return service.executeHistoricalScCall(query, gasPrice)
+	}
+
 	shouldCheckRootHashChanges := query.SameScState
 	rootHashBeforeExecution := make([]byte, 0)
 
@@ -133,8 +323,118 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
 
+	vmOutput, err := service.runSmartContractCall(service.vmContainer, query, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.SameScState {
+		err = service.checkForRootHashChanges(rootHashBeforeExecution)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vmOutput, nil
+}
+
+// executeHistoricalScCall executes the query on the historical virtual machines container, whose blockchain hook and
+// built-in functions read all the accounts at the root hash of the requested block
+func (service *SCQueryService) executeHistoricalScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+	if check.IfNil(service.historicalAccounts) {
+		return nil, process.ErrHistoricalQueriesNotSupported
+	}
+
+	header, rootHash, err := service.getHistoricalBlockHeaderAndRootHash(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.executeScCallAtRootHash(query, gasPrice, header, rootHash)
+}
+
+// executeScCallAtRootHash executes the query on the historical virtual machines container, with all the accounts read
+// at the provided root hash
+func (service *SCQueryService) executeScCallAtRootHash(
+	query *process.SCQuery,
+	gasPrice uint64,
+	header data.HeaderHandler,
+	rootHash []byte,
+) (*vmcommon.VMOutput, error) {
+	service.historicalAccounts.SetRootHash(rootHash)
+	_, err := service.historicalAccounts.GetExistingAccount(query.ScAddress)
+	if errors.Is(err, state.ErrRootHashNotAvailable) {
+		return nil, fmt.Errorf("%w, root hash: %s, reason: %s",
+			process.ErrHistoricalStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	service.historicalBlockChainHook.SetCurrentHeader(header)
+
+	// a partly pruned state surfaces as missing trie nodes, either as an error or in the message of the VM output
+	vmOutput, err := service.runSmartContractCall(service.historicalVmContainer, query, gasPrice)
+	if err == nil && vmOutput != nil && isGetNodeFromDBError(vmOutput.ReturnMessage) {
+		err = errors.New(vmOutput.ReturnMessage)
+	}
+	if err != nil && isGetNodeFromDBError(err.Error()) {
+		return nil, fmt.Errorf("%w, root hash: %s, reason: %s",
+			process.ErrHistoricalStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	return vmOutput, err
+}
+
+// getHistoricalBlockHeaderAndRootHash returns the header providing the block information to the contract and the root
+// hash of the state the query is executed against. When only the root hash is provided, the block is not known, so the
+// current block header is used for the block information
+func (service *SCQueryService) getHistoricalBlockHeaderAndRootHash(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
+	if len(query.BlockRootHash) > 0 {
+		return service.blockChain.GetCurrentBlockHeader(), query.BlockRootHash, nil
+	}
+
+	header, err := service.getHistoricalBlockHeader(query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", process.ErrHistoricalBlockNotFound, err.Error())
+	}
+
+	return header, header.GetRootHash(), nil
+}
+
+func isGetNodeFromDBError(message string) bool {
+	return strings.Contains(message, common.GetNodeFromDBErrorString)
+}
+
+func (service *SCQueryService) getHistoricalBlockHeader(query *process.SCQuery) (data.HeaderHandler, error) {
+	selfShardID := service.shardCoordinator.SelfId()
+	if query.BlockNonce.HasValue {
+		header, _, err := process.GetHeaderFromStorageWithNonce(
+			query.BlockNonce.Value,
+			selfShardID,
+			service.storageService,
+			service.uint64Converter,
+			service.marshaller,
+		)
+		return header, err
+	}
+
+	if selfShardID == core.MetachainShardId {
+		header, err := process.GetMetaHeaderFromStorage(query.BlockHash, service.marshaller, service.storageService)
+		if err != nil {
+			return nil, err
+		}
+
+		return header, nil
+	}
+
+	return process.GetShardHeaderFromStorage(query.BlockHash, service.marshaller, service.storageService)
+}
+
+func (service *SCQueryService) runSmartContractCall(
+	vmContainer process.VirtualMachinesContainer,
+	query *process.SCQuery,
+	gasPrice uint64,
+) (*vmcommon.VMOutput, error) {
 	service.arwenChangeLocker.RLock()
-	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
+	vm, err := findVMByScAddress(vmContainer, query.ScAddress)
 	if err != nil {
 		service.arwenChangeLocker.RUnlock()
 		return nil, err
@@ -157,16 +457,13 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		}
 	}
 
-	if query.SameScState {
-		err = service.checkForRootHashChanges(rootHashBeforeExecution)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	return vmOutput, nil
 }
 
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return query.BlockNonce.HasValue || len(query.BlockHash) > 0 || len(query.BlockRootHash) > 0
+}
+
 func (service *SCQueryService) checkForRootHashChanges(rootHashBefore []byte) error {
 	rootHashAfter := service.blockChain.GetCurrentBlockRootHash()
 
@@ -250,7 +547,17 @@ func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction
 
 // Close closes all underlying components
 func (service *SCQueryService) Close() error {
-	return service.vmContainer.Close()
+	err := service.vmContainer.Close()
+	if check.IfNil(service.historicalVmContainer) {
+		return err
+	}
+
+	errHistorical := service.historicalVmContainer.Close()
+	if err != nil {
+		return err
+	}
+
+	return errHistorical
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
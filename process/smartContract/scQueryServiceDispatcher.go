@@ -47,6 +47,16 @@ func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmc
 	return sqsd.list[index].ExecuteQuery(query)
 }
 
+// ExecuteQueries will call this method on one of the element from provided list
+func (sqsd *scQueryServiceDispatcher) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+	index := sqsd.getNewIndex()
+
+	sqsd.mutList.RLock()
+	defer sqsd.mutList.RUnlock()
+
+	return sqsd.list[index].ExecuteQueries(queries)
+}
+
 // ComputeScCallGasLimit will call this method on one of the element from provided list
 func (sqsd *scQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	index := sqsd.getNewIndex()
@@ -80,6 +80,36 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 	assert.Equal(t, 1, calledElement2)
 }
 
+func TestScQueryServiceDispatcher_ExecuteQueriesShouldCallInRoundRobinFashion(t *testing.T) {
+	t.Parallel()
+
+	calledElement1 := 0
+	calledElement2 := 0
+	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+				calledElement1++
+
+				return nil, nil, nil
+			},
+		},
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, []byte, error) {
+				calledElement2++
+
+				return nil, nil, nil
+			},
+		},
+	})
+
+	_, _, _ = sqsd.ExecuteQueries(nil)
+	_, _, _ = sqsd.ExecuteQueries(nil)
+	_, _, _ = sqsd.ExecuteQueries(nil)
+
+	assert.Equal(t, 2, calledElement1)
+	assert.Equal(t, 1, calledElement2)
+}
+
 func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFashion(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package smartContract
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -13,10 +14,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -657,6 +662,452 @@ func TestSCQueryService_ShouldWorkIfStateDidntChange(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestSCQueryService_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("queries not allowed yet should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.AllowExternalQueriesChan = make(chan struct{})
+		qs, _ := NewSCQueryService(args)
+
+		results, rootHash, err := qs.ExecuteQueries([]*process.SCQuery{{ScAddress: []byte(DummyScAddress), FuncName: "function"}})
+		assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
+		assert.Nil(t, results)
+		assert.Nil(t, rootHash)
+	})
+	t.Run("empty batch should err", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := qs.ExecuteQueries(nil)
+		assert.Equal(t, process.ErrEmptyQueriesBatch, err)
+		assert.Nil(t, results)
+	})
+	t.Run("too many queries should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.MaxQueriesInBatch = 2
+		qs, _ := NewSCQueryService(args)
+
+		query := &process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "function"}
+		results, _, err := qs.ExecuteQueries([]*process.SCQuery{query, query, query})
+		assert.True(t, errors.Is(err, process.ErrTooManyQueriesInBatch))
+		assert.Nil(t, results)
+	})
+	t.Run("state changed once should execute the batch again on the new state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		numRootHashCalls := 0
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				numRootHashCalls++
+				if numRootHashCalls == 1 {
+					return []byte("first root hash")
+				}
+
+				return []byte("second root hash")
+			},
+		}
+		numExecutions := 0
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+						numExecutions++
+						return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+					},
+				}, nil
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		query := &process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "function"}
+		results, rootHash, err := qs.ExecuteQueries([]*process.SCQuery{query, query})
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(results))
+		assert.Equal(t, []byte("second root hash"), rootHash)
+		assert.Equal(t, 4, numExecutions)
+	})
+	t.Run("state changing on every execution should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		numRootHashCalls := 0
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				numRootHashCalls++
+				return []byte(fmt.Sprintf("root hash %d", numRootHashCalls))
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		query := &process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "function"}
+		results, rootHash, err := qs.ExecuteQueries([]*process.SCQuery{query, query})
+		assert.True(t, errors.Is(err, process.ErrStateChangedWhileExecutingVmQuery))
+		assert.Nil(t, results)
+		assert.Nil(t, rootHash)
+		assert.Equal(t, 2*maxBatchExecutionAttempts, numRootHashCalls)
+	})
+	t.Run("should return the result of each query", func(t *testing.T) {
+		t.Parallel()
+
+		errVM := errors.New("vm error")
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				if input.Function == "failing" {
+					return nil, errVM
+				}
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{[]byte(input.Function)},
+				}, nil
+			},
+		}
+
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return mockVM, nil
+			},
+		}
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		queries := []*process.SCQuery{
+			{ScAddress: []byte(DummyScAddress), FuncName: "first"},
+			{ScAddress: []byte(DummyScAddress), FuncName: "failing"},
+			{ScAddress: []byte(DummyScAddress)},
+			nil,
+			{ScAddress: []byte(DummyScAddress), FuncName: "last"},
+		}
+		results, rootHash, err := qs.ExecuteQueries(queries)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("root hash"), rootHash)
+		require.Equal(t, len(queries), len(results))
+
+		assert.Nil(t, results[0].Err)
+		assert.Equal(t, [][]byte{[]byte("first")}, results[0].VMOutput.ReturnData)
+		assert.Equal(t, errVM, results[1].Err)
+		assert.Nil(t, results[1].VMOutput)
+		assert.Equal(t, process.ErrEmptyFunctionName, results[2].Err)
+		assert.Equal(t, process.ErrNilSCQuery, results[3].Err)
+		assert.Nil(t, results[4].Err)
+		assert.Equal(t, [][]byte{[]byte("last")}, results[4].VMOutput.ReturnData)
+	})
+}
+
+func createMockArgumentsForHistoricalQueries() *ArgsHistoricalQueries {
+	return &ArgsHistoricalQueries{
+		VmContainer:              &mock.VMContainerMock{},
+		BlockChainHook:           &mock.BlockChainHookHandlerMock{},
+		Accounts:                 createHistoricalAccounts(&stateMock.AccountsAdapterWithHistoryStub{}),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshaller:               &mock.MarshalizerMock{},
+		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         mock.NewOneShardCoordinatorMock(),
+	}
+}
+
+func createHistoricalAccounts(accountsWithHistory state.AccountsAdapterWithHistory) HistoricalAccountsHandler {
+	historicalAccounts, _ := state.NewAccountsDBApiAtRootHash(accountsWithHistory)
+	return historicalAccounts
+}
+
+func TestNewSCQueryService_InvalidHistoricalQueriesArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	testNewSCQueryServiceWithHistoricalQueries := func(changeArgs func(args *ArgsHistoricalQueries), expectedErr error) {
+		args := createMockArgumentsForSCQuery()
+		args.HistoricalQueries = createMockArgumentsForHistoricalQueries()
+		changeArgs(args.HistoricalQueries)
+		target, err := NewSCQueryService(args)
+
+		assert.Nil(t, target)
+		assert.Equal(t, expectedErr, err)
+	}
+
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.VmContainer = nil
+	}, process.ErrNoVM)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.BlockChainHook = nil
+	}, process.ErrNilBlockChainHook)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.Accounts = nil
+	}, process.ErrNilAccountsAdapter)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.StorageService = nil
+	}, process.ErrNilStorage)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.Marshaller = nil
+	}, process.ErrNilMarshalizer)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.Uint64ByteSliceConverter = nil
+	}, process.ErrNilUint64Converter)
+	testNewSCQueryServiceWithHistoricalQueries(func(args *ArgsHistoricalQueries) {
+		args.ShardCoordinator = nil
+	}, process.ErrNilShardCoordinator)
+}
+
+func TestSCQueryService_ExecuteHistoricalQuery(t *testing.T) {
+	t.Parallel()
+
+	historicalHeader := &block.Header{Nonce: 7, RootHash: []byte("historical root hash")}
+	historicalHeaderHash := []byte("historical header hash")
+	marshaller := &mock.MarshalizerMock{}
+	uint64Converter := uint64ByteSlice.NewBigEndianConverter()
+
+	createStorageService := func() *genericMocks.ChainStorerMock {
+		storageService := genericMocks.NewChainStorerMock(0)
+		headerBytes, _ := marshaller.Marshal(historicalHeader)
+		_ = storageService.HdrNonce.Put(historicalHeaderHash, headerBytes)
+		_ = storageService.HdrNonce.Put(uint64Converter.ToByteSlice(historicalHeader.Nonce), historicalHeaderHash)
+
+		return storageService
+	}
+	createVmContainer := func(runSmartContractCall func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)) process.VirtualMachinesContainer {
+		return &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: runSmartContractCall,
+				}, nil
+			},
+		}
+	}
+	createArgs := func() ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = createVmContainer(func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not executed the query on the current state")
+			return nil, nil
+		})
+		args.HistoricalQueries = createMockArgumentsForHistoricalQueries()
+		args.HistoricalQueries.StorageService = createStorageService()
+		args.HistoricalQueries.Marshaller = marshaller
+		args.HistoricalQueries.Uint64ByteSliceConverter = uint64Converter
+		args.HistoricalQueries.VmContainer = createVmContainer(func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		})
+
+		return args
+	}
+
+	t.Run("historical queries not set up should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.HistoricalQueries = nil
+		qs, _ := NewSCQueryService(args)
+
+		vmOutput, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:  []byte(DummyScAddress),
+			FuncName:   "function",
+			BlockNonce: common.OptionalUint64{Value: historicalHeader.Nonce, HasValue: true},
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+	})
+	t.Run("block not found should err", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createArgs())
+
+		vmOutput, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: []byte("missing header hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.True(t, errors.Is(err, process.ErrHistoricalBlockNotFound))
+	})
+	t.Run("state not available should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.HistoricalQueries.Accounts = createHistoricalAccounts(&stateMock.AccountsAdapterWithHistoryStub{
+			GetAccountWithRootHashCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+				return nil, fmt.Errorf("%w: trie pruned", state.ErrRootHashNotAvailable)
+			},
+		})
+		args.HistoricalQueries.VmContainer = createVmContainer(func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not executed the query")
+			return nil, nil
+		})
+		qs, _ := NewSCQueryService(args)
+
+		vmOutput, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: historicalHeaderHash,
+		})
+		assert.Nil(t, vmOutput)
+		assert.True(t, errors.Is(err, process.ErrHistoricalStateNotAvailable))
+	})
+	t.Run("should execute on the historical container against the state of the block", func(t *testing.T) {
+		t.Parallel()
+
+		readRootHashes := make([][]byte, 0)
+		historicalAccounts := createHistoricalAccounts(&stateMock.AccountsAdapterWithHistoryStub{
+			GetAccountWithRootHashCalled: func(_ []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+				readRootHashes = append(readRootHashes, rootHash)
+				return &stateMock.UserAccountStub{}, nil
+			},
+		})
+
+		var headerDuringExecution data.HeaderHandler
+		args := createArgs()
+		args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				assert.Fail(t, "should have not changed the header of the current state hook")
+			},
+		}
+		args.HistoricalQueries.Accounts = historicalAccounts
+		args.HistoricalQueries.BlockChainHook = &mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				headerDuringExecution = hdr
+			},
+		}
+		args.HistoricalQueries.VmContainer = createVmContainer(func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			// the built-in functions and the blockchain hook read through the same historical accounts
+			_, err := historicalAccounts.GetExistingAccount(input.RecipientAddr)
+			assert.Nil(t, err)
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		})
+		qs, _ := NewSCQueryService(args)
+
+		for _, query := range []*process.SCQuery{
+			{ScAddress: []byte(DummyScAddress), FuncName: "function", BlockHash: historicalHeaderHash},
+			{ScAddress: []byte(DummyScAddress), FuncName: "function", BlockNonce: common.OptionalUint64{Value: historicalHeader.Nonce, HasValue: true}},
+		} {
+			readRootHashes = make([][]byte, 0)
+			headerDuringExecution = nil
+
+			vmOutput, err := qs.ExecuteQuery(query)
+			require.Nil(t, err)
+			require.NotNil(t, vmOutput)
+			assert.Equal(t, [][]byte{historicalHeader.RootHash, historicalHeader.RootHash}, readRootHashes)
+			assert.Equal(t, historicalHeader.Nonce, headerDuringExecution.GetNonce())
+		}
+	})
+	t.Run("block root hash should execute against the state of the root hash", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash := []byte("requested root hash")
+		currentHeader := &block.Header{Nonce: 100}
+		var readRootHash []byte
+		var headerDuringExecution data.HeaderHandler
+		args := createArgs()
+		args.HistoricalQueries.Accounts = createHistoricalAccounts(&stateMock.AccountsAdapterWithHistoryStub{
+			GetAccountWithRootHashCalled: func(_ []byte, providedRootHash []byte) (vmcommon.AccountHandler, error) {
+				readRootHash = providedRootHash
+				return &stateMock.UserAccountStub{}, nil
+			},
+		})
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}
+		args.HistoricalQueries.BlockChainHook = &mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				headerDuringExecution = hdr
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		vmOutput, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:     []byte(DummyScAddress),
+			FuncName:      "function",
+			BlockRootHash: rootHash,
+		})
+		require.Nil(t, err)
+		require.NotNil(t, vmOutput)
+		assert.Equal(t, rootHash, readRootHash)
+		assert.True(t, headerDuringExecution == currentHeader)
+	})
+	t.Run("partly pruned state should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.HistoricalQueries.VmContainer = createVmContainer(func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.ExecutionFailed,
+				ReturnMessage: common.GetNodeFromDBErrorString + " key not found for key aabb",
+			}, nil
+		})
+		qs, _ := NewSCQueryService(args)
+
+		vmOutput, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: historicalHeaderHash,
+		})
+		assert.Nil(t, vmOutput)
+		assert.True(t, errors.Is(err, process.ErrHistoricalStateNotAvailable))
+	})
+	t.Run("batch should be pinned to the root hash of the current block", func(t *testing.T) {
+		t.Parallel()
+
+		currentHeader := &block.Header{Nonce: 100}
+		readRootHashes := make([][]byte, 0)
+		args := createArgs()
+		args.HistoricalQueries.Accounts = createHistoricalAccounts(&stateMock.AccountsAdapterWithHistoryStub{
+			GetAccountWithRootHashCalled: func(_ []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+				readRootHashes = append(readRootHashes, rootHash)
+				return &stateMock.UserAccountStub{}, nil
+			},
+		})
+		numRootHashCalls := 0
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+			GetCurrentBlockRootHashCalled: func() []byte {
+				numRootHashCalls++
+				return []byte(fmt.Sprintf("root hash %d", numRootHashCalls))
+			},
+		}
+		var headerDuringExecution data.HeaderHandler
+		args.HistoricalQueries.BlockChainHook = &mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				headerDuringExecution = hdr
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		query := &process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "function"}
+		results, rootHash, err := qs.ExecuteQueries([]*process.SCQuery{query, query})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(results))
+		assert.Nil(t, results[0].Err)
+		assert.Nil(t, results[1].Err)
+		assert.Equal(t, []byte("root hash 1"), rootHash)
+		assert.Equal(t, [][]byte{rootHash, rootHash}, readRootHashes)
+		assert.True(t, headerDuringExecution == currentHeader)
+	})
+	t.Run("historical query in batch should err", func(t *testing.T) {
+		t.Parallel()
+
+		qs, _ := NewSCQueryService(createArgs())
+
+		results, _, err := qs.ExecuteQueries([]*process.SCQuery{
+			{ScAddress: []byte(DummyScAddress), FuncName: "function", BlockHash: historicalHeaderHash},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(results))
+		assert.Equal(t, process.ErrHistoricalQueryNotAllowedInBatch, results[0].Err)
+	})
+}
+
 func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	t.Parallel()
 
@@ -753,3 +1204,23 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+func TestNewSCQueryService_CloseShouldCloseTheHistoricalVmContainer(t *testing.T) {
+	t.Parallel()
+
+	historicalCloseCalled := false
+	args := createMockArgumentsForSCQuery()
+	args.HistoricalQueries = createMockArgumentsForHistoricalQueries()
+	args.HistoricalQueries.VmContainer = &mock.VMContainerMock{
+		CloseCalled: func() error {
+			historicalCloseCalled = true
+			return nil
+		},
+	}
+
+	target, _ := NewSCQueryService(args)
+
+	err := target.Close()
+	assert.Nil(t, err)
+	assert.True(t, historicalCloseCalled)
+}
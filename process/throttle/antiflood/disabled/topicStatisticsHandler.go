@@ -0,0 +1,14 @@
+package disabled
+
+// TopicStatisticsHandler is a disabled instance of the topic statistics handler
+type TopicStatisticsHandler struct {
+}
+
+// AddTopicStatistics does nothing
+func (tsh *TopicStatisticsHandler) AddTopicStatistics(_ string, _ uint32, _ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsh *TopicStatisticsHandler) IsInterfaceNil() bool {
+	return tsh == nil
+}
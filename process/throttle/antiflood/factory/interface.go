@@ -0,0 +1,7 @@
+package factory
+
+// topicStatisticsPublisher defines the component able to send the accumulated topic statistics to the status handler
+type topicStatisticsPublisher interface {
+	PublishStatistics()
+	IsInterfaceNil() bool
+}
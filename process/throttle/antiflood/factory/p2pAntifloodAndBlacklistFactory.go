@@ -130,8 +130,19 @@ func initP2PAntiFloodComponents(
 		return nil, err
 	}
 
+	topicStatisticsProcessor, err := p2pQuota.NewP2PTopicStatisticsProcessor(statusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p2pAntiflood.SetTopicStatisticsHandler(topicStatisticsProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, topicMaxMessages)
 	startSweepingTimeCaches(ctx, p2pPeerBlackList, publicKeysCache)
+	startPublishingTopicStatistics(ctx, topicStatisticsProcessor)
 
 	return &AntiFloodComponents{
 		AntiFloodHandler: p2pAntiflood,
@@ -181,6 +192,21 @@ func startResettingTopicFloodPreventer(
 	}()
 }
 
+func startPublishingTopicStatistics(ctx context.Context, topicStatisticsProcessor topicStatisticsPublisher) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("startPublishingTopicStatistics's go routine is stopping...")
+				return
+			case <-time.After(time.Second):
+			}
+
+			topicStatisticsProcessor.PublishStatistics()
+		}
+	}()
+}
+
 func startSweepingTimeCaches(ctx context.Context, p2pPeerBlackList process.PeerBlackListCacher, publicKeysCache process.TimeCacher) {
 	go func() {
 		for {
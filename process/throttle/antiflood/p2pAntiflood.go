@@ -27,6 +27,8 @@ type p2pAntiflood struct {
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
+	mutTopicStatistics  sync.RWMutex
+	topicStatistics     process.TopicStatisticsHandler
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -54,6 +56,7 @@ func NewP2PAntiflood(
 		debugger:            &disabled.AntifloodDebugger{},
 		mapTopicsFromAll:    make(map[string]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+		topicStatistics:     &disabled.TopicStatisticsHandler{},
 	}, nil
 }
 
@@ -181,6 +184,10 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 
 // CanProcessMessagesOnTopic signals if a p2p message can be processed or not for a given topic
 func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+	af.mutTopicStatistics.RLock()
+	af.topicStatistics.AddTopicStatistics(topic, numMessages, totalSize)
+	af.mutTopicStatistics.RUnlock()
+
 	err := af.topicPreventer.IncreaseLoad(peer, topic, numMessages)
 	if err != nil {
 		log.Trace("topicFloodPreventer.Accumulate peer",
@@ -231,6 +238,19 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 	return nil
 }
 
+// SetTopicStatisticsHandler sets the component which accumulates the received messages statistics per topic
+func (af *p2pAntiflood) SetTopicStatisticsHandler(handler process.TopicStatisticsHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilTopicStatisticsHandler
+	}
+
+	af.mutTopicStatistics.Lock()
+	af.topicStatistics = handler
+	af.mutTopicStatistics.Unlock()
+
+	return nil
+}
+
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
@@ -355,6 +355,52 @@ func TestP2pAntiflood_SetDebuggerShouldWork(t *testing.T) {
 	assert.True(t, afm.Debugger() == debugger)
 }
 
+func TestP2pAntiflood_SetTopicStatisticsHandlerNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetTopicStatisticsHandler(nil)
+	assert.Equal(t, process.ErrNilTopicStatisticsHandler, err)
+}
+
+func TestP2pAntiflood_CanProcessMessagesOnTopicShouldAddTopicStatistics(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				return errors.New("topic load exceeded")
+			},
+		},
+		&mock.FloodPreventerStub{},
+	)
+
+	topic := "topic"
+	numMessages := uint32(2)
+	totalSize := uint64(100)
+	numCalls := 0
+	err := afm.SetTopicStatisticsHandler(&mock.TopicStatisticsHandlerStub{
+		AddTopicStatisticsCalled: func(receivedTopic string, receivedNumMessages uint32, receivedTotalSize uint64) {
+			numCalls++
+			assert.Equal(t, topic, receivedTopic)
+			assert.Equal(t, numMessages, receivedNumMessages)
+			assert.Equal(t, totalSize, receivedTotalSize)
+		},
+	})
+	assert.Nil(t, err)
+
+	// the statistics are recorded also for the rejected messages
+	err = afm.CanProcessMessagesOnTopic("pid", topic, numMessages, totalSize, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
 func TestP2pAntiflood_Close(t *testing.T) {
 	t.Parallel()
 
@@ -121,7 +121,7 @@ func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Tra
 		return nil, err
 	}
 
-	res, err := tce.txSimulator.ProcessTx(tx)
+	res, err := tce.txSimulator.ProcessTx(tx, false)
 	if err != nil {
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -117,7 +117,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 			return consumedGasUnits
 		},
 	}, &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			return &txSimData.SimulationResults{}, nil
 		},
 	}, &stateMock.AccountsStub{
@@ -151,7 +151,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 			return consumedGasUnits
 		},
 	}, &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 			return nil, simulationErr
 		},
 	}, &stateMock.AccountsStub{
@@ -180,7 +180,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{
 					VMOutput: &vmcommon.VMOutput{
 						ReturnCode:   vmcommon.Ok,
@@ -214,7 +214,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 				return nil, localErr
 			},
 		}, &stateMock.AccountsStub{
@@ -242,7 +242,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{}, nil
 			},
 		}, &stateMock.AccountsStub{
@@ -270,7 +270,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{
 					VMOutput: &vmcommon.VMOutput{
 						ReturnCode: vmcommon.UserError,
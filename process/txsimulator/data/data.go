@@ -12,5 +12,45 @@ type SimulationResults struct {
 	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                                         `json:"hash,omitempty"`
+	Trace      *ExecutionTrace                                `json:"trace,omitempty"`
 	VMOutput   *vmcommon.VMOutput                             `json:"-"`
 }
+
+// ExecutionTrace holds the trace of a simulated transaction's execution. The first step is the transaction itself and
+// it is followed by the transfers and calls found in the VM output, grouped by their receiver. The VM output records
+// neither the call that generated a transfer nor the gas consumed by each call, so the steps are not nested and the gas
+// used is reported only for the whole transaction and for each account
+type ExecutionTrace struct {
+	GasUsed       uint64          `json:"gasUsed"`
+	ReturnCode    string          `json:"returnCode,omitempty"`
+	ReturnMessage string          `json:"returnMessage,omitempty"`
+	Steps         []*TraceStep    `json:"steps"`
+	Accounts      []*AccountTrace `json:"accounts,omitempty"`
+}
+
+// TraceStep is a call or a transfer of the execution trace
+type TraceStep struct {
+	Type          string   `json:"type"`
+	Sender        string   `json:"sender"`
+	Receiver      string   `json:"receiver"`
+	Value         string   `json:"value,omitempty"`
+	Function      string   `json:"function,omitempty"`
+	Arguments     []string `json:"arguments,omitempty"`
+	GasLimit      uint64   `json:"gasLimit"`
+	ReturnMessage string   `json:"returnMessage,omitempty"`
+	ScResultHash  string   `json:"scResultHash,omitempty"`
+}
+
+// AccountTrace holds the gas consumed, the balance change and the storage writes of an account touched by the execution
+type AccountTrace struct {
+	Address       string          `json:"address"`
+	BalanceDelta  string          `json:"balanceDelta,omitempty"`
+	GasUsed       uint64          `json:"gasUsed"`
+	StorageWrites []*StorageWrite `json:"storageWrites,omitempty"`
+}
+
+// StorageWrite holds a hex encoded key-value pair written in an account's storage
+type StorageWrite struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
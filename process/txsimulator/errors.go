@@ -25,3 +25,6 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilBuiltInFunctionsContainer signals that a nil built-in functions container has been provided
+var ErrNilBuiltInFunctionsContainer = errors.New("nil built-in functions container")
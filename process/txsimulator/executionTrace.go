@@ -0,0 +1,256 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	traceStepTransfer            = "transfer"
+	traceStepSCDeploy            = "scDeploy"
+	traceStepSCCall              = "scCall"
+	traceStepBuiltInFunction     = "builtInFunction"
+	traceStepAsyncCall           = "asyncCall"
+	traceStepAsyncCallback       = "asyncCallback"
+	traceStepSmartContractResult = "smartContractResult"
+)
+
+type traceCall struct {
+	sender   []byte
+	receiver []byte
+	value    *big.Int
+	data     []byte
+	gasLimit uint64
+	callType vm.CallType
+}
+
+// createExecutionTrace builds the execution trace of the simulated transaction from its VM output and the generated
+// smart contract results
+func (ts *transactionSimulator) createExecutionTrace(
+	tx *transaction.Transaction,
+	vmOutput *vmcommon.VMOutput,
+	scResults map[string]*transaction.ApiSmartContractResult,
+) *txSimData.ExecutionTrace {
+	txStep := ts.createTraceStep(traceCall{
+		sender:   tx.SndAddr,
+		receiver: tx.RcvAddr,
+		value:    tx.Value,
+		data:     tx.Data,
+		gasLimit: tx.GasLimit,
+		callType: vm.DirectCall,
+	})
+
+	trace := &txSimData.ExecutionTrace{
+		Steps: []*txSimData.TraceStep{txStep},
+	}
+	if vmOutput == nil {
+		return trace
+	}
+
+	if vmOutput.GasRemaining <= tx.GasLimit {
+		trace.GasUsed = tx.GasLimit - vmOutput.GasRemaining
+	}
+	trace.ReturnCode = vmOutput.ReturnCode.String()
+	trace.ReturnMessage = vmOutput.ReturnMessage
+
+	outputAccounts := sortedOutputAccounts(vmOutput)
+	trace.Steps = append(trace.Steps, ts.createTransferSteps(outputAccounts)...)
+	trace.Steps = ts.addSmartContractResults(trace.Steps, scResults)
+	trace.Accounts = ts.createAccountsTrace(outputAccounts)
+
+	return trace
+}
+
+func (ts *transactionSimulator) createTransferSteps(outputAccounts []*vmcommon.OutputAccount) []*txSimData.TraceStep {
+	steps := make([]*txSimData.TraceStep, 0)
+	for _, outAcc := range outputAccounts {
+		for _, outTransfer := range outAcc.OutputTransfers {
+			steps = append(steps, ts.createTraceStep(traceCall{
+				sender:   outTransfer.SenderAddress,
+				receiver: outAcc.Address,
+				value:    outTransfer.Value,
+				data:     outTransfer.Data,
+				gasLimit: outTransfer.GasLimit,
+				callType: outTransfer.CallType,
+			}))
+		}
+	}
+
+	return steps
+}
+
+// addSmartContractResults links every generated smart contract result to the transfer it was created from. The ones
+// that can not be matched (e.g. gas refunds) are added as separate steps
+func (ts *transactionSimulator) addSmartContractResults(
+	steps []*txSimData.TraceStep,
+	scResults map[string]*transaction.ApiSmartContractResult,
+) []*txSimData.TraceStep {
+	hashes := make([]string, 0, len(scResults))
+	for hash := range scResults {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	// the first step is the transaction itself, which is not a smart contract result
+	transferSteps := steps[1:]
+	for _, hash := range hashes {
+		scr := scResults[hash]
+		step := findUnlinkedStep(transferSteps, scr)
+		if step != nil {
+			step.ScResultHash = hash
+			continue
+		}
+
+		steps = append(steps, &txSimData.TraceStep{
+			Type:          traceStepSmartContractResult,
+			Sender:        scr.SndAddr,
+			Receiver:      scr.RcvAddr,
+			Value:         bigIntToString(scr.Value),
+			GasLimit:      scr.GasLimit,
+			ReturnMessage: scr.ReturnMessage,
+			ScResultHash:  hash,
+		})
+	}
+
+	return steps
+}
+
+func findUnlinkedStep(steps []*txSimData.TraceStep, scr *transaction.ApiSmartContractResult) *txSimData.TraceStep {
+	for _, step := range steps {
+		isMatch := step.ScResultHash == "" &&
+			step.Sender == scr.SndAddr &&
+			step.Receiver == scr.RcvAddr &&
+			step.GasLimit == scr.GasLimit
+		if isMatch {
+			return step
+		}
+	}
+
+	return nil
+}
+
+func (ts *transactionSimulator) createAccountsTrace(outputAccounts []*vmcommon.OutputAccount) []*txSimData.AccountTrace {
+	accounts := make([]*txSimData.AccountTrace, 0, len(outputAccounts))
+	for _, outAcc := range outputAccounts {
+		accounts = append(accounts, &txSimData.AccountTrace{
+			Address:       ts.encodeAddress(outAcc.Address),
+			BalanceDelta:  bigIntToString(outAcc.BalanceDelta),
+			GasUsed:       outAcc.GasUsed,
+			StorageWrites: createStorageWrites(outAcc.StorageUpdates),
+		})
+	}
+
+	return accounts
+}
+
+func createStorageWrites(storageUpdates map[string]*vmcommon.StorageUpdate) []*txSimData.StorageWrite {
+	writes := make([]*txSimData.StorageWrite, 0, len(storageUpdates))
+	for _, update := range storageUpdates {
+		if update == nil || !update.Written {
+			continue
+		}
+
+		writes = append(writes, &txSimData.StorageWrite{
+			Key:   hex.EncodeToString(update.Offset),
+			Value: hex.EncodeToString(update.Data),
+		})
+	}
+
+	sort.Slice(writes, func(i, j int) bool {
+		return writes[i].Key < writes[j].Key
+	})
+
+	return writes
+}
+
+func (ts *transactionSimulator) createTraceStep(call traceCall) *txSimData.TraceStep {
+	step := &txSimData.TraceStep{
+		Sender:   ts.encodeAddress(call.sender),
+		Receiver: ts.encodeAddress(call.receiver),
+		Value:    bigIntToString(call.value),
+		GasLimit: call.gasLimit,
+	}
+
+	isDeploy := call.callType == vm.DirectCall && len(call.data) > 0 &&
+		bytes.Equal(call.receiver, make([]byte, ts.addressPubKeyConverter.Len()))
+	if isDeploy {
+		step.Type = traceStepSCDeploy
+		return step
+	}
+
+	function, args, err := ts.argsParser.ParseData(string(call.data))
+	if err == nil {
+		step.Function = function
+		step.Arguments = make([]string, 0, len(args))
+		for _, arg := range args {
+			step.Arguments = append(step.Arguments, hex.EncodeToString(arg))
+		}
+	}
+
+	step.Type = ts.getTraceStepType(call, step.Function)
+
+	return step
+}
+
+func (ts *transactionSimulator) getTraceStepType(call traceCall, function string) string {
+	switch call.callType {
+	case vm.AsynchronousCall:
+		return traceStepAsyncCall
+	case vm.AsynchronousCallBack:
+		return traceStepAsyncCallback
+	}
+
+	if len(function) == 0 {
+		return traceStepTransfer
+	}
+
+	_, err := ts.builtInFunctions.Get(function)
+	if err == nil {
+		return traceStepBuiltInFunction
+	}
+	if core.IsSmartContractAddress(call.receiver) {
+		return traceStepSCCall
+	}
+
+	return traceStepTransfer
+}
+
+func (ts *transactionSimulator) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return ts.addressPubKeyConverter.Encode(address)
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if outAcc == nil {
+			continue
+		}
+		outputAccounts = append(outputAccounts, outAcc)
+	}
+
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
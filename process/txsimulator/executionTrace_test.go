@@ -0,0 +1,199 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/stretchr/testify/require"
+)
+
+func createTraceSCAddress(suffix byte) []byte {
+	scAddress := bytes.Repeat([]byte{0}, core.NumInitCharactersForScAddress-core.VMTypeLen)
+	return append(scAddress, bytes.Repeat([]byte{suffix}, 32-len(scAddress))...)
+}
+
+func createTraceTestSimulator(t *testing.T) *transactionSimulator {
+	args := getTxSimulatorArgs()
+	args.AddressPubKeyConverter = mock.NewPubkeyConverterMock(32)
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = container.Add(core.BuiltInFunctionESDTTransfer, &mock.BuiltInFunctionStub{})
+	args.BuiltInFunctions = container
+
+	ts, err := NewTransactionSimulator(args)
+	require.Nil(t, err)
+
+	return ts
+}
+
+func TestTransactionSimulator_createExecutionTraceNilVMOutputShouldReturnOnlyTheTransaction(t *testing.T) {
+	t.Parallel()
+
+	ts := createTraceTestSimulator(t)
+	sender := bytes.Repeat([]byte{1}, 32)
+	receiver := bytes.Repeat([]byte{2}, 32)
+	tx := &transaction.Transaction{
+		SndAddr:  sender,
+		RcvAddr:  receiver,
+		Value:    big.NewInt(10),
+		GasLimit: 50000,
+	}
+
+	trace := ts.createExecutionTrace(tx, nil, nil)
+	require.Nil(t, trace.Accounts)
+	require.Equal(t, []*txSimData.TraceStep{
+		{
+			Type:     traceStepTransfer,
+			Sender:   hex.EncodeToString(sender),
+			Receiver: hex.EncodeToString(receiver),
+			Value:    "10",
+			GasLimit: 50000,
+		},
+	}, trace.Steps)
+}
+
+func TestTransactionSimulator_createExecutionTraceDeployShouldSetType(t *testing.T) {
+	t.Parallel()
+
+	ts := createTraceTestSimulator(t)
+	tx := &transaction.Transaction{
+		SndAddr: bytes.Repeat([]byte{1}, 32),
+		RcvAddr: make([]byte, 32),
+		Data:    []byte("0061736d@0500@0100"),
+	}
+
+	trace := ts.createExecutionTrace(tx, nil, nil)
+	require.Equal(t, traceStepSCDeploy, trace.Steps[0].Type)
+	require.Empty(t, trace.Steps[0].Function)
+}
+
+func TestTransactionSimulator_createExecutionTraceShouldListTheSteps(t *testing.T) {
+	t.Parallel()
+
+	ts := createTraceTestSimulator(t)
+	user := bytes.Repeat([]byte{1}, 32)
+	scA := createTraceSCAddress(2)
+	scB := createTraceSCAddress(3)
+	scC := createTraceSCAddress(4)
+
+	tx := &transaction.Transaction{
+		SndAddr:  user,
+		RcvAddr:  scA,
+		Value:    big.NewInt(0),
+		Data:     []byte("doSomething@01"),
+		GasLimit: 1000,
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:    vmcommon.Ok,
+		ReturnMessage: "",
+		GasRemaining:  400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(scA): {
+				Address: scA,
+				GasUsed: 300,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"k2": {Offset: []byte("k2"), Data: []byte("v2"), Written: true},
+					"k1": {Offset: []byte("k1"), Data: []byte("v1"), Written: true},
+					"k3": {Offset: []byte("k3"), Data: []byte("v3"), Written: false},
+				},
+			},
+			string(scB): {
+				Address: scB,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(0),
+						GasLimit:      200,
+						Data:          []byte("callB@02"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: scA,
+					},
+				},
+			},
+			string(scC): {
+				Address: scC,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(0),
+						GasLimit:      50,
+						Data:          []byte("ESDTTransfer@aa@01"),
+						CallType:      vm.DirectCall,
+						SenderAddress: scB,
+					},
+				},
+			},
+			string(user): {
+				Address:      user,
+				BalanceDelta: big.NewInt(5),
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(5),
+						CallType:      vm.DirectCall,
+						SenderAddress: scA,
+					},
+				},
+			},
+		},
+	}
+	scResults := map[string]*transaction.ApiSmartContractResult{
+		"hashAsync": {
+			SndAddr:  hex.EncodeToString(scA),
+			RcvAddr:  hex.EncodeToString(scB),
+			GasLimit: 200,
+		},
+		"hashRefund": {
+			SndAddr: hex.EncodeToString(scB),
+			RcvAddr: hex.EncodeToString(user),
+			Value:   big.NewInt(7),
+		},
+	}
+
+	trace := ts.createExecutionTrace(tx, vmOutput, scResults)
+
+	require.Equal(t, uint64(600), trace.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), trace.ReturnCode)
+	require.Len(t, trace.Steps, 5)
+
+	txStep := trace.Steps[0]
+	require.Equal(t, traceStepSCCall, txStep.Type)
+	require.Equal(t, "doSomething", txStep.Function)
+	require.Equal(t, []string{"01"}, txStep.Arguments)
+
+	asyncStep := trace.Steps[1]
+	require.Equal(t, traceStepAsyncCall, asyncStep.Type)
+	require.Equal(t, "callB", asyncStep.Function)
+	require.Equal(t, "hashAsync", asyncStep.ScResultHash)
+
+	builtInStep := trace.Steps[2]
+	require.Equal(t, traceStepBuiltInFunction, builtInStep.Type)
+	require.Equal(t, core.BuiltInFunctionESDTTransfer, builtInStep.Function)
+	require.Equal(t, hex.EncodeToString(scB), builtInStep.Sender)
+	require.Equal(t, hex.EncodeToString(scC), builtInStep.Receiver)
+
+	transferStep := trace.Steps[3]
+	require.Equal(t, traceStepTransfer, transferStep.Type)
+	require.Equal(t, "5", transferStep.Value)
+	require.Empty(t, transferStep.ScResultHash)
+
+	scrStep := trace.Steps[4]
+	require.Equal(t, traceStepSmartContractResult, scrStep.Type)
+	require.Equal(t, "hashRefund", scrStep.ScResultHash)
+	require.Equal(t, "7", scrStep.Value)
+
+	require.Len(t, trace.Accounts, 4)
+	accountA := trace.Accounts[0]
+	require.Equal(t, hex.EncodeToString(scA), accountA.Address)
+	require.Equal(t, uint64(300), accountA.GasUsed)
+	require.Equal(t, []*txSimData.StorageWrite{
+		{Key: hex.EncodeToString([]byte("k1")), Value: hex.EncodeToString([]byte("v1"))},
+		{Key: hex.EncodeToString([]byte("k2")), Value: hex.EncodeToString([]byte("v2"))},
+	}, accountA.StorageWrites)
+	require.Equal(t, "5", trace.Accounts[3].BalanceDelta)
+}
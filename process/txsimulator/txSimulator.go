@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
@@ -27,6 +28,7 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	BuiltInFunctions          vmcommon.BuiltInFunctionContainer
 }
 
 type transactionSimulator struct {
@@ -37,6 +39,8 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	builtInFunctions       vmcommon.BuiltInFunctionContainer
+	argsParser             process.CallArgumentsParser
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -62,6 +66,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.BuiltInFunctions) {
+		return nil, ErrNilBuiltInFunctionsContainer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -71,11 +78,14 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		builtInFunctions:       args.BuiltInFunctions,
+		argsParser:             parsers.NewCallArgsParser(),
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
-func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. If withTrace
+// is set, the results will also contain the execution trace of the transaction
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -103,6 +113,9 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	if ok {
 		results.VMOutput = vmOutput
 	}
+	if withTrace {
+		results.Trace = ts.createExecutionTrace(tx, results.VMOutput, results.ScResults)
+	}
 
 	return results, nil
 }
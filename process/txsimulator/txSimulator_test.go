@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,6 +91,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilBuiltInFunctions",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BuiltInFunctions = nil
+				return args
+			},
+			exError: ErrNilBuiltInFunctionsContainer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -121,7 +131,7 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, false)
 	require.NoError(t, err)
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
@@ -203,8 +213,9 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
 	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{}, 0)
 
-	results, err := ts.ProcessTx(tx)
+	results, err := ts.ProcessTx(tx, false)
 	require.NoError(t, err)
+	require.Nil(t, results.Trace)
 	require.Equal(
 		t,
 		hex.EncodeToString(expectedSCr["keySCr"].GetRcvAddr()),
@@ -217,6 +228,33 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxWithTraceShouldIncludeTrace(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+		Type:     storageUnit.LRUCache,
+		Capacity: 100,
+	})
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	tx := &transaction.Transaction{Nonce: 37, GasLimit: 100}
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{GasRemaining: 40, ReturnCode: vmcommon.UserError}, 0)
+
+	results, err := ts.ProcessTx(tx, true)
+	require.NoError(t, err)
+	require.NotNil(t, results.Trace)
+	require.Equal(t, uint64(60), results.Trace.GasUsed)
+	require.Equal(t, vmcommon.UserError.String(), results.Trace.ReturnCode)
+	require.Len(t, results.Trace.Steps, 1)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
@@ -226,5 +264,6 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &hashingMocks.HasherMock{},
+		BuiltInFunctions:          builtInFunctions.NewBuiltInFunctionContainer(),
 	}
 }
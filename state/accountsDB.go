@@ -101,6 +101,9 @@ type ArgsAccountsDB struct {
 	StoragePruningManager StoragePruningManager
 	ProcessingMode        common.NodeProcessingMode
 	ProcessStatusHandler  common.ProcessStatusHandler
+	// DisableSnapshotAfterRestart should be set for the accounts adapters not following the processed blocks, as
+	// resuming an interrupted snapshot is left to the processing accounts adapter
+	DisableSnapshotAfterRestart bool
 }
 
 // NewAccountsDB creates a new account manager
@@ -131,6 +134,10 @@ func NewAccountsDB(args ArgsAccountsDB) (*AccountsDB, error) {
 		processStatusHandler: args.ProcessStatusHandler,
 	}
 
+	if args.DisableSnapshotAfterRestart {
+		return adb, nil
+	}
+
 	val, err := trieStorageManager.GetFromCurrentEpoch([]byte(common.ActiveDBKey))
 	if err != nil || !bytes.Equal(val, []byte(common.ActiveDBVal)) {
 		startSnapshotAfterRestart(adb, trieStorageManager)
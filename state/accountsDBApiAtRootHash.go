@@ -0,0 +1,171 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// accountsDBApiAtRootHash is a read-only accounts adapter serving the accounts at the root hash it was last set to.
+// The accounts are loaded through an accounts adapter with history, so the same instance can be handed to all the
+// components executing a query (blockchain hook, built-in functions and so on) while they all read the same state
+type accountsDBApiAtRootHash struct {
+	accountsWithHistory AccountsAdapterWithHistory
+	mutRootHash         sync.RWMutex
+	rootHash            []byte
+}
+
+// NewAccountsDBApiAtRootHash will create a new instance of type accountsDBApiAtRootHash
+func NewAccountsDBApiAtRootHash(accountsWithHistory AccountsAdapterWithHistory) (*accountsDBApiAtRootHash, error) {
+	if check.IfNil(accountsWithHistory) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &accountsDBApiAtRootHash{
+		accountsWithHistory: accountsWithHistory,
+	}, nil
+}
+
+// SetRootHash sets the root hash the accounts will be read at
+func (accountsDB *accountsDBApiAtRootHash) SetRootHash(rootHash []byte) {
+	accountsDB.mutRootHash.Lock()
+	accountsDB.rootHash = rootHash
+	accountsDB.mutRootHash.Unlock()
+}
+
+func (accountsDB *accountsDBApiAtRootHash) getRootHash() []byte {
+	accountsDB.mutRootHash.RLock()
+	defer accountsDB.mutRootHash.RUnlock()
+
+	return accountsDB.rootHash
+}
+
+// GetExistingAccount returns the existing account found at the set root hash
+func (accountsDB *accountsDBApiAtRootHash) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.accountsWithHistory.GetAccountWithRootHash(address, accountsDB.getRootHash())
+}
+
+// GetAccountFromBytes is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) GetAccountFromBytes(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// LoadAccount returns the account found at the set root hash or a new account, if it does not exist
+func (accountsDB *accountsDBApiAtRootHash) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.accountsWithHistory.LoadAccountWithRootHash(address, accountsDB.getRootHash())
+}
+
+// SaveAccount is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) SaveAccount(_ vmcommon.AccountHandler) error {
+	return ErrOperationNotPermitted
+}
+
+// RemoveAccount is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) RemoveAccount(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// CommitInEpoch is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) CommitInEpoch(_ uint32, _ uint32) ([]byte, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// Commit is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) Commit() ([]byte, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// JournalLen will always return 0
+func (accountsDB *accountsDBApiAtRootHash) JournalLen() int {
+	return 0
+}
+
+// RevertToSnapshot is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) RevertToSnapshot(_ int) error {
+	return ErrOperationNotPermitted
+}
+
+// GetNumCheckpoints will always return 0
+func (accountsDB *accountsDBApiAtRootHash) GetNumCheckpoints() uint32 {
+	return 0
+}
+
+// GetCode returns the code found at the set root hash
+func (accountsDB *accountsDBApiAtRootHash) GetCode(codeHash []byte) []byte {
+	code, err := accountsDB.accountsWithHistory.GetCodeWithRootHash(codeHash, accountsDB.getRootHash())
+	if err != nil {
+		log.Debug("accountsDBApiAtRootHash.GetCode", "error", err)
+		return nil
+	}
+
+	return code
+}
+
+// RootHash will return the set root hash
+func (accountsDB *accountsDBApiAtRootHash) RootHash() ([]byte, error) {
+	rootHash := accountsDB.getRootHash()
+	if len(rootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+
+	return rootHash, nil
+}
+
+// RecreateTrie is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) RecreateTrie(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// PruneTrie is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBApiAtRootHash) PruneTrie(_ []byte, _ TriePruningIdentifier) {
+}
+
+// CancelPrune is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBApiAtRootHash) CancelPrune(_ []byte, _ TriePruningIdentifier) {
+}
+
+// SnapshotState is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBApiAtRootHash) SnapshotState(_ []byte) {
+}
+
+// SetStateCheckpoint is a not permitted operation in this implementation and thus, does nothing
+func (accountsDB *accountsDBApiAtRootHash) SetStateCheckpoint(_ []byte) {
+}
+
+// IsPruningEnabled will always return false
+func (accountsDB *accountsDBApiAtRootHash) IsPruningEnabled() bool {
+	return false
+}
+
+// GetAllLeaves is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) GetAllLeaves(_ []byte) (chan core.KeyValueHolder, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// RecreateAllTries is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) RecreateAllTries(_ []byte) (map[string]common.Trie, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// GetTrie is a not permitted operation in this implementation and thus, will return an error
+func (accountsDB *accountsDBApiAtRootHash) GetTrie(_ []byte) (common.Trie, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// GetStackDebugFirstEntry will always return nil
+func (accountsDB *accountsDBApiAtRootHash) GetStackDebugFirstEntry() []byte {
+	return nil
+}
+
+// Close does nothing, as the accounts adapter with history is not owned by this instance
+func (accountsDB *accountsDBApiAtRootHash) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountsDB *accountsDBApiAtRootHash) IsInterfaceNil() bool {
+	return accountsDB == nil
+}
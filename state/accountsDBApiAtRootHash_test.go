@@ -0,0 +1,106 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccountsDBApiAtRootHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter with history should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, err := state.NewAccountsDBApiAtRootHash(nil)
+
+		assert.True(t, check.IfNil(accountsApi))
+		assert.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, err := state.NewAccountsDBApiAtRootHash(&mockState.AccountsAdapterWithHistoryStub{})
+
+		assert.False(t, check.IfNil(accountsApi))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountsDBApiAtRootHash_NotPermittedOperations(t *testing.T) {
+	t.Parallel()
+
+	accountsApi, _ := state.NewAccountsDBApiAtRootHash(&mockState.AccountsAdapterWithHistoryStub{})
+
+	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.SaveAccount(nil))
+	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RemoveAccount(nil))
+	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RevertToSnapshot(0))
+	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RecreateTrie(nil))
+
+	_, err := accountsApi.Commit()
+	assert.Equal(t, state.ErrOperationNotPermitted, err)
+
+	_, err = accountsApi.CommitInEpoch(0, 0)
+	assert.Equal(t, state.ErrOperationNotPermitted, err)
+
+	_, err = accountsApi.GetAccountFromBytes(nil, nil)
+	assert.Equal(t, state.ErrOperationNotPermitted, err)
+}
+
+func TestAccountsDBApiAtRootHash_ShouldReadAtTheSetRootHash(t *testing.T) {
+	t.Parallel()
+
+	firstRootHash := []byte("first root hash")
+	secondRootHash := []byte("second root hash")
+	address := []byte("address")
+	codeHash := []byte("code hash")
+	expectedAccount := &mockState.UserAccountStub{}
+	expectedCode := []byte("code")
+
+	readRootHashes := make([][]byte, 0)
+	accountsApi, _ := state.NewAccountsDBApiAtRootHash(&mockState.AccountsAdapterWithHistoryStub{
+		GetAccountWithRootHashCalled: func(providedAddress []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+			assert.Equal(t, address, providedAddress)
+			readRootHashes = append(readRootHashes, rootHash)
+			return expectedAccount, nil
+		},
+		LoadAccountWithRootHashCalled: func(providedAddress []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+			assert.Equal(t, address, providedAddress)
+			readRootHashes = append(readRootHashes, rootHash)
+			return expectedAccount, nil
+		},
+		GetCodeWithRootHashCalled: func(providedCodeHash []byte, rootHash []byte) ([]byte, error) {
+			assert.Equal(t, codeHash, providedCodeHash)
+			readRootHashes = append(readRootHashes, rootHash)
+			return expectedCode, nil
+		},
+		CloseCalled: func() error {
+			assert.Fail(t, "should have not closed the accounts adapter with history")
+			return nil
+		},
+	})
+
+	_, err := accountsApi.RootHash()
+	assert.Equal(t, state.ErrNilRootHash, err)
+
+	accountsApi.SetRootHash(firstRootHash)
+	account, err := accountsApi.GetExistingAccount(address)
+	assert.Nil(t, err)
+	assert.True(t, account == expectedAccount)
+
+	accountsApi.SetRootHash(secondRootHash)
+	account, err = accountsApi.LoadAccount(address)
+	assert.Nil(t, err)
+	assert.True(t, account == expectedAccount)
+	assert.Equal(t, expectedCode, accountsApi.GetCode(codeHash))
+
+	rootHash, err := accountsApi.RootHash()
+	assert.Nil(t, err)
+	assert.Equal(t, secondRootHash, rootHash)
+	assert.Equal(t, [][]byte{firstRootHash, secondRootHash, secondRootHash}, readRootHashes)
+	assert.Nil(t, accountsApi.Close())
+}
@@ -0,0 +1,120 @@
+package state
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ AccountsAdapterWithHistory = (*accountsDBApiWithHistory)(nil)
+
+// accountsDBApiWithHistory loads the accounts at any root hash still available in the trie storage. It owns an
+// accounts adapter not shared with the processing or with the current state API, as its trie is recreated at the
+// requested root hash. The trie is only recreated when the requested root hash differs from the last one, so the
+// reads of a query, all done at the same root hash, reuse the recreated trie
+type accountsDBApiWithHistory struct {
+	innerAccountsAdapter AccountsAdapter
+	mutRecreateAndGet    sync.Mutex
+	lastRootHash         []byte
+}
+
+// NewAccountsDBApiWithHistory will create a new instance of type accountsDBApiWithHistory
+func NewAccountsDBApiWithHistory(innerAccountsAdapter AccountsAdapter) (*accountsDBApiWithHistory, error) {
+	if check.IfNil(innerAccountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &accountsDBApiWithHistory{
+		innerAccountsAdapter: innerAccountsAdapter,
+	}, nil
+}
+
+// GetAccountWithRootHash recreates the trie at the provided root hash and returns the existing account found there.
+// The account's data trie is loaded along with the account, so it can be read after the trie is recreated again.
+func (accountsDB *accountsDBApiWithHistory) GetAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.getAccountWithRootHash(rootHash, func() (vmcommon.AccountHandler, error) {
+		return accountsDB.innerAccountsAdapter.GetExistingAccount(address)
+	})
+}
+
+// LoadAccountWithRootHash recreates the trie at the provided root hash and returns the account found there or a new
+// account, if it does not exist at that root hash
+func (accountsDB *accountsDBApiWithHistory) LoadAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+	return accountsDB.getAccountWithRootHash(rootHash, func() (vmcommon.AccountHandler, error) {
+		return accountsDB.innerAccountsAdapter.LoadAccount(address)
+	})
+}
+
+// GetCodeWithRootHash recreates the trie at the provided root hash and returns the code stored there for the code hash
+func (accountsDB *accountsDBApiWithHistory) GetCodeWithRootHash(codeHash []byte, rootHash []byte) ([]byte, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+
+	accountsDB.mutRecreateAndGet.Lock()
+	defer accountsDB.mutRecreateAndGet.Unlock()
+
+	err := accountsDB.recreateTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsDB.innerAccountsAdapter.GetCode(codeHash), nil
+}
+
+func (accountsDB *accountsDBApiWithHistory) getAccountWithRootHash(
+	rootHash []byte,
+	getAccount func() (vmcommon.AccountHandler, error),
+) (vmcommon.AccountHandler, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+
+	accountsDB.mutRecreateAndGet.Lock()
+	defer accountsDB.mutRecreateAndGet.Unlock()
+
+	err := accountsDB.recreateTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := getAccount()
+	if err != nil && strings.Contains(err.Error(), common.GetNodeFromDBErrorString) {
+		// the root node is still available, but the state is partly pruned
+		return nil, fmt.Errorf("%w, root hash: %s, reason: %s", ErrRootHashNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	return account, err
+}
+
+func (accountsDB *accountsDBApiWithHistory) recreateTrie(rootHash []byte) error {
+	if bytes.Equal(accountsDB.lastRootHash, rootHash) {
+		return nil
+	}
+
+	err := accountsDB.innerAccountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		accountsDB.lastRootHash = nil
+		return fmt.Errorf("%w, root hash: %s, reason: %s", ErrRootHashNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	accountsDB.lastRootHash = append([]byte{}, rootHash...)
+
+	return nil
+}
+
+// Close will handle the closing of the underlying components
+func (accountsDB *accountsDBApiWithHistory) Close() error {
+	return accountsDB.innerAccountsAdapter.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountsDB *accountsDBApiWithHistory) IsInterfaceNil() bool {
+	return accountsDB == nil
+}
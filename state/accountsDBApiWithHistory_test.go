@@ -0,0 +1,239 @@
+package state_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAccountsDBApiWithHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, err := state.NewAccountsDBApiWithHistory(nil)
+
+		assert.True(t, check.IfNil(accountsApi))
+		assert.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, err := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{})
+
+		assert.False(t, check.IfNil(accountsApi))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountsDBApiWithHistory_GetAccountWithRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("address")
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{})
+
+		account, err := accountsApi.GetAccountWithRootHash(address, nil)
+		assert.Nil(t, account)
+		assert.Equal(t, state.ErrNilRootHash, err)
+	})
+	t.Run("recreate trie fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return errors.New("missing node")
+			},
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+
+		account, err := accountsApi.GetAccountWithRootHash(address, rootHash)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+		assert.Contains(t, err.Error(), "missing node")
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return nil
+			},
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, fmt.Errorf("%s key not found for key aabb", common.GetNodeFromDBErrorString)
+			},
+		})
+
+		account, err := accountsApi.GetAccountWithRootHash(address, rootHash)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+	})
+	t.Run("account not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return nil
+			},
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, state.ErrAccNotFound
+			},
+		})
+
+		account, err := accountsApi.GetAccountWithRootHash(address, rootHash)
+		assert.Nil(t, account)
+		assert.Equal(t, state.ErrAccNotFound, err)
+	})
+	t.Run("should recreate the trie and get the account", func(t *testing.T) {
+		t.Parallel()
+
+		recreatedRootHash := make([]byte, 0)
+		expectedAccount := &mockState.UserAccountStub{}
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(providedRootHash []byte) error {
+				recreatedRootHash = providedRootHash
+				return nil
+			},
+			GetExistingAccountCalled: func(providedAddress []byte) (vmcommon.AccountHandler, error) {
+				assert.Equal(t, address, providedAddress)
+				return expectedAccount, nil
+			},
+		})
+
+		account, err := accountsApi.GetAccountWithRootHash(address, rootHash)
+		assert.Nil(t, err)
+		assert.True(t, account == expectedAccount)
+		assert.Equal(t, rootHash, recreatedRootHash)
+	})
+	t.Run("should recreate the trie only when the root hash changes", func(t *testing.T) {
+		t.Parallel()
+
+		numRecreateCalls := 0
+		failRecreate := false
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				numRecreateCalls++
+				if failRecreate {
+					return errors.New("missing node")
+				}
+				return nil
+			},
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &mockState.UserAccountStub{}, nil
+			},
+		})
+
+		_, _ = accountsApi.GetAccountWithRootHash(address, rootHash)
+		_, _ = accountsApi.GetAccountWithRootHash([]byte("other address"), rootHash)
+		assert.Equal(t, 1, numRecreateCalls)
+
+		failRecreate = true
+		_, err := accountsApi.GetAccountWithRootHash(address, []byte("other root hash"))
+		assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+		assert.Equal(t, 2, numRecreateCalls)
+
+		failRecreate = false
+		_, err = accountsApi.GetAccountWithRootHash(address, rootHash)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numRecreateCalls)
+	})
+}
+
+func TestAccountsDBApiWithHistory_LoadAccountWithRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("address")
+
+	t.Run("recreate trie fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return errors.New("missing node")
+			},
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+
+		account, err := accountsApi.LoadAccountWithRootHash(address, rootHash)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+	})
+	t.Run("should recreate the trie and load the account", func(t *testing.T) {
+		t.Parallel()
+
+		recreatedRootHash := make([]byte, 0)
+		expectedAccount := &mockState.UserAccountStub{}
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(providedRootHash []byte) error {
+				recreatedRootHash = providedRootHash
+				return nil
+			},
+			LoadAccountCalled: func(providedAddress []byte) (vmcommon.AccountHandler, error) {
+				assert.Equal(t, address, providedAddress)
+				return expectedAccount, nil
+			},
+		})
+
+		account, err := accountsApi.LoadAccountWithRootHash(address, rootHash)
+		assert.Nil(t, err)
+		assert.True(t, account == expectedAccount)
+		assert.Equal(t, rootHash, recreatedRootHash)
+	})
+}
+
+func TestAccountsDBApiWithHistory_GetCodeWithRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	codeHash := []byte("code hash")
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{})
+
+		code, err := accountsApi.GetCodeWithRootHash(codeHash, nil)
+		assert.Nil(t, code)
+		assert.Equal(t, state.ErrNilRootHash, err)
+	})
+	t.Run("should recreate the trie and get the code", func(t *testing.T) {
+		t.Parallel()
+
+		recreatedRootHash := make([]byte, 0)
+		expectedCode := []byte("code")
+		accountsApi, _ := state.NewAccountsDBApiWithHistory(&mockState.AccountsStub{
+			RecreateTrieCalled: func(providedRootHash []byte) error {
+				recreatedRootHash = providedRootHash
+				return nil
+			},
+			GetCodeCalled: func(providedCodeHash []byte) []byte {
+				assert.Equal(t, codeHash, providedCodeHash)
+				return expectedCode
+			},
+		})
+
+		code, err := accountsApi.GetCodeWithRootHash(codeHash, rootHash)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedCode, code)
+		assert.Equal(t, rootHash, recreatedRootHash)
+	})
+}
@@ -2328,6 +2328,35 @@ func TestAccountsDB_NewAccountsDbStartsSnapshotAfterRestart(t *testing.T) {
 	assert.True(t, takeSnapshotCalled.IsSet())
 }
 
+func TestAccountsDB_NewAccountsDbWithSnapshotAfterRestartDisabledShouldNotStartSnapshot(t *testing.T) {
+	t.Parallel()
+
+	trieStub := &trieMock.TrieStub{
+		GetStorageManagerCalled: func() common.StorageManager {
+			return &testscommon.StorageManagerStub{
+				GetFromCurrentEpochCalled: func(key []byte) ([]byte, error) {
+					assert.Fail(t, "should have not been called")
+					return nil, fmt.Errorf("key not found")
+				},
+				PutCalled: func(key []byte, val []byte) error {
+					assert.Fail(t, "should have not been called")
+					return nil
+				},
+				TakeSnapshotCalled: func(_ []byte, _ []byte, _ chan core.KeyValueHolder, _ common.SnapshotStatisticsHandler, _ uint32) {
+					assert.Fail(t, "should have not been called")
+				},
+			}
+		},
+	}
+
+	args := createMockAccountsDBArgs()
+	args.Trie = trieStub
+	args.DisableSnapshotAfterRestart = true
+	adb, err := state.NewAccountsDB(args)
+	require.Nil(t, err)
+	require.NotNil(t, adb)
+}
+
 func BenchmarkAccountsDb_GetCodeEntry(b *testing.B) {
 	maxTrieLevelInMemory := uint(5)
 	marshaller := &testscommon.MarshalizerMock{}
@@ -0,0 +1,383 @@
+package archive
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	accountsTrieName = "userAccount"
+	peerTrieName     = "peerAccount"
+)
+
+type testState struct {
+	db                   common.DBWriteCacher
+	accountsRootHash     []byte
+	peerAccountsRootHash []byte
+	numAccountsNodes     int
+}
+
+func createTrie(tb testing.TB, db common.DBWriteCacher) common.Trie {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, err := trie.NewTrie(storageManager, &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}, 5)
+	require.Nil(tb, err)
+
+	return tr
+}
+
+// createTestState creates, in the same database, an accounts trie having half of the accounts with a data trie and
+// a peer accounts trie
+func createTestState(tb testing.TB, numAccounts int) *testState {
+	db := testscommon.NewMemDbMock()
+	marshalizer := &testscommon.ProtobufMarshalizerMock{}
+	numAccountsNodes := 0
+
+	accountsTrie := createTrie(tb, db)
+	for i := 0; i < numAccounts; i++ {
+		account := &state.UserAccountData{Nonce: uint64(i)}
+		if i%2 == 0 {
+			dataTrie := createTrie(tb, db)
+			for j := 0; j < 10; j++ {
+				_ = dataTrie.Update([]byte(fmt.Sprintf("key %d-%d", i, j)), []byte(fmt.Sprintf("value %d-%d", i, j)))
+			}
+			require.Nil(tb, dataTrie.Commit())
+			account.RootHash, _ = dataTrie.RootHash()
+
+			hashes, _ := dataTrie.GetAllHashes()
+			numAccountsNodes += len(hashes)
+		}
+
+		accountBytes, _ := marshalizer.Marshal(account)
+		_ = accountsTrie.Update([]byte(fmt.Sprintf("address %d", i)), accountBytes)
+	}
+	require.Nil(tb, accountsTrie.Commit())
+	accountsRootHash, _ := accountsTrie.RootHash()
+	hashes, _ := accountsTrie.GetAllHashes()
+	numAccountsNodes += len(hashes)
+
+	peerTrie := createTrie(tb, db)
+	for i := 0; i < numAccounts; i++ {
+		_ = peerTrie.Update([]byte(fmt.Sprintf("validator %d", i)), []byte(fmt.Sprintf("peer account %d", i)))
+	}
+	require.Nil(tb, peerTrie.Commit())
+	peerAccountsRootHash, _ := peerTrie.RootHash()
+
+	return &testState{
+		db:                   db,
+		accountsRootHash:     accountsRootHash,
+		peerAccountsRootHash: peerAccountsRootHash,
+		numAccountsNodes:     numAccountsNodes,
+	}
+}
+
+func createMockArgsExporter(archivePath string) ArgsExporter {
+	return ArgsExporter{
+		Marshalizer:         &testscommon.ProtobufMarshalizerMock{},
+		Hasher:              &testscommon.KeccakMock{},
+		ArchivePath:         archivePath,
+		MaxChunkSizeInBytes: 4096,
+	}
+}
+
+func createMockArgsImporter(archivePath string) ArgsImporter {
+	return ArgsImporter{
+		ArchivePath: archivePath,
+		Marshalizer: &testscommon.ProtobufMarshalizerMock{},
+		Hasher:      &testscommon.KeccakMock{},
+	}
+}
+
+func exportTestState(tb testing.TB, ts *testState, archivePath string) *Manifest {
+	exp, _ := NewExporter(createMockArgsExporter(archivePath))
+	manifest, err := exp.Export(5, 0, []TrieToExport{
+		{Name: accountsTrieName, RootHash: ts.accountsRootHash, WithDataTries: true, DB: ts.db},
+		{Name: peerTrieName, RootHash: ts.peerAccountsRootHash, DB: ts.db},
+	})
+	require.Nil(tb, err)
+
+	return manifest
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsExporter(t.TempDir())
+		args.Marshalizer = nil
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsExporter(t.TempDir())
+		args.Hasher = nil
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("empty archive path should error", func(t *testing.T) {
+		exp, err := NewExporter(createMockArgsExporter(""))
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrEmptyArchivePath, err)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		args := createMockArgsExporter(t.TempDir())
+		args.MaxChunkSizeInBytes = 0
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrInvalidMaxChunkSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		exp, err := NewExporter(createMockArgsExporter(t.TempDir()))
+		assert.False(t, check.IfNil(exp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestState(t, 50)
+
+	t.Run("no trie should error", func(t *testing.T) {
+		exp, _ := NewExporter(createMockArgsExporter(t.TempDir()))
+		_, err := exp.Export(0, 0, nil)
+		assert.Equal(t, ErrNoTrieToExport, err)
+	})
+	t.Run("duplicated trie should error", func(t *testing.T) {
+		exp, _ := NewExporter(createMockArgsExporter(t.TempDir()))
+		_, err := exp.Export(0, 0, []TrieToExport{
+			{Name: accountsTrieName, RootHash: ts.accountsRootHash, DB: ts.db},
+			{Name: accountsTrieName, RootHash: ts.peerAccountsRootHash, DB: ts.db},
+		})
+		assert.True(t, errors.Is(err, ErrDuplicatedTrieName))
+	})
+	t.Run("nil db should error", func(t *testing.T) {
+		exp, _ := NewExporter(createMockArgsExporter(t.TempDir()))
+		_, err := exp.Export(0, 0, []TrieToExport{{Name: accountsTrieName, RootHash: ts.accountsRootHash}})
+		assert.True(t, errors.Is(err, ErrNilDatabase))
+	})
+	t.Run("not empty directory should error", func(t *testing.T) {
+		archivePath := t.TempDir()
+		_ = ioutil.WriteFile(filepath.Join(archivePath, "file"), []byte("data"), 0644)
+
+		exp, _ := NewExporter(createMockArgsExporter(archivePath))
+		_, err := exp.Export(0, 0, []TrieToExport{{Name: peerTrieName, RootHash: ts.peerAccountsRootHash, DB: ts.db}})
+		assert.True(t, errors.Is(err, ErrArchiveDirectoryNotEmpty))
+	})
+	t.Run("missing root should error and not write the manifest", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "archive")
+		exp, _ := NewExporter(createMockArgsExporter(archivePath))
+		_, err := exp.Export(0, 0, []TrieToExport{{Name: peerTrieName, RootHash: []byte("missing root hash"), DB: ts.db}})
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+
+		_, err = os.Stat(filepath.Join(archivePath, ManifestFileName))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "archive")
+		manifest := exportTestState(t, ts, archivePath)
+
+		assert.Equal(t, uint32(archiveVersion), manifest.Version)
+		assert.Equal(t, uint32(5), manifest.Epoch)
+		require.Equal(t, 2, len(manifest.Tries))
+
+		accountsManifest, err := manifest.GetTrie(accountsTrieName)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(ts.accountsRootHash), accountsManifest.RootHash)
+		assert.Equal(t, uint64(ts.numAccountsNodes), accountsManifest.NumNodes)
+		assert.True(t, len(accountsManifest.Chunks) > 1)
+		numNodesInChunks := uint64(0)
+		for _, chunk := range accountsManifest.Chunks {
+			numNodesInChunks += chunk.NumNodes
+		}
+		assert.Equal(t, accountsManifest.NumNodes, numNodesInChunks)
+
+		loadedManifest, err := LoadManifest(archivePath)
+		require.Nil(t, err)
+		assert.Equal(t, manifest, loadedManifest)
+	})
+}
+
+func TestNewImporter(t *testing.T) {
+	t.Parallel()
+
+	archivePath := filepath.Join(t.TempDir(), "archive")
+	exportTestState(t, createTestState(t, 10), archivePath)
+
+	t.Run("empty archive path should error", func(t *testing.T) {
+		imp, err := NewImporter(createMockArgsImporter(""))
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrEmptyArchivePath, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsImporter(archivePath)
+		args.Marshalizer = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsImporter(archivePath)
+		args.Hasher = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("missing manifest should error", func(t *testing.T) {
+		imp, err := NewImporter(createMockArgsImporter(t.TempDir()))
+		assert.True(t, check.IfNil(imp))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		otherArchivePath := t.TempDir()
+		_ = saveManifest(otherArchivePath, &Manifest{Version: archiveVersion + 1})
+
+		imp, err := NewImporter(createMockArgsImporter(otherArchivePath))
+		assert.True(t, check.IfNil(imp))
+		assert.True(t, errors.Is(err, ErrUnsupportedArchiveVersion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		imp, err := NewImporter(createMockArgsImporter(archivePath))
+		assert.False(t, check.IfNil(imp))
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(5), imp.Manifest().Epoch)
+	})
+}
+
+func TestImporter_ImportTrie(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestState(t, 50)
+	archivePath := filepath.Join(t.TempDir(), "archive")
+	manifest := exportTestState(t, ts, archivePath)
+
+	t.Run("should import both tries", func(t *testing.T) {
+		imp, _ := NewImporter(createMockArgsImporter(archivePath))
+		db := testscommon.NewMemDbMock()
+
+		err := imp.ImportTrie(accountsTrieName, ts.accountsRootHash, true, db)
+		require.Nil(t, err)
+		err = imp.ImportTrie(peerTrieName, ts.peerAccountsRootHash, false, db)
+		require.Nil(t, err)
+
+		importedTrie := createTrie(t, db)
+		importedTrie, err = importedTrie.Recreate(ts.accountsRootHash)
+		require.Nil(t, err)
+		val, err := importedTrie.Get([]byte("address 4"))
+		require.Nil(t, err)
+		account := &state.UserAccountData{}
+		_ = (&testscommon.ProtobufMarshalizerMock{}).Unmarshal(account, val)
+		assert.Equal(t, uint64(4), account.Nonce)
+
+		dataTrie, err := importedTrie.Recreate(account.RootHash)
+		require.Nil(t, err)
+		val, err = dataTrie.Get([]byte("key 4-7"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value 4-7"), val)
+	})
+	t.Run("nil db should error", func(t *testing.T) {
+		imp, _ := NewImporter(createMockArgsImporter(archivePath))
+		err := imp.ImportTrie(accountsTrieName, ts.accountsRootHash, false, nil)
+		assert.Equal(t, ErrNilDatabase, err)
+	})
+	t.Run("unknown trie should error", func(t *testing.T) {
+		imp, _ := NewImporter(createMockArgsImporter(archivePath))
+		err := imp.ImportTrie("unknown", ts.accountsRootHash, false, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrTrieNotFoundInArchive))
+	})
+	t.Run("trie archived without its required data tries should error", func(t *testing.T) {
+		imp, _ := NewImporter(createMockArgsImporter(archivePath))
+		db := testscommon.NewMemDbMock()
+		err := imp.ImportTrie(peerTrieName, ts.peerAccountsRootHash, true, db)
+		assert.True(t, errors.Is(err, ErrMissingDataTries))
+		_, errGet := db.Get(ts.peerAccountsRootHash)
+		assert.NotNil(t, errGet)
+	})
+	t.Run("other root hash should error", func(t *testing.T) {
+		imp, _ := NewImporter(createMockArgsImporter(archivePath))
+		db := testscommon.NewMemDbMock()
+		err := imp.ImportTrie(accountsTrieName, ts.peerAccountsRootHash, false, db)
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+		_, errGet := db.Get(ts.accountsRootHash)
+		assert.NotNil(t, errGet)
+	})
+	t.Run("corrupted chunk should error", func(t *testing.T) {
+		corruptedArchivePath := copyArchive(t, archivePath)
+		chunk := manifest.Tries[0].Chunks[0]
+		chunkPath := filepath.Join(corruptedArchivePath, chunk.FileName)
+		buff, _ := ioutil.ReadFile(chunkPath)
+		buff[len(buff)-1]++
+		_ = ioutil.WriteFile(chunkPath, buff, 0644)
+
+		imp, _ := NewImporter(createMockArgsImporter(corruptedArchivePath))
+		err := imp.ImportTrie(accountsTrieName, ts.accountsRootHash, false, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrChunkChecksumMismatch))
+	})
+	t.Run("truncated chunk should error", func(t *testing.T) {
+		corruptedArchivePath := copyArchive(t, archivePath)
+		chunk := manifest.Tries[0].Chunks[0]
+		chunkPath := filepath.Join(corruptedArchivePath, chunk.FileName)
+		buff, _ := ioutil.ReadFile(chunkPath)
+		_ = ioutil.WriteFile(chunkPath, buff[:len(buff)-1], 0644)
+
+		imp, _ := NewImporter(createMockArgsImporter(corruptedArchivePath))
+		err := imp.ImportTrie(accountsTrieName, ts.accountsRootHash, false, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrChunkSizeMismatch))
+	})
+	t.Run("missing chunk should error", func(t *testing.T) {
+		corruptedArchivePath := copyArchive(t, archivePath)
+		loadedManifest, _ := LoadManifest(corruptedArchivePath)
+		loadedManifest.Tries[0].Chunks = loadedManifest.Tries[0].Chunks[1:]
+		_ = saveManifest(corruptedArchivePath, loadedManifest)
+
+		imp, _ := NewImporter(createMockArgsImporter(corruptedArchivePath))
+		err := imp.ImportTrie(accountsTrieName, ts.accountsRootHash, false, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrIncompleteTrie))
+	})
+}
+
+func TestReadChunk_InvalidNodeHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archivePath := t.TempDir()
+	writer := newChunkWriter(archivePath, "trie", 1024)
+	_ = writer.write([]byte("hash"), []byte("encoded node"))
+	chunks, err := writer.finish()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(chunks))
+
+	_ = saveManifest(archivePath, &Manifest{
+		Version: archiveVersion,
+		Tries:   []TrieManifest{{Name: "trie", RootHash: hex.EncodeToString([]byte("hash")), Chunks: chunks}},
+	})
+
+	imp, _ := NewImporter(createMockArgsImporter(archivePath))
+	err = imp.ImportTrie("trie", []byte("hash"), false, testscommon.NewMemDbMock())
+	assert.True(t, errors.Is(err, ErrInvalidTrieNode))
+}
+
+func copyArchive(tb testing.TB, archivePath string) string {
+	destination := tb.TempDir()
+	entries, _ := ioutil.ReadDir(archivePath)
+	for _, entry := range entries {
+		buff, err := ioutil.ReadFile(filepath.Join(archivePath, entry.Name()))
+		require.Nil(tb, err)
+		require.Nil(tb, ioutil.WriteFile(filepath.Join(destination, entry.Name()), buff, 0644))
+	}
+
+	return destination
+}
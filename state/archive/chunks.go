@@ -0,0 +1,171 @@
+package archive
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// A chunk file is a sequence of records, each one holding a trie node: uvarint(len(hash)) | hash |
+// uvarint(len(encodedNode)) | encodedNode. The chunks are independent of each other, so they can be verified and
+// imported one by one
+
+type chunkWriter struct {
+	archivePath  string
+	trieName     string
+	maxChunkSize uint64
+
+	file    *os.File
+	writer  *bufio.Writer
+	digest  hash.Hash
+	current ChunkManifest
+	chunks  []ChunkManifest
+}
+
+func newChunkWriter(archivePath string, trieName string, maxChunkSize uint64) *chunkWriter {
+	return &chunkWriter{
+		archivePath:  archivePath,
+		trieName:     trieName,
+		maxChunkSize: maxChunkSize,
+		chunks:       make([]ChunkManifest, 0),
+	}
+}
+
+func (cw *chunkWriter) write(nodeHash []byte, encodedNode []byte) error {
+	if cw.file == nil {
+		err := cw.openChunk()
+		if err != nil {
+			return err
+		}
+	}
+
+	record := make([]byte, 0, 2*binary.MaxVarintLen64+len(nodeHash)+len(encodedNode))
+	record = appendBytesWithLength(record, nodeHash)
+	record = appendBytesWithLength(record, encodedNode)
+
+	_, err := io.MultiWriter(cw.writer, cw.digest).Write(record)
+	if err != nil {
+		return err
+	}
+
+	cw.current.NumNodes++
+	cw.current.SizeInBytes += uint64(len(record))
+	if cw.current.SizeInBytes < cw.maxChunkSize {
+		return nil
+	}
+
+	return cw.closeChunk()
+}
+
+func appendBytesWithLength(buff []byte, data []byte) []byte {
+	lengthBuff := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(lengthBuff, uint64(len(data)))
+	buff = append(buff, lengthBuff[:n]...)
+
+	return append(buff, data...)
+}
+
+func (cw *chunkWriter) openChunk() error {
+	fileName := fmt.Sprintf("%s_%05d.chunk", cw.trieName, len(cw.chunks))
+	file, err := os.Create(filepath.Join(cw.archivePath, fileName))
+	if err != nil {
+		return err
+	}
+
+	cw.file = file
+	cw.writer = bufio.NewWriter(file)
+	cw.digest = sha256.New()
+	cw.current = ChunkManifest{
+		FileName: fileName,
+	}
+
+	return nil
+}
+
+func (cw *chunkWriter) closeChunk() error {
+	errFlush := cw.writer.Flush()
+	errClose := cw.file.Close()
+	cw.file = nil
+	if errFlush != nil {
+		return errFlush
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	cw.current.Checksum = hex.EncodeToString(cw.digest.Sum(nil))
+	cw.chunks = append(cw.chunks, cw.current)
+
+	return nil
+}
+
+// finish closes the chunk being written, if any, and returns the manifests of all the written chunks
+func (cw *chunkWriter) finish() ([]ChunkManifest, error) {
+	if cw.file != nil {
+		err := cw.closeChunk()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cw.chunks, nil
+}
+
+// readChunk verifies the chunk file against its manifest and then provides all the contained nodes to the handler
+func readChunk(archivePath string, chunk ChunkManifest, handler func(nodeHash []byte, encodedNode []byte) error) error {
+	buff, err := ioutil.ReadFile(filepath.Join(archivePath, chunk.FileName))
+	if err != nil {
+		return err
+	}
+	if uint64(len(buff)) != chunk.SizeInBytes {
+		return fmt.Errorf("%w for %s: expected %d, got %d", ErrChunkSizeMismatch, chunk.FileName, chunk.SizeInBytes, len(buff))
+	}
+
+	checksum := sha256.Sum256(buff)
+	if hex.EncodeToString(checksum[:]) != chunk.Checksum {
+		return fmt.Errorf("%w for %s", ErrChunkChecksumMismatch, chunk.FileName)
+	}
+
+	numNodes := uint64(0)
+	for len(buff) > 0 {
+		var nodeHash, encodedNode []byte
+		nodeHash, buff, err = readBytesWithLength(buff)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, chunk.FileName)
+		}
+		encodedNode, buff, err = readBytesWithLength(buff)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, chunk.FileName)
+		}
+
+		err = handler(nodeHash, encodedNode)
+		if err != nil {
+			return err
+		}
+		numNodes++
+	}
+
+	if numNodes != chunk.NumNodes {
+		return fmt.Errorf("%w for %s: expected %d nodes, got %d", ErrInvalidChunk, chunk.FileName, chunk.NumNodes, numNodes)
+	}
+
+	return nil
+}
+
+func readBytesWithLength(buff []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(buff)
+	if n <= 0 || uint64(len(buff)-n) < length {
+		return nil, nil, ErrInvalidChunk
+	}
+
+	end := n + int(length)
+
+	return buff[n:end], buff[end:], nil
+}
@@ -0,0 +1,54 @@
+package archive
+
+import "errors"
+
+// ErrNilDatabase signals that a nil database has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyArchivePath signals that an empty archive path has been provided
+var ErrEmptyArchivePath = errors.New("empty archive path")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrArchiveDirectoryNotEmpty signals that the export directory already contains data
+var ErrArchiveDirectoryNotEmpty = errors.New("archive directory is not empty")
+
+// ErrNoTrieToExport signals that no trie has been provided for export
+var ErrNoTrieToExport = errors.New("no trie to export")
+
+// ErrDuplicatedTrieName signals that the same trie name has been provided more than once
+var ErrDuplicatedTrieName = errors.New("duplicated trie name")
+
+// ErrUnsupportedArchiveVersion signals that the archive has been written in an unsupported format version
+var ErrUnsupportedArchiveVersion = errors.New("unsupported archive version")
+
+// ErrTrieNotFoundInArchive signals that the requested trie is not part of the archive
+var ErrTrieNotFoundInArchive = errors.New("trie not found in archive")
+
+// ErrRootHashMismatch signals that the archived trie has a different root hash than the expected one
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrChunkSizeMismatch signals that a chunk file has a different size than the one recorded in the manifest
+var ErrChunkSizeMismatch = errors.New("chunk size mismatch")
+
+// ErrChunkChecksumMismatch signals that a chunk file has a different checksum than the one recorded in the manifest
+var ErrChunkChecksumMismatch = errors.New("chunk checksum mismatch")
+
+// ErrInvalidChunk signals that a chunk file could not be decoded
+var ErrInvalidChunk = errors.New("invalid chunk")
+
+// ErrInvalidTrieNode signals that an archived trie node does not match its hash
+var ErrInvalidTrieNode = errors.New("invalid trie node")
+
+// ErrMissingDataTries signals that the trie was archived without its data tries, while they are required
+var ErrMissingDataTries = errors.New("trie archived without its data tries")
+
+// ErrIncompleteTrie signals that the imported trie nodes do not form the complete trie
+var ErrIncompleteTrie = errors.New("incomplete trie")
@@ -0,0 +1,184 @@
+package archive
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+var log = logger.GetOrCreate("state/archive")
+
+// ArgsExporter is the DTO used to create a new instance of the state archive exporter
+type ArgsExporter struct {
+	Marshalizer         marshal.Marshalizer
+	Hasher              hashing.Hasher
+	ArchivePath         string
+	MaxChunkSizeInBytes uint64
+}
+
+// TrieToExport defines a trie to be written in the state archive, along with the database holding its nodes
+type TrieToExport struct {
+	Name          string
+	RootHash      []byte
+	WithDataTries bool
+	DB            common.DBWriteCacher
+}
+
+type exporter struct {
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	archivePath         string
+	maxChunkSizeInBytes uint64
+}
+
+// NewExporter creates a new instance able to write tries into a state archive
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.ArchivePath) == 0 {
+		return nil, ErrEmptyArchivePath
+	}
+	if args.MaxChunkSizeInBytes == 0 {
+		return nil, ErrInvalidMaxChunkSize
+	}
+
+	return &exporter{
+		marshalizer:         args.Marshalizer,
+		hasher:              args.Hasher,
+		archivePath:         args.ArchivePath,
+		maxChunkSizeInBytes: args.MaxChunkSizeInBytes,
+	}, nil
+}
+
+// Export writes the provided tries into the archive directory, which should not exist or be empty. The manifest is
+// written last, so an interrupted export does not leave behind an archive that looks valid
+func (e *exporter) Export(epoch uint32, shardID uint32, tries []TrieToExport) (*Manifest, error) {
+	err := checkTriesToExport(tries)
+	if err != nil {
+		return nil, err
+	}
+
+	err = prepareArchiveDirectory(e.archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version: archiveVersion,
+		Epoch:   epoch,
+		ShardID: shardID,
+		Tries:   make([]TrieManifest, 0, len(tries)),
+	}
+	for _, trieToExport := range tries {
+		log.Info("exporting trie", "name", trieToExport.Name, "root hash", trieToExport.RootHash)
+
+		trieManifest, errExport := e.exportTrie(trieToExport)
+		if errExport != nil {
+			return nil, fmt.Errorf("%w while exporting trie %s", errExport, trieToExport.Name)
+		}
+
+		log.Info("trie exported",
+			"name", trieManifest.Name,
+			"num nodes", trieManifest.NumNodes,
+			"num chunks", len(trieManifest.Chunks),
+		)
+		manifest.Tries = append(manifest.Tries, *trieManifest)
+	}
+
+	err = saveManifest(e.archivePath, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func checkTriesToExport(tries []TrieToExport) error {
+	if len(tries) == 0 {
+		return ErrNoTrieToExport
+	}
+
+	names := make(map[string]struct{}, len(tries))
+	for _, trieToExport := range tries {
+		if check.IfNil(trieToExport.DB) {
+			return fmt.Errorf("%w for trie %s", ErrNilDatabase, trieToExport.Name)
+		}
+
+		_, found := names[trieToExport.Name]
+		if found {
+			return fmt.Errorf("%w: %s", ErrDuplicatedTrieName, trieToExport.Name)
+		}
+		names[trieToExport.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func prepareArchiveDirectory(archivePath string) error {
+	entries, err := ioutil.ReadDir(archivePath)
+	if os.IsNotExist(err) {
+		return os.MkdirAll(archivePath, os.ModePerm)
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", ErrArchiveDirectoryNotEmpty, archivePath)
+	}
+
+	return nil
+}
+
+func (e *exporter) exportTrie(trieToExport TrieToExport) (*TrieManifest, error) {
+	walker, err := trie.NewStatisticsWalker(trie.ArgsStatisticsWalker{
+		DB:          trieToExport.DB,
+		Marshalizer: e.marshalizer,
+		Hasher:      e.hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	writer := newChunkWriter(e.archivePath, trieToExport.Name, e.maxChunkSizeInBytes)
+	numNodes := uint64(0)
+	handleNode := func(nodeHash []byte, encodedNode []byte) error {
+		numNodes++
+		return writer.write(nodeHash, encodedNode)
+	}
+
+	err = walkTrieNodes(walker, e.marshalizer, trieToExport.RootHash, trieToExport.WithDataTries, handleNode)
+	if err != nil {
+		_, _ = writer.finish()
+		return nil, err
+	}
+
+	chunks, err := writer.finish()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrieManifest{
+		Name:          trieToExport.Name,
+		RootHash:      hex.EncodeToString(trieToExport.RootHash),
+		WithDataTries: trieToExport.WithDataTries,
+		NumNodes:      numNodes,
+		Chunks:        chunks,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
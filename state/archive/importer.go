@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// ArgsImporter is the DTO used to create a new instance of the state archive importer
+type ArgsImporter struct {
+	ArchivePath string
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type importer struct {
+	archivePath string
+	manifest    *Manifest
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewImporter creates a new instance able to import tries from the state archive found at the provided path. The
+// archive manifest is read and validated on creation
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if len(args.ArchivePath) == 0 {
+		return nil, ErrEmptyArchivePath
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	manifest, err := LoadManifest(args.ArchivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &importer{
+		archivePath: args.ArchivePath,
+		manifest:    manifest,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// ImportTrie writes into the provided database all the nodes of the archived trie with the provided name. The archived
+// trie should have the expected root hash and, if requireDataTries is set, should have been exported together with its
+// data tries. Each chunk is verified against its checksum and each node against its hash before being written. At the
+// end, the trie (and its data tries, if exported) is walked from the expected root hash to make sure no node is missing
+func (i *importer) ImportTrie(trieName string, expectedRootHash []byte, requireDataTries bool, db common.DBWriteCacher) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+
+	trieManifest, err := i.manifest.GetTrie(trieName)
+	if err != nil {
+		return err
+	}
+	if requireDataTries && !trieManifest.WithDataTries {
+		return fmt.Errorf("%w for trie %s", ErrMissingDataTries, trieName)
+	}
+	if trieManifest.RootHash != hex.EncodeToString(expectedRootHash) {
+		return fmt.Errorf("%w for trie %s: expected %s, archived %s",
+			ErrRootHashMismatch, trieName, hex.EncodeToString(expectedRootHash), trieManifest.RootHash)
+	}
+
+	putNode := func(nodeHash []byte, encodedNode []byte) error {
+		if !bytes.Equal(i.hasher.Compute(string(encodedNode)), nodeHash) {
+			return fmt.Errorf("%w: %s", ErrInvalidTrieNode, hex.EncodeToString(nodeHash))
+		}
+
+		return db.Put(nodeHash, encodedNode)
+	}
+	for index, chunk := range trieManifest.Chunks {
+		log.Debug("importing chunk",
+			"trie", trieName,
+			"file", chunk.FileName,
+			"progress", fmt.Sprintf("%d/%d", index+1, len(trieManifest.Chunks)),
+		)
+
+		err = readChunk(i.archivePath, chunk, putNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return i.verifyTrie(trieManifest, expectedRootHash, db)
+}
+
+func (i *importer) verifyTrie(trieManifest *TrieManifest, rootHash []byte, db common.DBWriteCacher) error {
+	walker, err := trie.NewStatisticsWalker(trie.ArgsStatisticsWalker{
+		DB:          db,
+		Marshalizer: i.marshalizer,
+		Hasher:      i.hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	numNodes := uint64(0)
+	countNode := func(_ []byte, _ []byte) error {
+		numNodes++
+		return nil
+	}
+	err = walkTrieNodes(walker, i.marshalizer, rootHash, trieManifest.WithDataTries, countNode)
+	if err != nil {
+		return fmt.Errorf("%w for trie %s: %v", ErrIncompleteTrie, trieManifest.Name, err)
+	}
+
+	log.Debug("imported trie verified", "trie", trieManifest.Name, "num nodes", numNodes)
+
+	return nil
+}
+
+// Manifest returns the manifest of the state archive
+func (i *importer) Manifest() Manifest {
+	return *i.manifest
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
@@ -0,0 +1,80 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+const (
+	// ManifestFileName is the name of the file describing the content of a state archive
+	ManifestFileName = "manifest.json"
+
+	archiveVersion = 1
+)
+
+// Manifest describes the content of a state archive: the exported tries and the chunks holding their nodes
+type Manifest struct {
+	Version uint32         `json:"version"`
+	Epoch   uint32         `json:"epoch"`
+	ShardID uint32         `json:"shardId"`
+	Tries   []TrieManifest `json:"tries"`
+}
+
+// TrieManifest describes one exported trie. When the trie is an accounts trie exported with its data tries, the
+// data tries nodes are stored in the same chunks
+type TrieManifest struct {
+	Name          string          `json:"name"`
+	RootHash      string          `json:"rootHash"`
+	WithDataTries bool            `json:"withDataTries"`
+	NumNodes      uint64          `json:"numNodes"`
+	Chunks        []ChunkManifest `json:"chunks"`
+}
+
+// ChunkManifest describes one chunk file. The checksum is the hex encoded sha256 of the whole file
+type ChunkManifest struct {
+	FileName    string `json:"fileName"`
+	NumNodes    uint64 `json:"numNodes"`
+	SizeInBytes uint64 `json:"sizeInBytes"`
+	Checksum    string `json:"checksum"`
+}
+
+// GetTrie returns the manifest of the trie with the provided name
+func (m *Manifest) GetTrie(name string) (*TrieManifest, error) {
+	for i := range m.Tries {
+		if m.Tries[i].Name == name {
+			return &m.Tries[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrTrieNotFoundInArchive, name)
+}
+
+func saveManifest(archivePath string, manifest *Manifest) error {
+	buff, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(archivePath, ManifestFileName), buff, 0644)
+}
+
+// LoadManifest reads and validates the manifest of the state archive found at the provided path
+func LoadManifest(archivePath string) (*Manifest, error) {
+	buff, err := ioutil.ReadFile(filepath.Join(archivePath, ManifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(buff, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Version != archiveVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
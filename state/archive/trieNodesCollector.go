@@ -0,0 +1,92 @@
+package archive
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type nodesWalker interface {
+	WalkNodes(rootHash []byte, handler common.TrieNodesHandler) error
+	IsInterfaceNil() bool
+}
+
+// trieNodesCollector forwards all the nodes of a trie to the node handler and, if required, records the data tries
+// root hashes of the accounts found in the trie leaves
+type trieNodesCollector struct {
+	marshalizer         marshal.Marshalizer
+	handleNode          func(nodeHash []byte, encodedNode []byte) error
+	collectDataTries    bool
+	dataTriesRootHashes [][]byte
+	seenDataTries       map[string]struct{}
+}
+
+// HandleNode forwards the node to the node handler
+func (tnc *trieNodesCollector) HandleNode(nodeHash []byte, encodedNode []byte) error {
+	return tnc.handleNode(nodeHash, encodedNode)
+}
+
+// HandleLeaf records the data trie root hash of the account stored in the leaf, if required
+func (tnc *trieNodesCollector) HandleLeaf(_ []byte, value []byte) error {
+	if !tnc.collectDataTries {
+		return nil
+	}
+
+	account := &state.UserAccountData{}
+	err := tnc.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		return err
+	}
+	if len(account.RootHash) == 0 {
+		return nil
+	}
+
+	_, seen := tnc.seenDataTries[string(account.RootHash)]
+	if seen {
+		return nil
+	}
+
+	tnc.seenDataTries[string(account.RootHash)] = struct{}{}
+	tnc.dataTriesRootHashes = append(tnc.dataTriesRootHashes, account.RootHash)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tnc *trieNodesCollector) IsInterfaceNil() bool {
+	return tnc == nil
+}
+
+// walkTrieNodes provides to the handler all the nodes of the trie with the provided root hash, followed, if required,
+// by all the nodes of the data tries of the accounts found in the trie
+func walkTrieNodes(
+	walker nodesWalker,
+	marshalizer marshal.Marshalizer,
+	rootHash []byte,
+	withDataTries bool,
+	handleNode func(nodeHash []byte, encodedNode []byte) error,
+) error {
+	collector := &trieNodesCollector{
+		marshalizer:         marshalizer,
+		handleNode:          handleNode,
+		collectDataTries:    withDataTries,
+		dataTriesRootHashes: make([][]byte, 0),
+		seenDataTries:       make(map[string]struct{}),
+	}
+
+	err := walker.WalkNodes(rootHash, collector)
+	if err != nil {
+		return err
+	}
+
+	// the data tries leaves are not accounts
+	collector.collectDataTries = false
+	for _, dataTrieRootHash := range collector.dataTriesRootHashes {
+		err = walker.WalkNodes(dataTrieRootHash, collector)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
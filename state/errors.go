@@ -124,3 +124,7 @@ var ErrNilChainHandler = errors.New("nil chain handler")
 
 // ErrNilProcessStatusHandler signals that a nil process status handler was provided
 var ErrNilProcessStatusHandler = errors.New("nil process status handler")
+
+// ErrRootHashNotAvailable signals that the trie cannot be recreated from the provided root hash, as it was never
+// committed or it has been pruned
+var ErrRootHashNotAvailable = errors.New("root hash not available, it might have been pruned")
@@ -127,6 +127,15 @@ type AccountsAdapter interface {
 	IsInterfaceNil() bool
 }
 
+// AccountsAdapterWithHistory is used to load the accounts at past root hashes, still available in the trie storage
+type AccountsAdapterWithHistory interface {
+	GetAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error)
+	LoadAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error)
+	GetCodeWithRootHash(codeHash []byte, rootHash []byte) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // JournalEntry will be used to implement different state changes to be able to easily revert them
 type JournalEntry interface {
 	Revert() (vmcommon.AccountHandler, error)
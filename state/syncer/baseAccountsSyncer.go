@@ -140,6 +140,9 @@ func (b *baseAccountsSyncer) printStatistics(ssh common.SizeSyncStatisticsHandle
 				"state data size", core.ConvertBytes(ssh.NumBytesReceived()),
 				"peak network speed", peakSpeed,
 				"average network speed", averageSpeed,
+				"nodes throughput", fmt.Sprintf("%.2f nodes/s", ssh.NodesThroughput()),
+				"num request batches", ssh.NumBatchesCompleted(),
+				"num timed out request batches", ssh.NumBatchesTimedOut(),
 			)
 			return
 		case <-time.After(timeBetweenStatisticsPrints):
@@ -165,7 +168,10 @@ func (b *baseAccountsSyncer) printStatistics(ssh common.SizeSyncStatisticsHandle
 				"intercepted trie nodes cache size", core.ConvertBytes(b.cacher.SizeInBytesContained()),
 				"num of intercepted trie nodes", b.cacher.Len(),
 				"state data size", core.ConvertBytes(ssh.NumBytesReceived()),
-				"network speed", speed)
+				"network speed", speed,
+				"nodes throughput", fmt.Sprintf("%.2f nodes/s", ssh.NodesThroughput()),
+				"average request batch latency", ssh.AverageBatchLatency(),
+				"num timed out request batches", ssh.NumBatchesTimedOut())
 		}
 	}
 }
@@ -0,0 +1,6 @@
+package openMetrics
+
+import "errors"
+
+// ErrEmptyBuckets signals that no histogram buckets were provided
+var ErrEmptyBuckets = errors.New("empty histogram buckets")
@@ -0,0 +1,228 @@
+package openMetrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	shardLabel  = "shard"
+	epochLabel  = "epoch"
+	nodeFamily  = "erd_node"
+	totalSuffix = "_total"
+)
+
+// labeledFamily groups under the same family the metrics built as prefix + "_" + label value
+type labeledFamily struct {
+	metricPrefix string
+	labelName    string
+	metricType   string
+	help         string
+}
+
+var labeledFamilies = []labeledFamily{
+	{common.MetricP2PPeerNumReceivedMessages, "quota", typeGauge, "Maximum number of messages received from a peer in the last interval"},
+	{common.MetricP2PPeerSizeReceivedMessages, "quota", typeGauge, "Maximum size of the messages received from a peer in the last interval"},
+	{common.MetricP2PPeerNumProcessedMessages, "quota", typeGauge, "Maximum number of messages processed from a peer in the last interval"},
+	{common.MetricP2PPeerSizeProcessedMessages, "quota", typeGauge, "Maximum size of the messages processed from a peer in the last interval"},
+	{common.MetricP2PPeakPeerNumReceivedMessages, "quota", typeGauge, "Peak number of messages received from a peer in an interval"},
+	{common.MetricP2PPeakPeerSizeReceivedMessages, "quota", typeGauge, "Peak size of the messages received from a peer in an interval"},
+	{common.MetricP2PPeakPeerNumProcessedMessages, "quota", typeGauge, "Peak number of messages processed from a peer in an interval"},
+	{common.MetricP2PPeakPeerSizeProcessedMessages, "quota", typeGauge, "Peak size of the messages processed from a peer in an interval"},
+	{common.MetricP2PNumReceiverPeers, "quota", typeGauge, "Number of peers which sent messages in the last interval"},
+	{common.MetricP2PPeakNumReceiverPeers, "quota", typeGauge, "Peak number of peers which sent messages in an interval"},
+	{common.MetricP2PTopicNumReceivedMessages, "topic", typeCounter, "Number of messages received on a topic"},
+	{common.MetricP2PTopicSizeReceivedMessages, "topic", typeCounter, "Size of the messages received on a topic"},
+	{common.MetricTxCacheNumTxs, "cache", typeGauge, "Number of transactions held by a transactions cache"},
+	{common.MetricTxCacheNumBytes, "cache", typeGauge, "Size in bytes of a transactions cache"},
+	{common.MetricTxCacheNumSenders, "cache", typeGauge, "Number of senders held by a transactions cache"},
+	{common.MetricTxCacheNumReplacedTxs, "cache", typeCounter, "Number of transactions replaced in a transactions cache"},
+	{common.MetricTxCacheNumRejectedReplacements, "cache", typeCounter, "Number of replacements rejected by a transactions cache"},
+	{common.MetricBlockProcessingDuration, "operation", typeGauge, "Duration in milliseconds of the last block operation"},
+	{common.MetricConsensusSubroundDuration, "subround", typeGauge, "Duration in milliseconds of the last consensus subround job"},
+}
+
+// counterMetrics holds the metrics which only increase during the node's lifetime
+var counterMetrics = map[string]struct{}{
+	common.MetricCountLeader:                   {},
+	common.MetricCountConsensus:                {},
+	common.MetricCountAcceptedBlocks:           {},
+	common.MetricCountConsensusAcceptedBlocks:  {},
+	common.MetricNumProcessedTxs:               {},
+	common.MetricTxPoolNumReplacedTxs:          {},
+	common.MetricTxPoolNumRejectedReplacements: {},
+	common.MetricNumTimesInForkChoice:          {},
+}
+
+// infoMetrics holds the string metrics exported as labels of the node info family
+var infoMetrics = map[string]string{
+	common.MetricAppVersion:      "app_version",
+	common.MetricChainId:         "chain_id",
+	common.MetricNodeType:        "node_type",
+	common.MetricPeerType:        "peer_type",
+	common.MetricNodeDisplayName: "display_name",
+}
+
+// MetricsSnapshot holds the values of the status metrics at a given moment
+type MetricsSnapshot struct {
+	Uint64Metrics map[string]uint64
+	Int64Metrics  map[string]int64
+	StringMetrics map[string]string
+}
+
+type sample struct {
+	labels []Label
+	value  float64
+}
+
+type histogramSample struct {
+	labels    []Label
+	histogram *histogram
+}
+
+type family struct {
+	metricType string
+	unit       string
+	help       string
+	samples    []sample
+	histograms []histogramSample
+}
+
+// Write returns the OpenMetrics text exposition of the provided metrics snapshot and latency histograms. The
+// histograms can be nil
+func Write(snapshot MetricsSnapshot, histograms *LatencyHistograms) string {
+	commonLabels := []Label{{Name: shardLabel, Value: fmt.Sprintf("%d", snapshot.Uint64Metrics[common.MetricShardId])}}
+	epoch := fmt.Sprintf("%d", snapshot.Uint64Metrics[common.MetricEpochNumber])
+
+	families := make(map[string]*family)
+	for key, value := range snapshot.Uint64Metrics {
+		addNumericMetric(families, key, float64(value), commonLabels, epoch)
+	}
+	for key, value := range snapshot.Int64Metrics {
+		addNumericMetric(families, key, float64(value), commonLabels, epoch)
+	}
+	addNodeInfo(families, snapshot.StringMetrics, commonLabels)
+	if histograms != nil {
+		histograms.write(families, commonLabels)
+	}
+
+	return writeFamilies(families)
+}
+
+func addNumericMetric(families map[string]*family, key string, value float64, commonLabels []Label, epoch string) {
+	labels := append(make([]Label, 0, len(commonLabels)+2), commonLabels...)
+	if strings.Contains(key, "_in_epoch") || strings.Contains(key, "_in_current_epoch") {
+		labels = append(labels, Label{Name: epochLabel, Value: epoch})
+	}
+
+	name, labeledFam, labelValue := classifyMetric(key)
+	metricType := typeGauge
+	help := ""
+	if labeledFam != nil {
+		metricType = labeledFam.metricType
+		help = labeledFam.help
+		labels = append(labels, Label{Name: labeledFam.labelName, Value: labelValue})
+	} else if _, isCounter := counterMetrics[key]; isCounter {
+		metricType = typeCounter
+	}
+
+	fam, ok := families[name]
+	if !ok {
+		fam = &family{
+			metricType: metricType,
+			help:       help,
+		}
+		families[name] = fam
+	}
+
+	fam.samples = append(fam.samples, sample{labels: labels, value: value})
+}
+
+// classifyMetric returns the family name of the provided metric key along with the matching labeled family and label
+// value, if any. The longest matching prefix wins so that overlapping prefixes are correctly resolved
+func classifyMetric(key string) (string, *labeledFamily, string) {
+	var matched *labeledFamily
+	labelValue := ""
+	for i := range labeledFamilies {
+		candidate := &labeledFamilies[i]
+		prefix := candidate.metricPrefix + "_"
+		if !strings.HasPrefix(key, prefix) || len(key) == len(prefix) {
+			continue
+		}
+		if matched != nil && len(matched.metricPrefix) >= len(candidate.metricPrefix) {
+			continue
+		}
+
+		matched = candidate
+		labelValue = key[len(prefix):]
+	}
+
+	if matched == nil {
+		return sanitizeName(key), nil, ""
+	}
+
+	return sanitizeName(matched.metricPrefix), matched, labelValue
+}
+
+func addNodeInfo(families map[string]*family, stringMetrics map[string]string, commonLabels []Label) {
+	infoLabels := make([]Label, 0, len(infoMetrics))
+	for key, labelName := range infoMetrics {
+		value, ok := stringMetrics[key]
+		if !ok {
+			continue
+		}
+
+		infoLabels = append(infoLabels, Label{Name: labelName, Value: value})
+	}
+	sort.Slice(infoLabels, func(i, j int) bool {
+		return infoLabels[i].Name < infoLabels[j].Name
+	})
+	labels := append(append(make([]Label, 0, len(commonLabels)+len(infoLabels)), commonLabels...), infoLabels...)
+
+	families[nodeFamily] = &family{
+		metricType: typeInfo,
+		help:       "Information about the running node",
+		samples:    []sample{{labels: labels, value: 1}},
+	}
+}
+
+func writeFamilies(families map[string]*family) string {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := &textWriter{}
+	for _, name := range names {
+		fam := families[name]
+		writer.writeFamilyHeader(name, fam.metricType, fam.unit, fam.help)
+
+		sampleName := name
+		switch fam.metricType {
+		case typeCounter:
+			sampleName = name + totalSuffix
+		case typeInfo:
+			sampleName = name + "_info"
+		}
+
+		sort.Slice(fam.samples, func(i, j int) bool {
+			return formatLabels(fam.samples[i].labels) < formatLabels(fam.samples[j].labels)
+		})
+		for _, s := range fam.samples {
+			writer.writeSample(sampleName, s.labels, s.value)
+		}
+
+		sort.Slice(fam.histograms, func(i, j int) bool {
+			return formatLabels(fam.histograms[i].labels) < formatLabels(fam.histograms[j].labels)
+		})
+		for _, hs := range fam.histograms {
+			writer.writeHistogram(name, hs.labels, hs.histogram)
+		}
+	}
+
+	return writer.String()
+}
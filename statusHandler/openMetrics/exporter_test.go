@@ -0,0 +1,149 @@
+package openMetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyMetric(t *testing.T) {
+	t.Parallel()
+
+	name, fam, labelValue := classifyMetric(common.MetricNonce)
+	assert.Equal(t, common.MetricNonce, name)
+	assert.Nil(t, fam)
+	assert.Empty(t, labelValue)
+
+	name, fam, labelValue = classifyMetric(common.MetricP2PPeakPeerNumReceivedMessages + "_fast_reacting")
+	assert.Equal(t, common.MetricP2PPeakPeerNumReceivedMessages, name)
+	require.NotNil(t, fam)
+	assert.Equal(t, "quota", fam.labelName)
+	assert.Equal(t, "fast_reacting", labelValue)
+
+	name, fam, labelValue = classifyMetric(common.MetricTxCacheNumTxs + "_0_1")
+	assert.Equal(t, common.MetricTxCacheNumTxs, name)
+	require.NotNil(t, fam)
+	assert.Equal(t, "cache", fam.labelName)
+	assert.Equal(t, "0_1", labelValue)
+
+	// the prefix alone is not a labeled metric
+	_, fam, _ = classifyMetric(common.MetricP2PTopicNumReceivedMessages + "_")
+	assert.Nil(t, fam)
+}
+
+func TestNewLatencyHistograms(t *testing.T) {
+	t.Parallel()
+
+	lh, err := NewLatencyHistograms(nil)
+	assert.Nil(t, lh)
+	assert.Equal(t, ErrEmptyBuckets, err)
+
+	lh, err = NewLatencyHistograms(DefaultLatencyBuckets)
+	assert.Nil(t, err)
+	assert.False(t, lh.IsInterfaceNil())
+}
+
+func TestLatencyHistograms_ObserveMetric(t *testing.T) {
+	t.Parallel()
+
+	lh, _ := NewLatencyHistograms(DefaultLatencyBuckets)
+	assert.False(t, lh.ObserveMetric(common.MetricNonce, 10))
+	assert.False(t, lh.ObserveMetric(common.MetricBlockProcessingDuration, 10))
+	assert.True(t, lh.ObserveMetric(common.MetricBlockProcessingDuration+"_"+common.BlockOperationProcess, 120))
+
+	h := lh.histograms[blockProcessingFamily][common.BlockOperationProcess]
+	require.NotNil(t, h)
+	assert.Equal(t, uint64(1), h.count)
+	assert.InDelta(t, 0.12, h.sum, 1e-9)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	lh, _ := NewLatencyHistograms([]float64{0.1, 1})
+	lh.ObserveApiRoute("/node/status", 50*time.Millisecond)
+	lh.ObserveApiRoute("/node/status", 3*time.Second)
+
+	snapshot := MetricsSnapshot{
+		Uint64Metrics: map[string]uint64{
+			common.MetricShardId:     1,
+			common.MetricEpochNumber: 7,
+			common.MetricNonce:       100,
+			common.MetricCountLeader: 3,
+			common.MetricP2PTopicNumReceivedMessages + "_heartbeat": 10,
+			common.MetricP2PTopicNumReceivedMessages + "_consensus": 20,
+			common.MetricP2PNumReceiverPeers + "_" + "out_of_specs": 4,
+			"erd_rewards_in_epoch": 5,
+		},
+		Int64Metrics: map[string]int64{
+			"erd_int_metric": -2,
+		},
+		StringMetrics: map[string]string{
+			common.MetricAppVersion: "v1.0.0",
+			common.MetricChainId:    "T",
+			"erd_other_string":      "ignored",
+		},
+	}
+
+	expected := strings.Join([]string{
+		`# TYPE erd_api_route_duration_seconds histogram`,
+		`# UNIT erd_api_route_duration_seconds seconds`,
+		`# HELP erd_api_route_duration_seconds Duration of the API requests, per route`,
+		`erd_api_route_duration_seconds_bucket{shard="1",route="/node/status",le="0.1"} 1`,
+		`erd_api_route_duration_seconds_bucket{shard="1",route="/node/status",le="1"} 1`,
+		`erd_api_route_duration_seconds_bucket{shard="1",route="/node/status",le="+Inf"} 2`,
+		`erd_api_route_duration_seconds_sum{shard="1",route="/node/status"} 3.05`,
+		`erd_api_route_duration_seconds_count{shard="1",route="/node/status"} 2`,
+		`# TYPE erd_count_leader counter`,
+		`erd_count_leader_total{shard="1"} 3`,
+		`# TYPE erd_epoch_number gauge`,
+		`erd_epoch_number{shard="1"} 7`,
+		`# TYPE erd_int_metric gauge`,
+		`erd_int_metric{shard="1"} -2`,
+		`# TYPE erd_node info`,
+		`# HELP erd_node Information about the running node`,
+		`erd_node_info{shard="1",app_version="v1.0.0",chain_id="T"} 1`,
+		`# TYPE erd_nonce gauge`,
+		`erd_nonce{shard="1"} 100`,
+		`# TYPE erd_p2p_num_receiver_peers gauge`,
+		`# HELP erd_p2p_num_receiver_peers Number of peers which sent messages in the last interval`,
+		`erd_p2p_num_receiver_peers{shard="1",quota="out_of_specs"} 4`,
+		`# TYPE erd_p2p_topic_num_received_messages counter`,
+		`# HELP erd_p2p_topic_num_received_messages Number of messages received on a topic`,
+		`erd_p2p_topic_num_received_messages_total{shard="1",topic="consensus"} 20`,
+		`erd_p2p_topic_num_received_messages_total{shard="1",topic="heartbeat"} 10`,
+		`# TYPE erd_rewards_in_epoch gauge`,
+		`erd_rewards_in_epoch{shard="1",epoch="7"} 5`,
+		`# TYPE erd_shard_id gauge`,
+		`erd_shard_id{shard="1"} 1`,
+		`# EOF`,
+		``,
+	}, "\n")
+
+	assert.Equal(t, expected, Write(snapshot, lh))
+}
+
+func TestWrite_NilHistogramsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	output := Write(MetricsSnapshot{}, nil)
+	assert.True(t, strings.HasSuffix(output, "# EOF\n"))
+	assert.Contains(t, output, `erd_node_info{shard="0"} 1`)
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `a\"b\\c\nd`, escapeLabelValue("a\"b\\c\nd"))
+}
+
+func TestSanitizeName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "erd_metric_1", sanitizeName("erd_metric_1"))
+	assert.Equal(t, "_rd_metric_a_b", sanitizeName("0rd-metric.a b"))
+}
@@ -0,0 +1,60 @@
+package openMetrics
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the buckets used by the latency histograms
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// histogram counts the observed values in buckets. It is not concurrent safe, the access being protected by the
+// containing LatencyHistograms instance
+type histogram struct {
+	upperBounds  []float64
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+func newHistogram(upperBounds []float64) *histogram {
+	bounds := make([]float64, len(upperBounds))
+	copy(bounds, upperBounds)
+	sort.Float64s(bounds)
+
+	return &histogram{
+		upperBounds: bounds,
+		// the last bucket is the +Inf one
+		bucketCounts: make([]uint64, len(bounds)+1),
+	}
+}
+
+func (h *histogram) observe(value float64) {
+	index := sort.SearchFloat64s(h.upperBounds, value)
+	h.bucketCounts[index]++
+	h.sum += value
+	h.count++
+}
+
+// cumulativeBuckets returns the upper bounds, including +Inf, and the cumulative count of each bucket
+func (h *histogram) cumulativeBuckets() ([]float64, []uint64) {
+	bounds := append(append(make([]float64, 0, len(h.upperBounds)+1), h.upperBounds...), math.Inf(1))
+	counts := make([]uint64, len(h.bucketCounts))
+
+	cumulated := uint64(0)
+	for i, bucketCount := range h.bucketCounts {
+		cumulated += bucketCount
+		counts[i] = cumulated
+	}
+
+	return bounds, counts
+}
+
+func (h *histogram) clone() *histogram {
+	return &histogram{
+		upperBounds:  h.upperBounds,
+		bucketCounts: append(make([]uint64, 0, len(h.bucketCounts)), h.bucketCounts...),
+		sum:          h.sum,
+		count:        h.count,
+	}
+}
@@ -0,0 +1,36 @@
+package openMetrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram_ObserveAndCumulativeBuckets(t *testing.T) {
+	t.Parallel()
+
+	h := newHistogram([]float64{1, 0.1, 0.5})
+	h.observe(0.05)
+	h.observe(0.1)
+	h.observe(0.3)
+	h.observe(2)
+
+	bounds, counts := h.cumulativeBuckets()
+	assert.Equal(t, []float64{0.1, 0.5, 1, math.Inf(1)}, bounds)
+	assert.Equal(t, []uint64{2, 3, 3, 4}, counts)
+	assert.Equal(t, uint64(4), h.count)
+	assert.InDelta(t, 2.45, h.sum, 1e-9)
+}
+
+func TestHistogram_CloneShouldNotShareCounts(t *testing.T) {
+	t.Parallel()
+
+	h := newHistogram(DefaultLatencyBuckets)
+	h.observe(0.2)
+	cloned := h.clone()
+	h.observe(0.2)
+
+	assert.Equal(t, uint64(1), cloned.count)
+	assert.Equal(t, uint64(2), h.count)
+}
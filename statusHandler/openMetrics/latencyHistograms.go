@@ -0,0 +1,140 @@
+package openMetrics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	blockProcessingFamily   = "erd_block_processing_duration_seconds"
+	consensusSubroundFamily = "erd_consensus_subround_duration_seconds"
+	apiRouteFamily          = "erd_api_route_duration_seconds"
+)
+
+// latencyFamily describes a histogram family fed from the duration metrics set in the status handler
+type latencyFamily struct {
+	metricPrefix string
+	name         string
+	labelName    string
+	help         string
+}
+
+var latencyFamilies = []latencyFamily{
+	{
+		metricPrefix: common.MetricBlockProcessingDuration,
+		name:         blockProcessingFamily,
+		labelName:    "operation",
+		help:         "Duration of the block create, process and commit operations",
+	},
+	{
+		metricPrefix: common.MetricConsensusSubroundDuration,
+		name:         consensusSubroundFamily,
+		labelName:    "subround",
+		help:         "Duration of the consensus subrounds jobs",
+	},
+	{
+		name:      apiRouteFamily,
+		labelName: "route",
+		help:      "Duration of the API requests, per route",
+	},
+}
+
+// LatencyHistograms holds the latency histograms of the node, grouped in families with one histogram for each label value
+type LatencyHistograms struct {
+	mut         sync.RWMutex
+	upperBounds []float64
+	// family name -> label value -> histogram
+	histograms map[string]map[string]*histogram
+}
+
+// NewLatencyHistograms creates a new LatencyHistograms instance using the provided buckets upper bounds, in seconds
+func NewLatencyHistograms(upperBounds []float64) (*LatencyHistograms, error) {
+	if len(upperBounds) == 0 {
+		return nil, ErrEmptyBuckets
+	}
+
+	return &LatencyHistograms{
+		upperBounds: upperBounds,
+		histograms:  make(map[string]map[string]*histogram),
+	}, nil
+}
+
+// ObserveMetric records the value, in milliseconds, of a duration metric. Returns false if the key is not one of the
+// duration metrics followed by the histograms
+func (lh *LatencyHistograms) ObserveMetric(key string, valueInMilliseconds uint64) bool {
+	for _, family := range latencyFamilies {
+		if len(family.metricPrefix) == 0 {
+			continue
+		}
+
+		labelValue := strings.TrimPrefix(key, family.metricPrefix+"_")
+		if len(labelValue) == len(key) || len(labelValue) == 0 {
+			continue
+		}
+
+		lh.observe(family.name, labelValue, float64(valueInMilliseconds)/1000)
+		return true
+	}
+
+	return false
+}
+
+// ObserveApiRoute records the duration of a request served on the provided route
+func (lh *LatencyHistograms) ObserveApiRoute(route string, duration time.Duration) {
+	lh.observe(apiRouteFamily, route, duration.Seconds())
+}
+
+func (lh *LatencyHistograms) observe(familyName string, labelValue string, valueInSeconds float64) {
+	lh.mut.Lock()
+	defer lh.mut.Unlock()
+
+	familyHistograms, ok := lh.histograms[familyName]
+	if !ok {
+		familyHistograms = make(map[string]*histogram)
+		lh.histograms[familyName] = familyHistograms
+	}
+
+	h, ok := familyHistograms[labelValue]
+	if !ok {
+		h = newHistogram(lh.upperBounds)
+		familyHistograms[labelValue] = h
+	}
+
+	h.observe(valueInSeconds)
+}
+
+// write adds the histograms families to the provided families map
+func (lh *LatencyHistograms) write(families map[string]*family, commonLabels []Label) {
+	lh.mut.RLock()
+	defer lh.mut.RUnlock()
+
+	for _, latencyFam := range latencyFamilies {
+		familyHistograms, ok := lh.histograms[latencyFam.name]
+		if !ok {
+			continue
+		}
+
+		fam := &family{
+			metricType: typeHistogram,
+			unit:       "seconds",
+			help:       latencyFam.help,
+		}
+		for labelValue, h := range familyHistograms {
+			labels := append(append(make([]Label, 0, len(commonLabels)+1), commonLabels...), Label{Name: latencyFam.labelName, Value: labelValue})
+			fam.histograms = append(fam.histograms, histogramSample{
+				labels:    labels,
+				histogram: h.clone(),
+			})
+		}
+
+		families[latencyFam.name] = fam
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lh *LatencyHistograms) IsInterfaceNil() bool {
+	return lh == nil
+}
@@ -0,0 +1,112 @@
+package openMetrics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the HTTP content type of the OpenMetrics text exposition format
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+	typeInfo      = "info"
+)
+
+// Label is a name-value pair attached to a metric sample
+type Label struct {
+	Name  string
+	Value string
+}
+
+// textWriter builds the OpenMetrics text exposition of several metric families
+type textWriter struct {
+	builder strings.Builder
+}
+
+func (tw *textWriter) writeFamilyHeader(name string, metricType string, unit string, help string) {
+	tw.builder.WriteString(fmt.Sprintf("# TYPE %s %s\n", name, metricType))
+	if len(unit) > 0 {
+		tw.builder.WriteString(fmt.Sprintf("# UNIT %s %s\n", name, unit))
+	}
+	if len(help) > 0 {
+		tw.builder.WriteString(fmt.Sprintf("# HELP %s %s\n", name, escapeHelp(help)))
+	}
+}
+
+func (tw *textWriter) writeSample(name string, labels []Label, value float64) {
+	tw.builder.WriteString(name)
+	tw.builder.WriteString(formatLabels(labels))
+	tw.builder.WriteString(" ")
+	tw.builder.WriteString(formatValue(value))
+	tw.builder.WriteString("\n")
+}
+
+func (tw *textWriter) writeHistogram(name string, labels []Label, h *histogram) {
+	bounds, counts := h.cumulativeBuckets()
+	for i, bound := range bounds {
+		bucketLabels := append(append(make([]Label, 0, len(labels)+1), labels...), Label{Name: "le", Value: formatValue(bound)})
+		tw.writeSample(name+"_bucket", bucketLabels, float64(counts[i]))
+	}
+	tw.writeSample(name+"_sum", labels, h.sum)
+	tw.writeSample(name+"_count", labels, float64(h.count))
+}
+
+func (tw *textWriter) String() string {
+	return tw.builder.String() + "# EOF\n"
+}
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	formatted := make([]string, 0, len(labels))
+	for _, label := range labels {
+		formatted = append(formatted, fmt.Sprintf("%s=\"%s\"", sanitizeName(label.Name), escapeLabelValue(label.Value)))
+	}
+
+	return "{" + strings.Join(formatted, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
+
+func escapeHelp(help string) string {
+	help = strings.ReplaceAll(help, `\`, `\\`)
+	return strings.ReplaceAll(help, "\n", `\n`)
+}
+
+// sanitizeName replaces the characters which are not allowed in a metric or label name with underscores
+func sanitizeName(name string) string {
+	sanitized := []rune(name)
+	for i, r := range sanitized {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isAllowedChar := isLetter || r == '_' || r == ':' || (i > 0 && r >= '0' && r <= '9')
+		if !isAllowedChar {
+			sanitized[i] = '_'
+		}
+	}
+
+	return string(sanitized)
+}
@@ -0,0 +1,66 @@
+package p2pQuota
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+type topicStatistics struct {
+	numReceivedMessages  uint64
+	sizeReceivedMessages uint64
+}
+
+// p2pTopicStatisticsProcessor accumulates the number and the size of the messages received on each topic and is able
+// to periodically send the totals to a statusHandler
+type p2pTopicStatisticsProcessor struct {
+	mutStatistics sync.Mutex
+	statistics    map[string]*topicStatistics
+	handler       core.AppStatusHandler
+}
+
+// NewP2PTopicStatisticsProcessor creates a new p2pTopicStatisticsProcessor instance
+func NewP2PTopicStatisticsProcessor(handler core.AppStatusHandler) (*p2pTopicStatisticsProcessor, error) {
+	if check.IfNil(handler) {
+		return nil, statusHandler.ErrNilAppStatusHandler
+	}
+
+	return &p2pTopicStatisticsProcessor{
+		statistics: make(map[string]*topicStatistics),
+		handler:    handler,
+	}, nil
+}
+
+// AddTopicStatistics accumulates the provided number of messages and their size on the given topic
+func (ptsp *p2pTopicStatisticsProcessor) AddTopicStatistics(topic string, numMessages uint32, totalSize uint64) {
+	ptsp.mutStatistics.Lock()
+	defer ptsp.mutStatistics.Unlock()
+
+	stats, ok := ptsp.statistics[topic]
+	if !ok {
+		stats = &topicStatistics{}
+		ptsp.statistics[topic] = stats
+	}
+
+	stats.numReceivedMessages += uint64(numMessages)
+	stats.sizeReceivedMessages += totalSize
+}
+
+// PublishStatistics sends the accumulated totals of each topic to the status handler. The totals are not reset
+func (ptsp *p2pTopicStatisticsProcessor) PublishStatistics() {
+	ptsp.mutStatistics.Lock()
+	defer ptsp.mutStatistics.Unlock()
+
+	for topic, stats := range ptsp.statistics {
+		ptsp.handler.SetUInt64Value(common.MetricP2PTopicNumReceivedMessages+"_"+topic, stats.numReceivedMessages)
+		ptsp.handler.SetUInt64Value(common.MetricP2PTopicSizeReceivedMessages+"_"+topic, stats.sizeReceivedMessages)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ptsp *p2pTopicStatisticsProcessor) IsInterfaceNil() bool {
+	return ptsp == nil
+}
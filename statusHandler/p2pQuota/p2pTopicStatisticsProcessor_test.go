@@ -0,0 +1,54 @@
+package p2pQuota_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/p2pQuota"
+	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewP2PTopicStatisticsProcessor(t *testing.T) {
+	t.Parallel()
+
+	ptsp, err := p2pQuota.NewP2PTopicStatisticsProcessor(nil)
+	assert.True(t, check.IfNil(ptsp))
+	assert.Equal(t, statusHandler.ErrNilAppStatusHandler, err)
+
+	ptsp, err = p2pQuota.NewP2PTopicStatisticsProcessor(&statusHandlerMock.AppStatusHandlerStub{})
+	assert.False(t, check.IfNil(ptsp))
+	assert.Nil(t, err)
+}
+
+func TestP2PTopicStatisticsProcessor_PublishStatisticsShouldSetTotals(t *testing.T) {
+	t.Parallel()
+
+	setValues := make(map[string]uint64)
+	handler := &statusHandlerMock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			setValues[key] = value
+		},
+	}
+	ptsp, _ := p2pQuota.NewP2PTopicStatisticsProcessor(handler)
+
+	ptsp.AddTopicStatistics("transactions", 2, 100)
+	ptsp.AddTopicStatistics("transactions", 1, 50)
+	ptsp.AddTopicStatistics("heartbeat", 1, 10)
+	ptsp.PublishStatistics()
+
+	expectedValues := map[string]uint64{
+		common.MetricP2PTopicNumReceivedMessages + "_transactions":  3,
+		common.MetricP2PTopicSizeReceivedMessages + "_transactions": 150,
+		common.MetricP2PTopicNumReceivedMessages + "_heartbeat":     1,
+		common.MetricP2PTopicSizeReceivedMessages + "_heartbeat":    10,
+	}
+	assert.Equal(t, expectedValues, setValues)
+
+	// the totals are kept between publishes
+	ptsp.AddTopicStatistics("heartbeat", 1, 10)
+	ptsp.PublishStatistics()
+	assert.Equal(t, uint64(2), setValues[common.MetricP2PTopicNumReceivedMessages+"_heartbeat"])
+}
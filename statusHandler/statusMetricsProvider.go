@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/openMetrics"
 )
 
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
@@ -18,14 +20,20 @@ type statusMetrics struct {
 
 	int64Metrics       map[string]int64
 	mutInt64Operations sync.RWMutex
+
+	latencyHistograms *openMetrics.LatencyHistograms
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
+	// can not fail as the default buckets are not empty
+	latencyHistograms, _ := openMetrics.NewLatencyHistograms(openMetrics.DefaultLatencyBuckets)
+
 	return &statusMetrics{
-		uint64Metrics: make(map[string]uint64),
-		stringMetrics: make(map[string]string),
-		int64Metrics:  make(map[string]int64),
+		uint64Metrics:     make(map[string]uint64),
+		stringMetrics:     make(map[string]string),
+		int64Metrics:      make(map[string]int64),
+		latencyHistograms: latencyHistograms,
 	}
 }
 
@@ -88,8 +96,10 @@ func (sm *statusMetrics) SetInt64Value(key string, value int64) {
 	sm.int64Metrics[key] = value
 }
 
-// SetUInt64Value method - sets an uint64 value for a key
+// SetUInt64Value method - sets an uint64 value for a key. The duration metrics are also recorded in the latency histograms
 func (sm *statusMetrics) SetUInt64Value(key string, value uint64) {
+	sm.latencyHistograms.ObserveMetric(key, value)
+
 	sm.mutUint64Operations.Lock()
 	defer sm.mutUint64Operations.Unlock()
 
@@ -179,6 +189,40 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	return stringBuilder.String()
 }
 
+// StatusMetricsOpenMetricsString returns all the metrics, including the p2p ones and the latency histograms, in the
+// OpenMetrics text format
+func (sm *statusMetrics) StatusMetricsOpenMetricsString() string {
+	snapshot := openMetrics.MetricsSnapshot{}
+
+	sm.mutUint64Operations.RLock()
+	snapshot.Uint64Metrics = make(map[string]uint64, len(sm.uint64Metrics))
+	for key, value := range sm.uint64Metrics {
+		snapshot.Uint64Metrics[key] = value
+	}
+	sm.mutUint64Operations.RUnlock()
+
+	sm.mutInt64Operations.RLock()
+	snapshot.Int64Metrics = make(map[string]int64, len(sm.int64Metrics))
+	for key, value := range sm.int64Metrics {
+		snapshot.Int64Metrics[key] = value
+	}
+	sm.mutInt64Operations.RUnlock()
+
+	sm.mutStringOperations.RLock()
+	snapshot.StringMetrics = make(map[string]string, len(sm.stringMetrics))
+	for key, value := range sm.stringMetrics {
+		snapshot.StringMetrics[key] = value
+	}
+	sm.mutStringOperations.RUnlock()
+
+	return openMetrics.Write(snapshot, sm.latencyHistograms)
+}
+
+// ObserveApiRouteDuration records the duration of a request served on the provided API route
+func (sm *statusMetrics) ObserveApiRouteDuration(route string, duration time.Duration) {
+	sm.latencyHistograms.ObserveApiRoute(route, duration)
+}
+
 // EconomicsMetrics returns the economics related metrics
 func (sm *statusMetrics) EconomicsMetrics() map[string]interface{} {
 	economicsMetrics := make(map[string]interface{})
@@ -147,6 +147,29 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsOpenMetricsString(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(common.MetricShardId, 2)
+	sm.SetUInt64Value(common.MetricCountLeader, 5)
+	sm.SetUInt64Value(common.MetricP2PPeerNumReceivedMessages+"_fast_reacting", 7)
+	sm.SetInt64Value("erd_int_metric", -3)
+	sm.SetStringValue(common.MetricAppVersion, "v1.2.3")
+	sm.SetUInt64Value(common.MetricBlockProcessingDuration+"_"+common.BlockOperationCommit, 30)
+	sm.ObserveApiRouteDuration("/node/status", 2*time.Millisecond)
+
+	strRes := sm.StatusMetricsOpenMetricsString()
+
+	assert.Contains(t, strRes, "# TYPE erd_count_leader counter\nerd_count_leader_total{shard=\"2\"} 5\n")
+	assert.Contains(t, strRes, "erd_p2p_peer_num_received_messages{shard=\"2\",quota=\"fast_reacting\"} 7\n")
+	assert.Contains(t, strRes, "erd_int_metric{shard=\"2\"} -3\n")
+	assert.Contains(t, strRes, "erd_node_info{shard=\"2\",app_version=\"v1.2.3\"} 1\n")
+	assert.Contains(t, strRes, "erd_block_processing_duration_seconds_bucket{shard=\"2\",operation=\"commit\",le=\"0.05\"} 1\n")
+	assert.Contains(t, strRes, "erd_api_route_duration_seconds_count{shard=\"2\",route=\"/node/status\"} 1\n")
+	assert.True(t, strings.HasSuffix(strRes, "# EOF\n"))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,87 @@
+package boltdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+const removed = "removed"
+
+type batchOperation struct {
+	key      []byte
+	val      []byte
+	isDelete bool
+}
+
+type batch struct {
+	operations []batchOperation
+	cachedData map[string][]byte
+	mutBatch   sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		operations: make([]batchOperation, 0),
+		cachedData: make(map[string][]byte),
+		mutBatch:   sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch. The key and the value are copied, so the caller can
+// reuse its buffers
+func (b *batch) Put(key []byte, val []byte) error {
+	keyCopy := append([]byte{}, key...)
+	valCopy := append([]byte{}, val...)
+
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, batchOperation{key: keyCopy, val: valCopy})
+	b.cachedData[string(key)] = valCopy
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	keyCopy := append([]byte{}, key...)
+
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, batchOperation{key: keyCopy, isDelete: true})
+	b.cachedData[string(key)] = []byte(removed)
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.operations = make([]batchOperation, 0)
+	b.cachedData = make(map[string][]byte)
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) getOperations() []batchOperation {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	operations := make([]batchOperation, len(b.operations))
+	copy(operations, b.operations)
+
+	return operations
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
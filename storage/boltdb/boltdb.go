@@ -0,0 +1,379 @@
+package boltdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// read + write for owner only
+const rwOwner = 0600
+
+// DataFileName is the name of the file, inside the persister directory, that holds the bbolt database
+const DataFileName = "data.db"
+
+const openTimeout = 10 * time.Second
+
+// rangeKeysChunkSize is the maximum number of pairs copied in a single read transaction while ranging over the keys
+const rangeKeysChunkSize = 1024
+
+var bucketName = []byte("data")
+
+var log = logger.GetOrCreate("storage/boltdb")
+
+// DB holds a pointer to the bbolt database and the path to where it is stored.
+// Writes are accumulated in a batch that is committed in a single bbolt transaction
+// either when the batch is full or when the batch delay expires
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *bolt.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the bbolt persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (*DB, error) {
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &bolt.Options{
+		Timeout:        openTimeout,
+		NoFreelistSync: true,
+		FreelistType:   bolt.FreelistMapType,
+	}
+
+	db, err := bolt.Open(filepath.Join(path, DataFileName), rwOwner, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, errCreate := tx.CreateBucketIfNotExists(bucketName)
+		return errCreate
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%w while creating the bucket for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	log.Debug("opened bolt db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("boltdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("boltdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+func (s *DB) getDbPointer() *bolt.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *bolt.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	if len(key) == 0 {
+		return storage.ErrEmptyKey
+	}
+
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		if bytes.Equal(data, []byte(removed)) {
+			return nil, storage.ErrKeyNotFound
+		}
+		return data, nil
+	}
+
+	var value []byte
+	err := db.View(func(tx *bolt.Tx) error {
+		bucketValue := tx.Bucket(bucketName).Get(key)
+		if bucketValue == nil {
+			return storage.ErrKeyNotFound
+		}
+
+		value = make([]byte, len(bucketValue))
+		copy(value, bucketValue)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// putBatch writes the Batch data into the database in a single transaction
+func (s *DB) putBatch(b *batch) error {
+	operations := b.getOperations()
+	if len(operations) == 0 {
+		return nil
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		for _, operation := range operations {
+			var err error
+			if operation.isDelete {
+				err = bucket.Delete(operation.key)
+			} else {
+				err = bucket.Put(operation.key, operation.val)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+// The pairs are copied out of the database in chunks and the handler is called outside any bbolt transaction, so the
+// handler can safely write to the same persister
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	var lastKey []byte
+	for {
+		db := s.getDbPointer()
+		if db == nil {
+			return
+		}
+
+		pairs, err := readPairsAfter(db, lastKey, rangeKeysChunkSize)
+		if err != nil {
+			log.Warn("boltdb RangeKeys", "path", s.path, "error", err.Error())
+			return
+		}
+
+		for _, pair := range pairs {
+			shouldContinue := handler(pair.key, pair.value)
+			if !shouldContinue {
+				return
+			}
+		}
+
+		if len(pairs) < rangeKeysChunkSize {
+			return
+		}
+		lastKey = pairs[len(pairs)-1].key
+	}
+}
+
+type keyValuePair struct {
+	key   []byte
+	value []byte
+}
+
+// readPairsAfter copies at most maxPairs (key, value) pairs that are stored after the provided key. A nil key means
+// the reading starts with the first pair
+func readPairsAfter(db *bolt.DB, lastKey []byte, maxPairs int) ([]keyValuePair, error) {
+	pairs := make([]keyValuePair, 0, maxPairs)
+	err := db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bucketName).Cursor()
+
+		key, val := cursor.First()
+		if lastKey != nil {
+			key, val = cursor.Seek(lastKey)
+			if key != nil && bytes.Equal(key, lastKey) {
+				key, val = cursor.Next()
+			}
+		}
+
+		for ; key != nil && len(pairs) < maxPairs; key, val = cursor.Next() {
+			clonedKey := make([]byte, len(key))
+			copy(clonedKey, key)
+
+			clonedVal := make([]byte, len(val))
+			copy(clonedVal, val)
+
+			pairs = append(pairs, keyValuePair{key: clonedKey, value: clonedVal})
+		}
+
+		return nil
+	})
+
+	return pairs, err
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
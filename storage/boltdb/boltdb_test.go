@@ -0,0 +1,234 @@
+package boltdb_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDB_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+	assert.False(t, db.IsInterfaceNil())
+
+	_ = db.Close()
+}
+
+func TestDB_PutEmptyKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	err = db.Put([]byte(""), []byte("val"))
+	assert.Equal(t, storage.ErrEmptyKey, err)
+}
+
+func TestDB_GetOKBeforeBatchIsWritten(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, val := []byte("key"), []byte("val")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 1, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, val := []byte("key"), []byte("val")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	time.Sleep(time.Second * 2)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_PutShouldNotBeAffectedByReusedBuffers(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	key, val := []byte("key0"), []byte("val0")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	copy(key, "key1")
+	copy(val, "val1")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := db.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("val0"), recovered)
+
+	err = db.Close()
+	require.Nil(t, err)
+
+	reopened, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	for i := 0; i < 2; i++ {
+		recovered, err = reopened.Get([]byte(fmt.Sprintf("key%d", i)))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("val%d", i)), recovered)
+	}
+}
+
+func TestDB_RemoveBeforeBatchIsWrittenShouldHideKey(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, val := []byte("key"), []byte("val")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	err = db.Remove(key)
+	require.Nil(t, err)
+
+	_, err = db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+}
+
+func TestDB_CloseShouldFlushTheBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	numKeys := 10
+	for i := 0; i < numKeys; i++ {
+		err = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)))
+		require.Nil(t, err)
+	}
+
+	err = db.Close()
+	require.Nil(t, err)
+
+	reopened, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Close()
+	}()
+
+	for i := 0; i < numKeys; i++ {
+		val, errGet := reopened.Get([]byte(fmt.Sprintf("key%d", i)))
+		assert.Nil(t, errGet)
+		assert.Equal(t, []byte(fmt.Sprintf("val%d", i)), val)
+	}
+}
+
+func TestDB_RangeKeysShouldReturnKeysInOrder(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_ = db.Put([]byte("c"), []byte("3"))
+	_ = db.Put([]byte("a"), []byte("1"))
+	_ = db.Put([]byte("b"), []byte("2"))
+
+	keys := make([]string, 0)
+	db.RangeKeys(func(key []byte, _ []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+}
+
+func TestDB_RangeKeysHandlerCanWriteToTheSameDB(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numKeys := 2500
+	for i := 0; i < numKeys; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%05d", i)), []byte("value"))
+	}
+
+	chDone := make(chan struct{})
+	numVisited := 0
+	go func() {
+		db.RangeKeys(func(key []byte, _ []byte) bool {
+			numVisited++
+			_ = db.Put(append([]byte("copy_"), key...), []byte("value"))
+			return true
+		})
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "RangeKeys should not deadlock when the handler writes to the same DB")
+	}
+
+	assert.Equal(t, numKeys, numVisited)
+	assert.Nil(t, db.Has([]byte("copy_key02499")))
+}
+
+func TestDB_MethodCallsAfterCloseShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	db, err := boltdb.NewDB(t.TempDir(), 10, 1)
+	require.Nil(t, err)
+
+	_ = db.Close()
+
+	_, err = db.Get([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+	assert.Equal(t, storage.ErrDBIsClosed, db.Has([]byte("key")))
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		assert.Fail(t, "should have not called the handler")
+		return true
+	})
+	assert.Nil(t, db.Close())
+}
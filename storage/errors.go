@@ -147,3 +147,7 @@ var ErrNilOldDataCleanerProvider = errors.New("nil old data cleaner provider")
 
 // ErrNilStoredDataFactory signals that a nil stored data factory has been provided
 var ErrNilStoredDataFactory = errors.New("nil stored data factory")
+
+// ErrTxReplacementUnderpriced signals that a transaction would replace a pending one, having the same sender and nonce,
+// without a sufficiently higher gas price
+var ErrTxReplacementUnderpriced = errors.New("transaction replacement is underpriced")
@@ -0,0 +1,201 @@
+package factory
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// supportedDBTypes lists all the persister types that have to pass the conformance suite
+var supportedDBTypes = []storageUnit.DBType{
+	storageUnit.LvlDB,
+	storageUnit.LvlDBSerial,
+	storageUnit.BoltDB,
+	storageUnit.MemoryDB,
+}
+
+func createPersisterFactory(dbType storageUnit.DBType, maxBatchSize int) *PersisterFactory {
+	return NewPersisterFactory(config.DBConfig{
+		Type:              string(dbType),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      maxBatchSize,
+		MaxOpenFiles:      10,
+	})
+}
+
+func TestPersisterFactory_CreateEmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pf := createPersisterFactory(storageUnit.LvlDBSerial, 1)
+	p, err := pf.Create("")
+
+	assert.NotNil(t, err)
+	assert.True(t, check.IfNil(p))
+}
+
+func TestPersisterFactory_CreateUnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pf := createPersisterFactory("unknown", 1)
+	p, err := pf.Create(t.TempDir())
+
+	assert.Equal(t, storage.ErrNotSupportedDBType, err)
+	assert.True(t, check.IfNil(p))
+}
+
+func TestPersisterFactory_Conformance(t *testing.T) {
+	t.Parallel()
+
+	for _, dbType := range supportedDBTypes {
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("put get has", func(t *testing.T) {
+				testPersisterPutGetHas(t, dbType)
+			})
+			t.Run("remove", func(t *testing.T) {
+				testPersisterRemove(t, dbType)
+			})
+			t.Run("batching", func(t *testing.T) {
+				testPersisterBatching(t, dbType)
+			})
+			t.Run("range keys", func(t *testing.T) {
+				testPersisterRangeKeys(t, dbType)
+			})
+			t.Run("range keys early stop", func(t *testing.T) {
+				testPersisterRangeKeysEarlyStop(t, dbType)
+			})
+			t.Run("destroy", func(t *testing.T) {
+				testPersisterDestroy(t, dbType)
+			})
+		})
+	}
+}
+
+func createPersister(t *testing.T, dbType storageUnit.DBType, maxBatchSize int) storage.Persister {
+	p, err := createPersisterFactory(dbType, maxBatchSize).Create(t.TempDir())
+	require.Nil(t, err)
+	require.False(t, check.IfNil(p))
+
+	return p
+}
+
+func testPersisterPutGetHas(t *testing.T, dbType storageUnit.DBType) {
+	p := createPersister(t, dbType, 1)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	require.Nil(t, p.Put(key, val))
+
+	recovered, err := p.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, p.Has(key))
+
+	_, err = p.Get([]byte("missing"))
+	assert.NotNil(t, err)
+	assert.NotNil(t, p.Has([]byte("missing")))
+}
+
+func testPersisterRemove(t *testing.T, dbType storageUnit.DBType) {
+	p := createPersister(t, dbType, 1)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	require.Nil(t, p.Put(key, val))
+	require.Nil(t, p.Remove(key))
+
+	_, err := p.Get(key)
+	assert.NotNil(t, err)
+	assert.NotNil(t, p.Has(key))
+
+	assert.Nil(t, p.Remove([]byte("missing")))
+}
+
+func testPersisterBatching(t *testing.T, dbType storageUnit.DBType) {
+	maxBatchSize := 5
+	p := createPersister(t, dbType, maxBatchSize)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	numKeys := 3*maxBatchSize + 2
+	for i := 0; i < numKeys; i++ {
+		require.Nil(t, p.Put([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d", i))))
+	}
+	require.Nil(t, p.Remove([]byte("key000")))
+
+	for i := 1; i < numKeys; i++ {
+		val, err := p.Get([]byte(fmt.Sprintf("key%03d", i)))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("val%03d", i)), val)
+	}
+	_, err := p.Get([]byte("key000"))
+	assert.NotNil(t, err)
+}
+
+func testPersisterRangeKeys(t *testing.T, dbType storageUnit.DBType) {
+	p := createPersister(t, dbType, 1)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	expected := map[string]string{
+		"key1": "val1",
+		"key2": "val2",
+		"key3": "val3",
+	}
+	for key, val := range expected {
+		require.Nil(t, p.Put([]byte(key), []byte(val)))
+	}
+
+	recovered := make(map[string]string)
+	p.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = string(val)
+		return true
+	})
+	assert.Equal(t, expected, recovered)
+
+	p.RangeKeys(nil)
+}
+
+func testPersisterRangeKeysEarlyStop(t *testing.T, dbType storageUnit.DBType) {
+	p := createPersister(t, dbType, 1)
+	defer func() {
+		_ = p.Close()
+	}()
+
+	keys := []string{"key1", "key2", "key3"}
+	for _, key := range keys {
+		require.Nil(t, p.Put([]byte(key), []byte("val")))
+	}
+
+	visited := make([]string, 0)
+	p.RangeKeys(func(key []byte, _ []byte) bool {
+		visited = append(visited, string(key))
+		return false
+	})
+	assert.Equal(t, 1, len(visited))
+
+	sort.Strings(visited)
+	assert.Contains(t, keys, visited[0])
+}
+
+func testPersisterDestroy(t *testing.T, dbType storageUnit.DBType) {
+	p := createPersister(t, dbType, 1)
+
+	require.Nil(t, p.Put([]byte("key"), []byte("val")))
+	assert.Nil(t, p.Destroy())
+}
@@ -652,6 +652,36 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, esdtSuppliesUnit)
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if psf.generalConfig.DbLookupExtensions.AddressTransactionsEnabled {
+		// Create the addressTransactions (STATIC) storer
+		addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+		addressTransactionsDbConfig := GetDBFromConfig(addressTransactionsConfig.DB)
+		addressTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, addressTransactionsConfig.DB.FilePath)
+		addressTransactionsCacherConfig := GetCacherFromConfig(addressTransactionsConfig.Cache)
+		addressTransactionsUnit, errCreate := storageUnit.NewStorageUnitFromConf(addressTransactionsCacherConfig, addressTransactionsDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, addressTransactionsUnit)
+		chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.LogsIndexEnabled {
+		// Create the logsIndex (STATIC) storer
+		logsIndexConfig := psf.generalConfig.DbLookupExtensions.LogsIndexStorageConfig
+		logsIndexDbConfig := GetDBFromConfig(logsIndexConfig.DB)
+		logsIndexDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, logsIndexConfig.DB.FilePath)
+		logsIndexCacherConfig := GetCacherFromConfig(logsIndexConfig.Cache)
+		logsIndexUnit, errCreate := storageUnit.NewStorageUnitFromConf(logsIndexCacherConfig, logsIndexDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, logsIndexUnit)
+		chainStorer.AddStorer(dataRetriever.LogsIndexUnit, logsIndexUnit)
+	}
+
 	return createdStorers, nil
 }
 
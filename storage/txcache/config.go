@@ -17,6 +17,7 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const minGasPriceBumpPercentageUpperBound = 1000
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,6 +29,7 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	MinGasPriceBumpPercentage     uint32
 }
 
 type senderConstraints struct {
@@ -49,6 +51,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if config.MinGasPriceBumpPercentage > minGasPriceBumpPercentageUpperBound {
+		return fmt.Errorf("%w: config.MinGasPriceBumpPercentage is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -0,0 +1,94 @@
+package txcache
+
+// NonceGap is a range of nonces missing from the pool, between the account nonce or a pending transaction and the
+// next pending transaction of the same sender
+type NonceGap struct {
+	FromNonce uint64
+	ToNonce   uint64
+}
+
+// SenderInspection holds the state of a sender's pending transactions, as seen by the cache
+type SenderInspection struct {
+	Sender              []byte
+	Score               uint32
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	HasInitialGap       bool
+	NumFailedSelections int64
+	TotalBytes          int64
+	TotalGas            int64
+	NonceGaps           []NonceGap
+	Transactions        []*WrappedTransaction
+}
+
+// InspectSenders returns the state of at most limit senders in the cache, starting with the one at the provided offset,
+// in descending order of their score. The total number of senders is returned as well
+func (cache *TxCache) InspectSenders(offset int, limit int) ([]*SenderInspection, int) {
+	senders := cache.txListBySender.getSnapshotDescending()
+	numSenders := len(senders)
+	if offset < 0 || offset >= numSenders || limit <= 0 {
+		return make([]*SenderInspection, 0), numSenders
+	}
+
+	end := offset + limit
+	if end > numSenders {
+		end = numSenders
+	}
+
+	inspections := make([]*SenderInspection, 0, end-offset)
+	for _, listForSender := range senders[offset:end] {
+		inspections = append(inspections, listForSender.inspect())
+	}
+
+	return inspections, numSenders
+}
+
+// InspectSender returns the state of the provided sender, if it has transactions in the cache
+func (cache *TxCache) InspectSender(sender []byte) (*SenderInspection, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.inspect(), true
+}
+
+func (listForSender *txListForSender) inspect() *SenderInspection {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	inspection := &SenderInspection{
+		Sender:              []byte(listForSender.sender),
+		Score:               listForSender.getLastComputedScore(),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		NumFailedSelections: listForSender.numFailedSelections.Get(),
+		TotalBytes:          listForSender.totalBytes.Get(),
+		TotalGas:            listForSender.totalGas.Get(),
+		NonceGaps:           make([]NonceGap, 0),
+		Transactions:        make([]*WrappedTransaction, 0, listForSender.countTx()),
+	}
+
+	// the transactions are sorted by nonce, so the gaps are found between consecutive elements. Before the first
+	// transaction, the gap is only known if the account nonce has been notified
+	isFirst := true
+	previousNonce := uint64(0)
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		tx := element.Value.(*WrappedTransaction)
+		nonce := tx.Tx.GetNonce()
+
+		if isFirst && inspection.AccountNonceKnown && nonce > inspection.AccountNonce {
+			inspection.HasInitialGap = true
+			inspection.NonceGaps = append(inspection.NonceGaps, NonceGap{FromNonce: inspection.AccountNonce, ToNonce: nonce - 1})
+		}
+		if !isFirst && nonce > previousNonce+1 {
+			inspection.NonceGaps = append(inspection.NonceGaps, NonceGap{FromNonce: previousNonce + 1, ToNonce: nonce - 1})
+		}
+
+		inspection.Transactions = append(inspection.Transactions, tx)
+		isFirst = false
+		previousNonce = nonce
+	}
+
+	return inspection
+}
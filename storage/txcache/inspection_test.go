@@ -0,0 +1,101 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_InspectSender(t *testing.T) {
+	t.Parallel()
+
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+	cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+	cache.AddTx(createTx([]byte("hash-alice-9"), "alice", 9))
+	cache.AddTx(createTx([]byte("hash-alice-12"), "alice", 12))
+
+	inspection, ok := cache.InspectSender([]byte("alice"))
+	require.True(t, ok)
+	assert.Equal(t, []byte("alice"), inspection.Sender)
+	assert.False(t, inspection.AccountNonceKnown)
+	assert.False(t, inspection.HasInitialGap)
+	assert.Equal(t, []NonceGap{{FromNonce: 7, ToNonce: 8}, {FromNonce: 10, ToNonce: 11}}, inspection.NonceGaps)
+	assert.Equal(t, []string{"hash-alice-5", "hash-alice-6", "hash-alice-9", "hash-alice-12"}, hashesOfInspection(inspection))
+	assert.Equal(t, cache.getScoreOfSender("alice"), inspection.Score)
+
+	cache.NotifyAccountNonce([]byte("alice"), 3)
+	inspection, _ = cache.InspectSender([]byte("alice"))
+	assert.True(t, inspection.AccountNonceKnown)
+	assert.Equal(t, uint64(3), inspection.AccountNonce)
+	assert.True(t, inspection.HasInitialGap)
+	assert.Equal(t, NonceGap{FromNonce: 3, ToNonce: 4}, inspection.NonceGaps[0])
+
+	cache.NotifyAccountNonce([]byte("alice"), 5)
+	inspection, _ = cache.InspectSender([]byte("alice"))
+	assert.False(t, inspection.HasInitialGap)
+	assert.Equal(t, 2, len(inspection.NonceGaps))
+
+	inspection, ok = cache.InspectSender([]byte("bob"))
+	assert.False(t, ok)
+	assert.Nil(t, inspection)
+}
+
+func TestTxCache_InspectSenders(t *testing.T) {
+	t.Parallel()
+
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+	cache.AddTx(createTx([]byte("hash-bob-2"), "bob", 2))
+
+	inspections, numSenders := cache.InspectSenders(0, 10)
+	require.Equal(t, 2, len(inspections))
+	assert.Equal(t, 2, numSenders)
+
+	numTxs := 0
+	for _, inspection := range inspections {
+		numTxs += len(inspection.Transactions)
+		assert.Equal(t, 0, len(inspection.NonceGaps))
+	}
+	assert.Equal(t, 3, numTxs)
+	assert.True(t, inspections[0].Score >= inspections[1].Score)
+}
+
+func TestTxCache_InspectSendersShouldPaginate(t *testing.T) {
+	t.Parallel()
+
+	// the senders have different scores, so their order is deterministic
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 100000, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-bob-1"), "bob", 1, 128, 50000, uint64(1.2*oneBillion)))
+	cache.AddTx(createTxWithParams([]byte("hash-carol-1"), "carol", 1, 128, 50000, oneBillion))
+
+	firstPage, numSenders := cache.InspectSenders(0, 2)
+	assert.Equal(t, 3, numSenders)
+	require.Equal(t, 2, len(firstPage))
+	assert.Equal(t, []byte("bob"), firstPage[0].Sender)
+	assert.Equal(t, []byte("carol"), firstPage[1].Sender)
+
+	secondPage, numSenders := cache.InspectSenders(2, 2)
+	assert.Equal(t, 3, numSenders)
+	require.Equal(t, 1, len(secondPage))
+	assert.Equal(t, []byte("alice"), secondPage[0].Sender)
+
+	pastTheEnd, numSenders := cache.InspectSenders(3, 2)
+	assert.Equal(t, 3, numSenders)
+	assert.Equal(t, 0, len(pastTheEnd))
+
+	noLimit, _ := cache.InspectSenders(0, 0)
+	assert.Equal(t, 0, len(noLimit))
+}
+
+func hashesOfInspection(inspection *SenderInspection) []string {
+	hashes := make([]string, 0, len(inspection.Transactions))
+	for _, tx := range inspection.Transactions {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
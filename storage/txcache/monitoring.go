@@ -19,6 +19,11 @@ func (cache *TxCache) monitorEvictionWrtSenderLimit(sender []byte, evicted [][]b
 	}
 }
 
+func (cache *TxCache) monitorRejectedReplacement(txHash []byte, err error) {
+	cache.numRejectedReplacements.Increment()
+	log.Trace("TxCache: transaction replacement rejected", "name", cache.name, "tx", txHash, "err", err)
+}
+
 func (cache *TxCache) monitorEvictionStart() *core.StopWatch {
 	log.Debug("TxCache: eviction started", "name", cache.name, "numBytes", cache.NumBytes(), "txs", cache.CountTx(), "senders", cache.CountSenders())
 	cache.displaySendersHistogram()
@@ -154,6 +159,7 @@ func (cache *TxCache) diagnoseShallowly() {
 	log.Debug("TxCache.NumSenders:", "estimate", numSendersEstimate, "inChunks", numSendersInChunks, "inScoreChunks", numSendersInScoreChunks)
 	log.Debug("TxCache.NumSenders (continued):", "keys", len(sendersKeys), "keysSorted", len(sendersKeysSorted), "snapshot", len(sendersSnapshot))
 	log.Debug("TxCache.NumTxs:", "estimate", numTxsEstimate, "inChunks", numTxsInChunks, "keys", len(txsKeys))
+	log.Debug("TxCache.Replacements:", "replaced", cache.NumReplacedTxs(), "rejected", cache.NumRejectedReplacements())
 }
 
 func (cache *TxCache) diagnoseDeeply() {
@@ -0,0 +1,101 @@
+package txcache
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const percentageDenominator = 100
+
+// CheckReplacement returns an error if the provided transaction would replace a pending one, having the same sender
+// and nonce, without bumping the gas price by at least the configured percentage.
+// It always returns nil if the replacement is disabled. Only the transactions rejected by AddTx are counted as rejected
+// replacements, the checks done before adding a transaction are not.
+func (cache *TxCache) CheckReplacement(tx data.TransactionHandler, txHash []byte) error {
+	_, err := cache.getReplacedTxs(&WrappedTransaction{Tx: tx, TxHash: txHash})
+
+	return err
+}
+
+// NumReplacedTxs returns the number of transactions replaced by others with the same sender and nonce, but with a
+// higher gas price
+func (cache *TxCache) NumReplacedTxs() uint64 {
+	return cache.numReplacedTxs.GetUint64()
+}
+
+// NumRejectedReplacements returns the number of transactions rejected because of an insufficient gas price bump
+func (cache *TxCache) NumRejectedReplacements() uint64 {
+	return cache.numRejectedReplacements.GetUint64()
+}
+
+func (cache *TxCache) isReplacementEnabled() bool {
+	return cache.config.MinGasPriceBumpPercentage > 0
+}
+
+// getReplacedTxs returns the pending transactions of the same sender and nonce which would be replaced by the
+// incoming one, or an error if the incoming gas price is not high enough
+func (cache *TxCache) getReplacedTxs(tx *WrappedTransaction) ([]*WrappedTransaction, error) {
+	if !cache.isReplacementEnabled() || tx == nil || check.IfNil(tx.Tx) {
+		return nil, nil
+	}
+
+	listForSender, ok := cache.txListBySender.getListForSender(string(tx.Tx.GetSndAddr()))
+	if !ok {
+		return nil, nil
+	}
+
+	sameNonceTxs := listForSender.getTxsWithNonce(tx.Tx.GetNonce())
+	if len(sameNonceTxs) == 0 {
+		return nil, nil
+	}
+
+	for _, pendingTx := range sameNonceTxs {
+		if bytes.Equal(pendingTx.TxHash, tx.TxHash) {
+			// not a replacement, but a duplicate: handled as usual
+			return nil, nil
+		}
+	}
+
+	// the transactions are sorted by gas price, in descending order
+	highestGasPrice := sameNonceTxs[0].Tx.GetGasPrice()
+	minGasPrice := computeMinReplacementGasPrice(highestGasPrice, cache.config.MinGasPriceBumpPercentage)
+	if minGasPrice.Cmp(big.NewInt(0).SetUint64(tx.Tx.GetGasPrice())) > 0 {
+		return nil, fmt.Errorf("%w, provided gas price: %d, minimum gas price: %s",
+			storage.ErrTxReplacementUnderpriced, tx.Tx.GetGasPrice(), minGasPrice.String())
+	}
+
+	return sameNonceTxs, nil
+}
+
+// removeReplacedTxs should only be called in the critical section (cache.mutTxOperation)
+func (cache *TxCache) removeReplacedTxs(replacement *WrappedTransaction, replacedTxs []*WrappedTransaction) {
+	for _, replacedTx := range replacedTxs {
+		cache.txByHash.removeTx(string(replacedTx.TxHash))
+		cache.txListBySender.removeTx(replacedTx)
+		cache.numReplacedTxs.Increment()
+
+		log.Trace("TxCache: transaction replaced", "name", cache.name,
+			"sender", replacement.Tx.GetSndAddr(),
+			"nonce", replacement.Tx.GetNonce(),
+			"replaced tx", replacedTx.TxHash,
+			"replaced gas price", replacedTx.Tx.GetGasPrice(),
+			"tx", replacement.TxHash,
+			"gas price", replacement.Tx.GetGasPrice(),
+		)
+	}
+}
+
+// computeMinReplacementGasPrice returns gasPrice * (100 + bumpPercentage) / 100, rounded up
+func computeMinReplacementGasPrice(gasPrice uint64, bumpPercentage uint32) *big.Int {
+	result := big.NewInt(0).SetUint64(gasPrice)
+	result.Mul(result, big.NewInt(int64(percentageDenominator+bumpPercentage)))
+	result.Add(result, big.NewInt(percentageDenominator-1))
+	result.Div(result, big.NewInt(percentageDenominator))
+
+	return result
+}
@@ -0,0 +1,140 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheWithReplacementToTest(minGasPriceBumpPercentage uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceBumpPercentage:  minGasPriceBumpPercentage,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithReplacementToTest(): %s", err))
+	}
+
+	return cache
+}
+
+func TestNewTxCache_InvalidMinGasPriceBumpPercentage(t *testing.T) {
+	t.Parallel()
+
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceBumpPercentage:  minGasPriceBumpPercentageUpperBound + 1,
+	}, txGasHandler)
+	assert.Nil(t, cache)
+	assert.True(t, errors.Is(err, storage.ErrInvalidConfig))
+}
+
+func TestTxCache_AddTx_ReplacementDisabledKeepsTransactionsWithSameNonce(t *testing.T) {
+	t.Parallel()
+
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("hash-1-bis"), "alice", 1, 128, 50000, 2000))
+
+	assert.Equal(t, []string{"hash-1-bis", "hash-1"}, cache.getHashesForSender("alice"))
+	assert.Nil(t, cache.CheckReplacement(createTxWithParams([]byte("hash-1-ter"), "alice", 1, 128, 50000, 1).Tx, []byte("hash-1-ter")))
+	assert.Equal(t, uint64(0), cache.NumReplacedTxs())
+	assert.Equal(t, uint64(0), cache.NumRejectedReplacements())
+}
+
+func TestTxCache_AddTx_ReplacementWithSufficientBump(t *testing.T) {
+	t.Parallel()
+
+	cache := newCacheWithReplacementToTest(10)
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("hash-2"), "alice", 2, 128, 50000, 1000))
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("hash-1-bis"), "alice", 1, 128, 50000, 1100))
+	assert.True(t, ok)
+	assert.True(t, added)
+	assert.Equal(t, []string{"hash-1-bis", "hash-2"}, cache.getHashesForSender("alice"))
+	assert.Equal(t, uint64(2), cache.CountTx())
+	assert.True(t, cache.areInternalMapsConsistent())
+
+	_, found := cache.GetByTxHash([]byte("hash-1"))
+	assert.False(t, found)
+	assert.Equal(t, uint64(1), cache.NumReplacedTxs())
+	assert.Equal(t, uint64(0), cache.NumRejectedReplacements())
+}
+
+func TestTxCache_AddTx_ReplacementWithInsufficientBump(t *testing.T) {
+	t.Parallel()
+
+	cache := newCacheWithReplacementToTest(10)
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("hash-1-bis"), "alice", 1, 128, 50000, 1099))
+	assert.False(t, ok)
+	assert.False(t, added)
+	assert.Equal(t, []string{"hash-1"}, cache.getHashesForSender("alice"))
+	assert.True(t, cache.areInternalMapsConsistent())
+	assert.Equal(t, uint64(0), cache.NumReplacedTxs())
+	assert.Equal(t, uint64(1), cache.NumRejectedReplacements())
+}
+
+func TestTxCache_AddTx_ReplacementIgnoresDuplicates(t *testing.T) {
+	t.Parallel()
+
+	cache := newCacheWithReplacementToTest(10)
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+	assert.True(t, ok)
+	assert.False(t, added)
+	assert.Equal(t, []string{"hash-1"}, cache.getHashesForSender("alice"))
+	assert.Equal(t, uint64(0), cache.NumReplacedTxs())
+	assert.Equal(t, uint64(0), cache.NumRejectedReplacements())
+}
+
+func TestTxCache_CheckReplacement(t *testing.T) {
+	t.Parallel()
+
+	cache := newCacheWithReplacementToTest(25)
+	cache.AddTx(createTxWithParams([]byte("hash-1"), "alice", 1, 128, 50000, 1000))
+
+	underpriced := createTxWithParams([]byte("hash-1-bis"), "alice", 1, 128, 50000, 1249)
+	err := cache.CheckReplacement(underpriced.Tx, underpriced.TxHash)
+	require.True(t, errors.Is(err, storage.ErrTxReplacementUnderpriced))
+	// only the transactions rejected by the cache are counted
+	assert.Equal(t, uint64(0), cache.NumRejectedReplacements())
+
+	replacement := createTxWithParams([]byte("hash-1-bis"), "alice", 1, 128, 50000, 1250)
+	assert.Nil(t, cache.CheckReplacement(replacement.Tx, replacement.TxHash))
+
+	otherNonce := createTxWithParams([]byte("hash-2"), "alice", 2, 128, 50000, 1)
+	assert.Nil(t, cache.CheckReplacement(otherNonce.Tx, otherNonce.TxHash))
+
+	otherSender := createTxWithParams([]byte("hash-bob-1"), "bob", 1, 128, 50000, 1)
+	assert.Nil(t, cache.CheckReplacement(otherSender.Tx, otherSender.TxHash))
+
+	// checking does not alter the cache
+	assert.Equal(t, []string{"hash-1"}, cache.getHashesForSender("alice"))
+	assert.Equal(t, uint64(0), cache.NumReplacedTxs())
+}
+
+func TestComputeMinReplacementGasPrice(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "1100", computeMinReplacementGasPrice(1000, 10).String())
+	assert.Equal(t, "1000", computeMinReplacementGasPrice(1000, 0).String())
+	assert.Equal(t, "2", computeMinReplacementGasPrice(1, 10).String())
+	assert.Equal(t, "36893488147419103230", computeMinReplacementGasPrice(math.MaxUint64, 100).String())
+}
@@ -24,6 +24,8 @@ type TxCache struct {
 	numSendersWithInitialGap  atomic.Counter
 	numSendersWithMiddleGap   atomic.Counter
 	numSendersInGracePeriod   atomic.Counter
+	numReplacedTxs            atomic.Counter
+	numRejectedReplacements   atomic.Counter
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 	mutTxOperation            sync.Mutex
@@ -62,6 +64,8 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 
 // AddTx adds a transaction in the cache
 // Eviction happens if maximum capacity is reached
+// If the replacement is enabled, the pending transactions with the same sender and nonce are replaced, given the gas price
+// is sufficiently higher. Otherwise, the transaction is rejected.
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
@@ -72,8 +76,18 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	}
 
 	cache.mutTxOperation.Lock()
+	replacedTxs, err := cache.getReplacedTxs(tx)
+	if err != nil {
+		cache.mutTxOperation.Unlock()
+		cache.monitorRejectedReplacement(tx.TxHash, err)
+		return false, false
+	}
+
 	addedInByHash := cache.txByHash.addTx(tx)
 	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	if addedInBySender && len(replacedTxs) > 0 {
+		cache.removeReplacedTxs(tx, replacedTxs)
+	}
 	cache.mutTxOperation.Unlock()
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
@@ -297,6 +297,27 @@ func (listForSender *txListForSender) getTxHashes() [][]byte {
 	return result
 }
 
+// getTxsWithNonce returns the transactions having the provided nonce, in descending order of the gas price
+func (listForSender *txListForSender) getTxsWithNonce(nonce uint64) []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0)
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		txNonce := value.Tx.GetNonce()
+		if txNonce > nonce {
+			break
+		}
+		if txNonce == nonce {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
 // This function should only be used in critical section (listForSender.mutex)
 func (listForSender *txListForSender) countTx() uint64 {
 	return uint64(listForSender.items.Len())
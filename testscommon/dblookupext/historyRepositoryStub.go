@@ -10,12 +10,14 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs []*data.LogData) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs []*data.LogData) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddressCalled     func(address []byte, query dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsPage, error)
+	GetLogsCalled                      func(query dblookupext.LogsQuery) (*dblookupext.LogsQueryResult, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -24,12 +26,13 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts, logs)
 	}
 	return nil
 }
@@ -84,6 +87,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsByAddress(address []byte, query dblookupext.AddressTransactionsQuery) (*dblookupext.AddressTransactionsPage, error) {
+	if hp.GetTransactionsByAddressCalled != nil {
+		return hp.GetTransactionsByAddressCalled(address, query)
+	}
+
+	return nil, dblookupext.ErrAddressTransactionsIndexDisabled
+}
+
+// GetLogs -
+func (hp *HistoryRepositoryStub) GetLogs(query dblookupext.LogsQuery) (*dblookupext.LogsQueryResult, error) {
+	if hp.GetLogsCalled != nil {
+		return hp.GetLogsCalled(query)
+	}
+
+	return nil, dblookupext.ErrLogsIndexDisabled
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -1,6 +1,8 @@
 package testscommon
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -9,6 +11,7 @@ import (
 // OutportStub is a mock implementation fot the OutportHandler interface
 type OutportStub struct {
 	SaveBlockCalled             func(args *indexer.ArgsSaveBlockData)
+	GetRetryingDurationCalled   func() time.Duration
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
@@ -48,6 +51,14 @@ func (as *OutportStub) HasDrivers() bool {
 	return false
 }
 
+// GetRetryingDuration -
+func (as *OutportStub) GetRetryingDuration() time.Duration {
+	if as.GetRetryingDurationCalled != nil {
+		return as.GetRetryingDurationCalled()
+	}
+	return 0
+}
+
 // RevertIndexedBlock -
 func (as *OutportStub) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
 
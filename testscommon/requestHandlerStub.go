@@ -14,6 +14,7 @@ type RequestHandlerStub struct {
 	RequestMiniBlockHandlerCalled      func(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocksHandlerCalled     func(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodesCalled             func(destShardID uint32, hashes [][]byte, topic string)
+	RequestTrieNodesBatchCalled        func(destShardID uint32, hashes [][]byte, topic string)
 	RequestStartOfEpochMetaBlockCalled func(epoch uint32)
 	SetNumPeersToQueryCalled           func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled           func(key string) (int, int, error)
@@ -136,6 +137,14 @@ func (rhs *RequestHandlerStub) RequestTrieNodes(destShardID uint32, hashes [][]b
 	rhs.RequestTrieNodesCalled(destShardID, hashes, topic)
 }
 
+// RequestTrieNodesBatch -
+func (rhs *RequestHandlerStub) RequestTrieNodesBatch(destShardID uint32, hashes [][]byte, topic string) {
+	if rhs.RequestTrieNodesBatchCalled == nil {
+		return
+	}
+	rhs.RequestTrieNodesBatchCalled(destShardID, hashes, topic)
+}
+
 // CreateTrieNodeIdentifier -
 func (rhs *RequestHandlerStub) CreateTrieNodeIdentifier(requestHash []byte, chunkIndex uint32) []byte {
 	if rhs.CreateTrieNodeIdentifierCalled != nil {
@@ -37,7 +37,11 @@ func (shardedData *ShardedDataStub) RegisterOnAdded(handler func(key []byte, val
 
 // ShardDataStore -
 func (shardedData *ShardedDataStub) ShardDataStore(cacheID string) storage.Cacher {
-	return shardedData.ShardDataStoreCalled(cacheID)
+	if shardedData.ShardDataStoreCalled != nil {
+		return shardedData.ShardDataStoreCalled(cacheID)
+	}
+
+	return nil
 }
 
 // AddData -
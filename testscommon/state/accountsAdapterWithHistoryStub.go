@@ -0,0 +1,54 @@
+package state
+
+import (
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// AccountsAdapterWithHistoryStub -
+type AccountsAdapterWithHistoryStub struct {
+	GetAccountWithRootHashCalled  func(address []byte, rootHash []byte) (vmcommon.AccountHandler, error)
+	LoadAccountWithRootHashCalled func(address []byte, rootHash []byte) (vmcommon.AccountHandler, error)
+	GetCodeWithRootHashCalled     func(codeHash []byte, rootHash []byte) ([]byte, error)
+	CloseCalled                   func() error
+}
+
+// GetAccountWithRootHash -
+func (stub *AccountsAdapterWithHistoryStub) GetAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+	if stub.GetAccountWithRootHashCalled != nil {
+		return stub.GetAccountWithRootHashCalled(address, rootHash)
+	}
+
+	return nil, nil
+}
+
+// LoadAccountWithRootHash -
+func (stub *AccountsAdapterWithHistoryStub) LoadAccountWithRootHash(address []byte, rootHash []byte) (vmcommon.AccountHandler, error) {
+	if stub.LoadAccountWithRootHashCalled != nil {
+		return stub.LoadAccountWithRootHashCalled(address, rootHash)
+	}
+
+	return nil, nil
+}
+
+// GetCodeWithRootHash -
+func (stub *AccountsAdapterWithHistoryStub) GetCodeWithRootHash(codeHash []byte, rootHash []byte) ([]byte, error) {
+	if stub.GetCodeWithRootHashCalled != nil {
+		return stub.GetCodeWithRootHashCalled(codeHash, rootHash)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (stub *AccountsAdapterWithHistoryStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsAdapterWithHistoryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
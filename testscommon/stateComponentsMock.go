@@ -7,11 +7,12 @@ import (
 
 // StateComponentsMock -
 type StateComponentsMock struct {
-	PeersAcc        state.AccountsAdapter
-	Accounts        state.AccountsAdapter
-	AccountsAPI     state.AccountsAdapter
-	Tries           common.TriesHolder
-	StorageManagers map[string]common.StorageManager
+	PeersAcc               state.AccountsAdapter
+	Accounts               state.AccountsAdapter
+	AccountsAPI            state.AccountsAdapter
+	AccountsAPIWithHistory state.AccountsAdapterWithHistory
+	Tries                  common.TriesHolder
+	StorageManagers        map[string]common.StorageManager
 }
 
 // Create -
@@ -44,6 +45,11 @@ func (scm *StateComponentsMock) AccountsAdapterAPI() state.AccountsAdapter {
 	return scm.AccountsAPI
 }
 
+// AccountsAdapterAPIWithHistory -
+func (scm *StateComponentsMock) AccountsAdapterAPIWithHistory() state.AccountsAdapterWithHistory {
+	return scm.AccountsAPIWithHistory
+}
+
 // TriesContainer -
 func (scm *StateComponentsMock) TriesContainer() common.TriesHolder {
 	return scm.Tries
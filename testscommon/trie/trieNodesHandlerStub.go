@@ -0,0 +1,30 @@
+package trie
+
+// TrieNodesHandlerStub -
+type TrieNodesHandlerStub struct {
+	HandleNodeCalled func(hash []byte, encodedNode []byte) error
+	HandleLeafCalled func(key []byte, value []byte) error
+}
+
+// HandleNode -
+func (tnhs *TrieNodesHandlerStub) HandleNode(hash []byte, encodedNode []byte) error {
+	if tnhs.HandleNodeCalled != nil {
+		return tnhs.HandleNodeCalled(hash, encodedNode)
+	}
+
+	return nil
+}
+
+// HandleLeaf -
+func (tnhs *TrieNodesHandlerStub) HandleLeaf(key []byte, value []byte) error {
+	if tnhs.HandleLeafCalled != nil {
+		return tnhs.HandleLeafCalled(key, value)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tnhs *TrieNodesHandlerStub) IsInterfaceNil() bool {
+	return tnhs == nil
+}
@@ -0,0 +1,42 @@
+package trie
+
+// TrieStatisticsHandlerStub -
+type TrieStatisticsHandlerStub struct {
+	AddBranchNodeCalled    func(level int, size uint64)
+	AddExtensionNodeCalled func(level int, size uint64)
+	AddLeafNodeCalled      func(level int, size uint64, key []byte, value []byte)
+	AddMissingNodeCalled   func(level int, hash []byte)
+}
+
+// AddBranchNode -
+func (tshs *TrieStatisticsHandlerStub) AddBranchNode(level int, size uint64) {
+	if tshs.AddBranchNodeCalled != nil {
+		tshs.AddBranchNodeCalled(level, size)
+	}
+}
+
+// AddExtensionNode -
+func (tshs *TrieStatisticsHandlerStub) AddExtensionNode(level int, size uint64) {
+	if tshs.AddExtensionNodeCalled != nil {
+		tshs.AddExtensionNodeCalled(level, size)
+	}
+}
+
+// AddLeafNode -
+func (tshs *TrieStatisticsHandlerStub) AddLeafNode(level int, size uint64, key []byte, value []byte) {
+	if tshs.AddLeafNodeCalled != nil {
+		tshs.AddLeafNodeCalled(level, size, key, value)
+	}
+}
+
+// AddMissingNode -
+func (tshs *TrieStatisticsHandlerStub) AddMissingNode(level int, hash []byte) {
+	if tshs.AddMissingNodeCalled != nil {
+		tshs.AddMissingNodeCalled(level, hash)
+	}
+}
+
+// IsInterfaceNil -
+func (tshs *TrieStatisticsHandlerStub) IsInterfaceNil() bool {
+	return tshs == nil
+}
@@ -29,6 +29,8 @@ type TrieStub struct {
 	GetNumNodesCalled           func() common.NumNodesDTO
 	GetOldRootCalled            func() []byte
 	CloseCalled                 func() error
+	GetMultiProofCalled         func(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProofCalled         func(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error)
 }
 
 // GetStorageManager -
@@ -58,6 +60,24 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(startKey, endKey, maxLeaves)
+	}
+
+	return &common.TrieRangeProof{}, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte) (chan core.KeyValueHolder, error) {
 	if ts.GetAllLeavesOnChannelCalled != nil {
@@ -0,0 +1,131 @@
+package trie
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+const (
+	minRequestBatchSize        = 16
+	maxRequestBatchSize        = 2048
+	initialRequestBatchSize    = 128
+	maxRequestBatchSizeInBytes = 4 * core.MaxBufferSizeToSendTrieNodes
+	targetBatchLatency         = time.Second
+	minBatchTimeout            = time.Second
+	maxBatchTimeout            = 10 * time.Second
+	batchTimeoutMultiplier     = 3
+	sizerSmoothingFactor       = 0.25
+)
+
+// adaptiveBatchSizer computes how many trie nodes should be requested in one batch. The batch grows while the
+// batches are fully received well under the target latency and shrinks when the latency exceeds the target or when a
+// batch times out. The batch size is also capped so that the expected response, computed from the average size of
+// the received nodes, stays under a bytes limit. It is not concurrent safe
+type adaptiveBatchSizer struct {
+	minBatchSize        int
+	maxBatchSize        int
+	maxBatchSizeInBytes uint64
+	targetLatency       time.Duration
+	minTimeout          time.Duration
+
+	batchSize       int
+	averageLatency  time.Duration
+	averageNodeSize float64
+}
+
+func newAdaptiveBatchSizer(minTimeout time.Duration) *adaptiveBatchSizer {
+	if minTimeout < minBatchTimeout {
+		minTimeout = minBatchTimeout
+	}
+
+	return &adaptiveBatchSizer{
+		minBatchSize:        minRequestBatchSize,
+		maxBatchSize:        maxRequestBatchSize,
+		maxBatchSizeInBytes: maxRequestBatchSizeInBytes,
+		targetLatency:       targetBatchLatency,
+		minTimeout:          minTimeout,
+		batchSize:           initialRequestBatchSize,
+	}
+}
+
+// onBatchCompleted adjusts the batch size after all the nodes of a batch were received
+func (abs *adaptiveBatchSizer) onBatchCompleted(numNodes int, numBytes uint64, latency time.Duration) {
+	abs.averageLatency = smoothDuration(abs.averageLatency, latency)
+	if numNodes > 0 {
+		nodeSize := float64(numBytes) / float64(numNodes)
+		abs.averageNodeSize = smoothFloat(abs.averageNodeSize, nodeSize)
+	}
+
+	switch {
+	case abs.averageLatency < abs.targetLatency/2:
+		abs.batchSize += maxInt(abs.batchSize/4, 1)
+	case abs.averageLatency > abs.targetLatency:
+		abs.batchSize -= abs.batchSize / 4
+	}
+
+	abs.applyLimits()
+}
+
+// onBatchTimeout halves the batch size as the peers could not provide the requested nodes in time
+func (abs *adaptiveBatchSizer) onBatchTimeout() {
+	abs.batchSize /= 2
+	abs.applyLimits()
+}
+
+func (abs *adaptiveBatchSizer) applyLimits() {
+	if abs.averageNodeSize > 0 {
+		maxBatchSizeForBytes := int(float64(abs.maxBatchSizeInBytes) / abs.averageNodeSize)
+		if abs.batchSize > maxBatchSizeForBytes {
+			abs.batchSize = maxBatchSizeForBytes
+		}
+	}
+	if abs.batchSize > abs.maxBatchSize {
+		abs.batchSize = abs.maxBatchSize
+	}
+	if abs.batchSize < abs.minBatchSize {
+		abs.batchSize = abs.minBatchSize
+	}
+}
+
+// getBatchSize returns the number of nodes to be requested in the next batch
+func (abs *adaptiveBatchSizer) getBatchSize() int {
+	return abs.batchSize
+}
+
+// getBatchTimeout returns the duration after which a batch that was not fully received is considered lost
+func (abs *adaptiveBatchSizer) getBatchTimeout() time.Duration {
+	timeout := abs.averageLatency * batchTimeoutMultiplier
+	if timeout > maxBatchTimeout {
+		timeout = maxBatchTimeout
+	}
+	if timeout < abs.minTimeout {
+		timeout = abs.minTimeout
+	}
+
+	return timeout
+}
+
+func smoothDuration(average time.Duration, sample time.Duration) time.Duration {
+	if average == 0 {
+		return sample
+	}
+
+	return average + time.Duration(float64(sample-average)*sizerSmoothingFactor)
+}
+
+func smoothFloat(average float64, sample float64) float64 {
+	if average == 0 {
+		return sample
+	}
+
+	return average + (sample-average)*sizerSmoothingFactor
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
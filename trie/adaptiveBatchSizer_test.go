@@ -0,0 +1,80 @@
+package trie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAdaptiveBatchSizer(t *testing.T) {
+	t.Parallel()
+
+	abs := newAdaptiveBatchSizer(time.Millisecond)
+	assert.Equal(t, initialRequestBatchSize, abs.getBatchSize())
+	assert.Equal(t, minBatchTimeout, abs.getBatchTimeout())
+
+	abs = newAdaptiveBatchSizer(2 * minBatchTimeout)
+	assert.Equal(t, 2*minBatchTimeout, abs.getBatchTimeout())
+}
+
+func TestAdaptiveBatchSizer_OnBatchCompleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fast responses should grow the batch up to the maximum", func(t *testing.T) {
+		abs := newAdaptiveBatchSizer(time.Second)
+		abs.onBatchCompleted(initialRequestBatchSize, initialRequestBatchSize*10, time.Millisecond*100)
+		assert.Equal(t, initialRequestBatchSize+initialRequestBatchSize/4, abs.getBatchSize())
+
+		for i := 0; i < 100; i++ {
+			abs.onBatchCompleted(abs.getBatchSize(), uint64(abs.getBatchSize()*10), time.Millisecond*100)
+		}
+		assert.Equal(t, maxRequestBatchSize, abs.getBatchSize())
+	})
+	t.Run("slow responses should shrink the batch down to the minimum", func(t *testing.T) {
+		abs := newAdaptiveBatchSizer(time.Second)
+		abs.onBatchCompleted(initialRequestBatchSize, initialRequestBatchSize*10, targetBatchLatency*2)
+		assert.Equal(t, initialRequestBatchSize-initialRequestBatchSize/4, abs.getBatchSize())
+
+		for i := 0; i < 100; i++ {
+			abs.onBatchCompleted(abs.getBatchSize(), uint64(abs.getBatchSize()*10), targetBatchLatency*2)
+		}
+		assert.Equal(t, minRequestBatchSize, abs.getBatchSize())
+	})
+	t.Run("responses close to the target latency should keep the batch size", func(t *testing.T) {
+		abs := newAdaptiveBatchSizer(time.Second)
+		abs.onBatchCompleted(initialRequestBatchSize, initialRequestBatchSize*10, targetBatchLatency*3/4)
+		assert.Equal(t, initialRequestBatchSize, abs.getBatchSize())
+	})
+	t.Run("large nodes should cap the batch size", func(t *testing.T) {
+		abs := newAdaptiveBatchSizer(time.Second)
+		nodeSize := uint64(maxRequestBatchSizeInBytes / 50)
+		abs.onBatchCompleted(10, 10*nodeSize, time.Millisecond)
+		assert.Equal(t, 50, abs.getBatchSize())
+	})
+}
+
+func TestAdaptiveBatchSizer_OnBatchTimeout(t *testing.T) {
+	t.Parallel()
+
+	abs := newAdaptiveBatchSizer(time.Second)
+	abs.onBatchTimeout()
+	assert.Equal(t, initialRequestBatchSize/2, abs.getBatchSize())
+
+	for i := 0; i < 10; i++ {
+		abs.onBatchTimeout()
+	}
+	assert.Equal(t, minRequestBatchSize, abs.getBatchSize())
+}
+
+func TestAdaptiveBatchSizer_GetBatchTimeout(t *testing.T) {
+	t.Parallel()
+
+	abs := newAdaptiveBatchSizer(time.Second)
+	abs.onBatchCompleted(1, 1, 2*time.Second)
+	assert.Equal(t, 6*time.Second, abs.getBatchTimeout())
+
+	abs = newAdaptiveBatchSizer(time.Second)
+	abs.onBatchCompleted(1, 1, time.Minute)
+	assert.Equal(t, maxBatchTimeout, abs.getBatchTimeout())
+}
@@ -99,27 +99,30 @@ func addDataToTrie(numKeysValues int, tr common.Trie) {
 }
 
 func createRequesterResolver(completeTrie common.Trie, interceptedNodes storage.Cacher, exceptionHashes [][]byte) RequestHandler {
-	return &testscommon.RequestHandlerStub{
-		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
-			for _, hash := range hashes {
-				if hashInList(hash, exceptionHashes) {
-					continue
-				}
-
-				buff, err := completeTrie.GetSerializedNode(hash)
-				if err != nil {
-					continue
-				}
-
-				var n *InterceptedTrieNode
-				n, err = NewInterceptedTrieNode(buff, marshalizer, hasherMock)
-				if err != nil {
-					continue
-				}
-
-				interceptedNodes.Put(hash, n, 0)
+	requestTrieNodes := func(destShardID uint32, hashes [][]byte, topic string) {
+		for _, hash := range hashes {
+			if hashInList(hash, exceptionHashes) {
+				continue
+			}
+
+			buff, err := completeTrie.GetSerializedNode(hash)
+			if err != nil {
+				continue
+			}
+
+			var n *InterceptedTrieNode
+			n, err = NewInterceptedTrieNode(buff, marshalizer, hasherMock)
+			if err != nil {
+				continue
 			}
-		},
+
+			interceptedNodes.Put(hash, n, 0)
+		}
+	}
+
+	return &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled:      requestTrieNodes,
+		RequestTrieNodesBatchCalled: requestTrieNodes,
 	}
 }
 
@@ -108,3 +108,12 @@ var ErrNilEpochNotifier = errors.New("nil EpochNotifier")
 
 // ErrNilIdleNodeProvider signals that a nil idle node provider was provided
 var ErrNilIdleNodeProvider = errors.New("nil idle node provider")
+
+// ErrInvalidProof signals that the provided proof does not contain all the trie nodes needed to prove the keys
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrNilTrieStatisticsHandler signals that a nil trie statistics handler was provided
+var ErrNilTrieStatisticsHandler = errors.New("nil trie statistics handler")
+
+// ErrNilTrieNodesHandler signals that a nil trie nodes handler was provided
+var ErrNilTrieNodesHandler = errors.New("nil trie nodes handler")
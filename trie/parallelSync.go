@@ -0,0 +1,348 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const maxParallelRequestBatches = 8
+
+type requestBatch struct {
+	missingHashes map[string]struct{}
+	numNodes      int
+	numBytes      uint64
+	requestTime   time.Time
+}
+
+type parallelTrieSyncer struct {
+	baseSyncTrie
+	shardId                   uint32
+	topic                     string
+	rootHash                  []byte
+	waitTimeBetweenChecks     time.Duration
+	maxParallelBatches        int
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	db                        common.DBWriteCacher
+	requestHandler            RequestHandler
+	interceptedNodesCacher    storage.Cacher
+	mutOperation              sync.Mutex
+	trieSyncStatistics        common.SizeSyncStatisticsHandler
+	timeoutHandler            TimeoutHandler
+	maxHardCapForMissingNodes int
+	batchSizer                *adaptiveBatchSizer
+	existingNodes             map[string]node
+	pendingHashes             map[string]struct{}
+	inFlightBatches           []*requestBatch
+	batchOfHash               map[string]*requestBatch
+}
+
+// NewParallelTrieSyncer creates a new instance of trieSyncer that keeps several batches of requested nodes in flight
+// at the same time. Each batch is sent separately, so the batches are answered by different peers. The size of the
+// batches adapts to the observed response latency and to the size of the received nodes, while the batches that are
+// not fully received in time are requested again
+func NewParallelTrieSyncer(arg ArgTrieSyncer) (*parallelTrieSyncer, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parallelTrieSyncer{
+		requestHandler:            arg.RequestHandler,
+		interceptedNodesCacher:    arg.InterceptedNodes,
+		db:                        arg.DB,
+		marshalizer:               arg.Marshalizer,
+		hasher:                    arg.Hasher,
+		topic:                     arg.Topic,
+		shardId:                   arg.ShardId,
+		waitTimeBetweenChecks:     time.Millisecond * 20,
+		maxParallelBatches:        maxParallelRequestBatches,
+		trieSyncStatistics:        arg.TrieSyncStatistics,
+		timeoutHandler:            arg.TimeoutHandler,
+		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
+		batchSizer:                newAdaptiveBatchSizer(arg.RequestHandler.RequestInterval()),
+	}
+
+	return p, nil
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network. All concurrent calls will be serialized
+func (p *parallelTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	p.mutOperation.Lock()
+	defer p.mutOperation.Unlock()
+
+	p.existingNodes = make(map[string]node)
+	p.pendingHashes = make(map[string]struct{})
+	p.inFlightBatches = make([]*requestBatch, 0, p.maxParallelBatches)
+	p.batchOfHash = make(map[string]*requestBatch)
+	p.rootHash = rootHash
+
+	p.pendingHashes[string(rootHash)] = struct{}{}
+
+	timeStart := time.Now()
+	defer func() {
+		p.setSyncDuration(time.Since(timeStart))
+	}()
+
+	for {
+		isSynced, err := p.syncStep()
+		if err != nil {
+			return err
+		}
+		if isSynced {
+			p.trieSyncStatistics.SetNumMissing(p.rootHash, 0)
+			return nil
+		}
+
+		select {
+		case <-time.After(p.waitTimeBetweenChecks):
+			continue
+		case <-ctx.Done():
+			return errors.ErrContextClosing
+		}
+	}
+}
+
+func (p *parallelTrieSyncer) syncStep() (bool, error) {
+	if p.timeoutHandler.IsTimeout() {
+		return false, ErrTrieSyncTimeout
+	}
+
+	err := p.collectReceivedNodes()
+	if err != nil {
+		return false, err
+	}
+
+	err = p.processExistingNodes()
+	if err != nil {
+		return false, err
+	}
+
+	p.expireBatches()
+	p.requestPendingHashes()
+
+	numMissing := len(p.pendingHashes) + len(p.batchOfHash)
+	p.trieSyncStatistics.SetNumMissing(p.rootHash, numMissing)
+
+	return numMissing+len(p.existingNodes) == 0, nil
+}
+
+// collectReceivedNodes moves the received nodes, requested or not yet requested, to the existing nodes
+func (p *parallelTrieSyncer) collectReceivedNodes() error {
+	for hash := range p.batchOfHash {
+		err := p.collectReceivedNode(hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	for hash := range p.pendingHashes {
+		err := p.collectReceivedNode(hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *parallelTrieSyncer) collectReceivedNode(hash string) error {
+	n, size, err := p.getReceivedNode([]byte(hash))
+	if err == ErrNodeNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	p.markAsReceived(hash, size)
+	p.existingNodes[hash] = n
+
+	return nil
+}
+
+// markAsReceived stops tracking the hash as missing. The batch holding the hash is completed when it was the last
+// missing hash of that batch
+func (p *parallelTrieSyncer) markAsReceived(hash string, size int) {
+	delete(p.pendingHashes, hash)
+
+	batch, isRequested := p.batchOfHash[hash]
+	if !isRequested {
+		return
+	}
+
+	delete(p.batchOfHash, hash)
+	delete(batch.missingHashes, hash)
+	batch.numBytes += uint64(size)
+	if len(batch.missingHashes) == 0 {
+		p.onBatchCompleted(batch)
+	}
+}
+
+func (p *parallelTrieSyncer) getReceivedNode(hash []byte) (node, int, error) {
+	value, ok := p.interceptedNodesCacher.Get(hash)
+	if !ok {
+		return nil, 0, ErrNodeNotFound
+	}
+	p.interceptedNodesCacher.Remove(hash)
+
+	n, err := trieNode(value, p.marshalizer, p.hasher)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := 0
+	interceptedNode, ok := value.(*InterceptedTrieNode)
+	if ok {
+		size = len(interceptedNode.GetSerialized())
+	}
+
+	return n, size, nil
+}
+
+func (p *parallelTrieSyncer) onBatchCompleted(batch *requestBatch) {
+	latency := time.Since(batch.requestTime)
+	p.batchSizer.onBatchCompleted(batch.numNodes, batch.numBytes, latency)
+	p.trieSyncStatistics.AddBatchResponse(latency)
+	p.removeInFlightBatch(batch)
+}
+
+func (p *parallelTrieSyncer) removeInFlightBatch(batch *requestBatch) {
+	for i, inFlightBatch := range p.inFlightBatches {
+		if inFlightBatch != batch {
+			continue
+		}
+
+		p.inFlightBatches = append(p.inFlightBatches[:i], p.inFlightBatches[i+1:]...)
+		return
+	}
+}
+
+func (p *parallelTrieSyncer) processExistingNodes() error {
+	for hash, element := range p.existingNodes {
+		numBytes, err := encodeNodeAndCommitToDB(element, p.db)
+		if err != nil {
+			return err
+		}
+
+		p.timeoutHandler.ResetWatchdog()
+
+		var children []node
+		var missingChildrenHashes [][]byte
+		missingChildrenHashes, children, err = element.loadChildren(p.getNode)
+		if err != nil {
+			return err
+		}
+
+		p.trieSyncStatistics.AddNumReceived(1)
+		if numBytes > core.MaxBufferSizeToSendTrieNodes {
+			p.trieSyncStatistics.AddNumLarge(1)
+		}
+		p.trieSyncStatistics.AddNumBytesReceived(uint64(numBytes))
+		p.updateStats(uint64(numBytes), element)
+
+		delete(p.existingNodes, hash)
+
+		for _, child := range children {
+			childHash := string(child.getHash())
+			p.markAsReceived(childHash, child.sizeInBytes())
+			p.existingNodes[childHash] = child
+		}
+
+		for _, missingHash := range missingChildrenHashes {
+			_, isRequested := p.batchOfHash[string(missingHash)]
+			if isRequested {
+				continue
+			}
+
+			p.pendingHashes[string(missingHash)] = struct{}{}
+		}
+
+		numMissing := len(p.pendingHashes) + len(p.batchOfHash)
+		if len(missingChildrenHashes) > 0 && numMissing > p.maxHardCapForMissingNodes {
+			break
+		}
+	}
+
+	return nil
+}
+
+// expireBatches moves the hashes of the batches that were not fully received in time back to the pending hashes, so
+// they will be requested again, most likely from other peers
+func (p *parallelTrieSyncer) expireBatches() {
+	batchTimeout := p.batchSizer.getBatchTimeout()
+	activeBatches := p.inFlightBatches[:0]
+	for _, batch := range p.inFlightBatches {
+		if time.Since(batch.requestTime) < batchTimeout {
+			activeBatches = append(activeBatches, batch)
+			continue
+		}
+
+		for hash := range batch.missingHashes {
+			delete(p.batchOfHash, hash)
+			p.pendingHashes[hash] = struct{}{}
+		}
+
+		p.batchSizer.onBatchTimeout()
+		p.trieSyncStatistics.AddNumBatchesTimedOut(1)
+	}
+	p.inFlightBatches = activeBatches
+}
+
+func (p *parallelTrieSyncer) requestPendingHashes() {
+	for len(p.inFlightBatches) < p.maxParallelBatches && len(p.pendingHashes) > 0 {
+		batchSize := p.batchSizer.getBatchSize()
+		batch := &requestBatch{
+			missingHashes: make(map[string]struct{}, batchSize),
+		}
+		hashes := make([][]byte, 0, batchSize)
+		for hash := range p.pendingHashes {
+			if len(hashes) == batchSize {
+				break
+			}
+
+			delete(p.pendingHashes, hash)
+			batch.missingHashes[hash] = struct{}{}
+			p.batchOfHash[hash] = batch
+			hashes = append(hashes, []byte(hash))
+		}
+
+		batch.numNodes = len(hashes)
+		p.inFlightBatches = append(p.inFlightBatches, batch)
+
+		// the batch is sent right away, so the latency is measured from the moment the request left the node
+		p.requestHandler.RequestTrieNodesBatch(p.shardId, hashes, p.topic)
+		batch.requestTime = time.Now()
+	}
+}
+
+func (p *parallelTrieSyncer) getNode(hash []byte) (node, error) {
+	return getNodeFromCacheOrStorage(
+		hash,
+		p.interceptedNodesCacher,
+		p.db,
+		p.marshalizer,
+		p.hasher,
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *parallelTrieSyncer) IsInterfaceNil() bool {
+	return p == nil
+}
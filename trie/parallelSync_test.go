@@ -0,0 +1,271 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkSyncedTrie(t *testing.T, db common.DBWriteCacher, rootHash []byte, numKeysValues int) {
+	tr, _ := createInMemoryTrieFromDB(t, db.(*testscommon.MemDbMock))
+	tr, _ = tr.Recreate(rootHash)
+	require.False(t, check.IfNil(tr))
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasherMock.Compute(fmt.Sprintf("%d", i))
+		val, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+}
+
+func TestNewParallelTrieSyncer_InvalidParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	arg.RequestHandler = nil
+	p, err := NewParallelTrieSyncer(arg)
+	assert.True(t, check.IfNil(p))
+	assert.Equal(t, ErrNilRequestHandler, err)
+}
+
+func TestNewParallelTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	p, err := NewParallelTrieSyncer(arg)
+	assert.False(t, check.IfNil(p))
+	assert.Nil(t, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	p, _ := NewParallelTrieSyncer(arg)
+
+	assert.Nil(t, p.StartSyncing(nil, context.Background()))
+	assert.Nil(t, p.StartSyncing(EmptyTrieHash, context.Background()))
+	assert.Equal(t, ErrNilContext, p.StartSyncing(bytes.Repeat([]byte{1}, len(EmptyTrieHash)), nil))
+}
+
+func TestParallelTrieSyncer_StartSyncingCanTimeout(t *testing.T) {
+	t.Parallel()
+
+	trSource, _ := createInMemoryTrie(t)
+	addDataToTrie(10, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	p, _ := NewParallelTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Equal(t, errors.ErrContextClosing, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingTimeoutNoNodesReceived(t *testing.T) {
+	t.Parallel()
+
+	trSource, _ := createInMemoryTrie(t)
+	addDataToTrie(10, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Second)
+	p, _ := NewParallelTrieSyncer(arg)
+
+	err := p.StartSyncing(rootHash, context.Background())
+	require.Equal(t, ErrTrieSyncTimeout, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingNewTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 1000
+	trSource, _ := createInMemoryTrie(t)
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+
+	p, _ := NewParallelTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+	assert.Equal(t, uint64(numKeysValues), p.NumLeaves())
+	assert.True(t, p.NumTrieNodes() > p.NumLeaves())
+	assert.True(t, p.NumBytes() > 0)
+	assert.True(t, p.Duration() > 0)
+	assert.True(t, arg.TrieSyncStatistics.NumBatchesCompleted() > 0)
+	assert.Equal(t, 0, arg.TrieSyncStatistics.NumBatchesTimedOut())
+	assert.Equal(t, 0, arg.TrieSyncStatistics.NumMissing())
+	assert.True(t, p.batchSizer.getBatchSize() > initialRequestBatchSize)
+}
+
+func TestParallelTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, memUnitSource := createInMemoryTrie(t)
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	exceptionHashes := make([][]byte, 0)
+	memUnitSource.RangeKeys(func(key []byte, val []byte) bool {
+		if len(exceptionHashes) >= numKeysValues/2 {
+			return false
+		}
+		_ = arg.DB.Put(key, val)
+		exceptionHashes = append(exceptionHashes, key)
+		return true
+	})
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, exceptionHashes)
+
+	p, _ := NewParallelTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+}
+
+func TestParallelTrieSyncer_StartSyncingShouldPipelineRequests(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 2000
+	trSource, _ := createInMemoryTrie(t)
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	resolver := createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+
+	mut := sync.Mutex{}
+	numInFlight := 0
+	maxNumInFlight := 0
+	arg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesBatchCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			mut.Lock()
+			numInFlight++
+			if numInFlight > maxNumInFlight {
+				maxNumInFlight = numInFlight
+			}
+			mut.Unlock()
+
+			go func() {
+				time.Sleep(time.Millisecond * 50)
+				resolver.RequestTrieNodes(destShardID, hashes, topic)
+
+				mut.Lock()
+				numInFlight--
+				mut.Unlock()
+			}()
+		},
+	}
+
+	p, _ := NewParallelTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+	mut.Lock()
+	assert.True(t, maxNumInFlight > 1)
+	mut.Unlock()
+}
+
+func TestParallelTrieSyncer_StartSyncingShouldRequestAgainLostBatches(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, _ := createInMemoryTrie(t)
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	arg := createMockArgument(time.Minute)
+	resolver := createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+
+	// the first request of each hash is lost, as if it was sent to an unresponsive peer
+	mut := sync.Mutex{}
+	requestedHashes := make(map[string]struct{})
+	arg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesBatchCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			hashesToResolve := make([][]byte, 0, len(hashes))
+			for _, hash := range hashes {
+				_, wasRequested := requestedHashes[string(hash)]
+				if wasRequested {
+					hashesToResolve = append(hashesToResolve, hash)
+					continue
+				}
+
+				requestedHashes[string(hash)] = struct{}{}
+			}
+
+			resolver.RequestTrieNodes(destShardID, hashesToResolve, topic)
+		},
+	}
+
+	p, _ := NewParallelTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := p.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues)
+	assert.True(t, arg.TrieSyncStatistics.NumBatchesTimedOut() > 0)
+}
+
+func TestParallelTrieSyncer_MarkAsReceivedShouldCompleteBatch(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	p, _ := NewParallelTrieSyncer(arg)
+	p.pendingHashes = make(map[string]struct{})
+	p.batchOfHash = make(map[string]*requestBatch)
+	p.inFlightBatches = make([]*requestBatch, 0)
+	p.pendingHashes["hash1"] = struct{}{}
+	p.pendingHashes["hash2"] = struct{}{}
+
+	p.requestPendingHashes()
+	require.Equal(t, 1, len(p.inFlightBatches))
+	require.Equal(t, 2, len(p.batchOfHash))
+	require.Equal(t, 0, len(p.pendingHashes))
+
+	p.markAsReceived("hash1", 10)
+	assert.Equal(t, 1, len(p.inFlightBatches))
+	p.markAsReceived("hash2", 10)
+	assert.Equal(t, 0, len(p.inFlightBatches))
+	assert.Equal(t, 0, len(p.batchOfHash))
+	assert.Equal(t, 1, arg.TrieSyncStatistics.NumBatchesCompleted())
+}
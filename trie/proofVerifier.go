@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
@@ -31,3 +32,13 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies the given multi-key Merkle proof and returns the proven values of the keys
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) ([][]byte, error) {
+	return mpv.trie.VerifyMultiProof(rootHash, keys, proof)
+}
+
+// VerifyRangeProof verifies the given range Merkle proof and returns all the leaves in the range
+func (mpv *merkleProofVerifier) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error) {
+	return mpv.trie.VerifyRangeProof(rootHash, startKey, endKey, proof)
+}
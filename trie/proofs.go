@@ -0,0 +1,439 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// GetMultiProof returns the trie nodes proving the presence or the absence of each of the given keys, each node being
+// included only once, along with the values of the keys, in the same order. Absent keys have a nil value.
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collector := newProofNodesCollector()
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, errCollect := tr.collectKeyProof(keyBytesToHex(key), collector)
+		if errCollect != nil {
+			return nil, nil, errCollect
+		}
+
+		values = append(values, value)
+	}
+
+	return collector.proof, values, nil
+}
+
+func (tr *patriciaMerkleTrie) collectKeyProof(hexKey []byte, collector *proofNodesCollector) ([]byte, error) {
+	currentNode := tr.root
+	for {
+		err := collector.add(currentNode)
+		if err != nil {
+			return nil, err
+		}
+		value := currentNode.getValue()
+
+		nextNode, nextKey, err := currentNode.getNext(hexKey, tr.trieStorage)
+		if errors.Is(err, ErrNodeNotFound) {
+			// the key path diverges from the trie here, so the nodes collected so far prove the absence of the key
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if nextNode == nil {
+			return value, nil
+		}
+
+		currentNode, hexKey = nextNode, nextKey
+	}
+}
+
+// GetRangeProof returns the leaves having the keys in the [startKey, endKey] interval, along with the trie nodes
+// proving that no other leaf exists in the interval, each node being included only once. The keys are compared in the
+// order the trie stores them: nibble by nibble, starting with the last key byte, low nibble first. Byte order can
+// not be used, as the keys of a byte order interval are spread all over the trie. An empty bound leaves the interval
+// open on that side. If maxLeaves is positive and the interval holds more leaves, the interval is shortened to end at
+// the last returned key.
+func (tr *patriciaMerkleTrie) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	rangeProof := &common.TrieRangeProof{
+		EndKey: endKey,
+	}
+	hexStart := keyToRangeBound(startKey)
+	hexEnd := keyToRangeBound(endKey)
+
+	if maxLeaves > 0 {
+		counter := tr.newTrieRangeWalker(hexStart, hexEnd, nil)
+		counter.maxLeaves = maxLeaves + 1
+		err = counter.walk(tr.root, []byte{})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(counter.leavesKeys) > maxLeaves {
+			hexEnd = counter.leavesKeys[maxLeaves-1]
+			rangeProof.HasMore = true
+		}
+	}
+
+	collector := newProofNodesCollector()
+	walker := tr.newTrieRangeWalker(hexStart, hexEnd, collector)
+	err = walker.walk(tr.root, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	rangeProof.Proof = collector.proof
+	rangeProof.Values = walker.leavesValues
+	rangeProof.Keys, err = hexKeysToKeyBytes(walker.leavesKeys)
+	if err != nil {
+		return nil, err
+	}
+	if rangeProof.HasMore {
+		rangeProof.EndKey = rangeProof.Keys[len(rangeProof.Keys)-1]
+	}
+
+	return rangeProof, nil
+}
+
+// VerifyMultiProof checks the multi-key proof against the root hash and returns the proven values of the keys, in the
+// same order. Absent keys have a nil value. ErrInvalidProof is returned if the proof does not cover all the keys.
+func (tr *patriciaMerkleTrie) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) ([][]byte, error) {
+	nodes := newProofNodes(proof, tr.marshalizer, tr.hasher)
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, err := nodes.getProvenValue(rootHash, keyBytesToHex(key))
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// VerifyRangeProof checks the range proof against the root hash and returns all the leaves having the keys in the
+// [startKey, endKey] interval, compared as in GetRangeProof. ErrInvalidProof is returned if the proof does not cover
+// the whole interval.
+func (tr *patriciaMerkleTrie) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error) {
+	nodes := newProofNodes(proof, tr.marshalizer, tr.hasher)
+	root, err := nodes.getNode(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	walker := &rangeWalker{
+		start:    keyToRangeBound(startKey),
+		end:      keyToRangeBound(endKey),
+		getChild: nodes.getChild,
+	}
+	err = walker.walk(root, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := hexKeysToKeyBytes(walker.leavesKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make([]core.KeyValueHolder, 0, len(keys))
+	for i := range keys {
+		leaves = append(leaves, keyValStorage.NewKeyValStorage(keys[i], walker.leavesValues[i]))
+	}
+
+	return leaves, nil
+}
+
+// proofNodesCollector gathers the encoded nodes of a proof, skipping the ones already added
+type proofNodesCollector struct {
+	encodedNodes map[string]struct{}
+	proof        [][]byte
+}
+
+func newProofNodesCollector() *proofNodesCollector {
+	return &proofNodesCollector{
+		encodedNodes: make(map[string]struct{}),
+		proof:        make([][]byte, 0),
+	}
+}
+
+func (collector *proofNodesCollector) add(n node) error {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	_, exists := collector.encodedNodes[string(encodedNode)]
+	if exists {
+		return nil
+	}
+
+	collector.encodedNodes[string(encodedNode)] = struct{}{}
+	collector.proof = append(collector.proof, encodedNode)
+
+	return nil
+}
+
+// proofNodes indexes the encoded nodes of a proof by their hashes
+type proofNodes struct {
+	encodedNodes map[string][]byte
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+}
+
+func newProofNodes(proof [][]byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) *proofNodes {
+	encodedNodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		encodedNodes[string(hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	return &proofNodes{
+		encodedNodes: encodedNodes,
+		marshalizer:  marshalizer,
+		hasher:       hasher,
+	}
+}
+
+func (pn *proofNodes) getNode(hash []byte) (node, error) {
+	encodedNode, ok := pn.encodedNodes[string(hash)]
+	if !ok {
+		return nil, ErrInvalidProof
+	}
+
+	return decodeNode(encodedNode, pn.marshalizer, pn.hasher)
+}
+
+func (pn *proofNodes) getChild(parent node, pos byte) (node, error) {
+	var childHash []byte
+	switch n := parent.(type) {
+	case *extensionNode:
+		childHash = n.EncodedChild
+	case *branchNode:
+		if int(pos) >= len(n.EncodedChildren) {
+			return nil, ErrInvalidProof
+		}
+		childHash = n.EncodedChildren[pos]
+	default:
+		return nil, ErrInvalidNode
+	}
+
+	if len(childHash) == 0 {
+		return nil, nil
+	}
+
+	return pn.getNode(childHash)
+}
+
+func (pn *proofNodes) getProvenValue(rootHash []byte, hexKey []byte) ([]byte, error) {
+	wantHash := rootHash
+	for {
+		currentNode, err := pn.getNode(wantHash)
+		if err != nil {
+			return nil, err
+		}
+
+		switch n := currentNode.(type) {
+		case *leafNode:
+			if bytes.Equal(hexKey, n.Key) {
+				return n.Value, nil
+			}
+			return nil, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, n.Key) {
+				return nil, nil
+			}
+			wantHash = n.EncodedChild
+			hexKey = hexKey[len(n.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[firstByte]) >= len(n.EncodedChildren) {
+				return nil, ErrInvalidProof
+			}
+			wantHash = n.EncodedChildren[hexKey[firstByte]]
+			if len(wantHash) == 0 {
+				return nil, nil
+			}
+			hexKey = hexKey[1:]
+		default:
+			return nil, ErrInvalidNode
+		}
+	}
+}
+
+// rangeWalker visits all the nodes whose subtries might hold keys in the [start, end] hex interval. A nil bound
+// leaves the interval open on that side
+type rangeWalker struct {
+	start        []byte
+	end          []byte
+	getChild     func(parent node, pos byte) (node, error)
+	collector    *proofNodesCollector
+	maxLeaves    int
+	leavesKeys   [][]byte
+	leavesValues [][]byte
+}
+
+func (tr *patriciaMerkleTrie) newTrieRangeWalker(start []byte, end []byte, collector *proofNodesCollector) *rangeWalker {
+	return &rangeWalker{
+		start:     start,
+		end:       end,
+		collector: collector,
+		getChild: func(parent node, pos byte) (node, error) {
+			return getResolvedChild(parent, pos, tr.trieStorage)
+		},
+	}
+}
+
+func getResolvedChild(parent node, pos byte, db common.DBWriteCacher) (node, error) {
+	err := resolveIfCollapsed(parent, pos, db)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n := parent.(type) {
+	case *extensionNode:
+		return n.child, nil
+	case *branchNode:
+		return n.children[pos], nil
+	default:
+		return nil, ErrInvalidNode
+	}
+}
+
+func (walker *rangeWalker) isLimitReached() bool {
+	return walker.maxLeaves > 0 && len(walker.leavesKeys) >= walker.maxLeaves
+}
+
+func (walker *rangeWalker) walk(n node, path []byte) error {
+	if walker.isLimitReached() {
+		return nil
+	}
+
+	if walker.collector != nil {
+		err := walker.collector.add(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		key := concat(path, currentNode.Key...)
+		if isKeyInRange(key, walker.start, walker.end) {
+			walker.leavesKeys = append(walker.leavesKeys, key)
+			walker.leavesValues = append(walker.leavesValues, currentNode.Value)
+		}
+		return nil
+	case *extensionNode:
+		return walker.walkChild(n, 0, concat(path, currentNode.Key...))
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			err := walker.walkChild(n, byte(i), concat(path, byte(i)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func (walker *rangeWalker) walkChild(parent node, pos byte, childPath []byte) error {
+	if !isPathInRange(childPath, walker.start, walker.end) {
+		return nil
+	}
+
+	child, err := walker.getChild(parent, pos)
+	if err != nil {
+		return err
+	}
+	if child == nil {
+		return nil
+	}
+
+	return walker.walk(child, childPath)
+}
+
+func keyToRangeBound(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+
+	return keyBytesToHex(key)
+}
+
+// isPathInRange returns true if keys starting with the given path might be in the [start, end] interval
+func isPathInRange(path []byte, start []byte, end []byte) bool {
+	if start != nil && bytes.Compare(path, start[:minLength(path, start)]) < 0 {
+		return false
+	}
+	if end != nil && bytes.Compare(path, end[:minLength(path, end)]) > 0 {
+		return false
+	}
+
+	return true
+}
+
+func isKeyInRange(key []byte, start []byte, end []byte) bool {
+	if start != nil && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	if end != nil && bytes.Compare(key, end) > 0 {
+		return false
+	}
+
+	return true
+}
+
+func minLength(a []byte, b []byte) int {
+	if len(a) < len(b) {
+		return len(a)
+	}
+
+	return len(b)
+}
+
+func hexKeysToKeyBytes(hexKeys [][]byte) ([][]byte, error) {
+	keys := make([][]byte, 0, len(hexKeys))
+	for _, hexKey := range hexKeys {
+		key, err := hexToKeyBytes(hexKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,170 @@
+package trie_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requireNoDuplicates(t *testing.T, proof [][]byte) {
+	seen := make(map[string]struct{})
+	for _, encodedNode := range proof {
+		_, found := seen[string(encodedNode)]
+		require.False(t, found)
+		seen[string(encodedNode)] = struct{}{}
+	}
+}
+
+func TestPatriciaMerkleTrie_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieMultipleValues(t, 100)
+	rootHash, _ := tr.RootHash()
+	absentKeys := [][]byte{
+		keccak.NewKeccak().Compute("absent 1"),
+		keccak.NewKeccak().Compute("absent 2"),
+	}
+	requestedKeys := [][]byte{keys[3], absentKeys[0], keys[50], keys[99], absentKeys[1]}
+
+	proof, values, err := tr.GetMultiProof(requestedKeys)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{keys[3], nil, keys[50], keys[99], nil}, values)
+	requireNoDuplicates(t, proof)
+
+	numSingleProofNodes := 0
+	for _, key := range requestedKeys {
+		singleProof, _, _ := tr.GetProof(key)
+		numSingleProofNodes += len(singleProof)
+	}
+	assert.Less(t, len(proof), numSingleProofNodes)
+
+	mpv, _ := trie.NewMerkleProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+	provenValues, err := mpv.VerifyMultiProof(rootHash, requestedKeys, proof)
+	require.Nil(t, err)
+	assert.Equal(t, values, provenValues)
+
+	t.Run("missing node should error", func(t *testing.T) {
+		_, errVerify := mpv.VerifyMultiProof(rootHash, requestedKeys, proof[1:])
+		assert.Equal(t, trie.ErrInvalidProof, errVerify)
+	})
+	t.Run("other root hash should error", func(t *testing.T) {
+		_, errVerify := mpv.VerifyMultiProof([]byte("other root hash"), requestedKeys, proof)
+		assert.Equal(t, trie.ErrInvalidProof, errVerify)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetMultiProofEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie(t)
+
+	_, _, err := tr.GetMultiProof([][]byte{[]byte("key")})
+	assert.Equal(t, trie.ErrNilNode, err)
+}
+
+func TestPatriciaMerkleTrie_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieMultipleValues(t, 100)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	// the recreated trie holds collapsed nodes, which are resolved from the storage while walking the range
+	tr, _ = tr.Recreate(rootHash)
+	mpv, _ := trie.NewMerkleProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+
+	t.Run("whole trie", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(nil, nil, 0)
+		require.Nil(t, err)
+		assert.False(t, rangeProof.HasMore)
+		assert.Equal(t, 100, len(rangeProof.Keys))
+		assert.ElementsMatch(t, keys, rangeProof.Keys)
+		assert.Equal(t, rangeProof.Keys, rangeProof.Values)
+		requireNoDuplicates(t, rangeProof.Proof)
+
+		leaves, err := mpv.VerifyRangeProof(rootHash, nil, nil, rangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, 100, len(leaves))
+		for i, leaf := range leaves {
+			assert.Equal(t, rangeProof.Keys[i], leaf.Key())
+			assert.Equal(t, rangeProof.Values[i], leaf.Value())
+		}
+	})
+	t.Run("paginated", func(t *testing.T) {
+		allKeys := make([][]byte, 0)
+		var startKey []byte
+		for numPages := 0; numPages < 20; numPages++ {
+			rangeProof, err := tr.GetRangeProof(startKey, nil, 30)
+			require.Nil(t, err)
+
+			leaves, err := mpv.VerifyRangeProof(rootHash, startKey, rangeProof.EndKey, rangeProof.Proof)
+			require.Nil(t, err)
+			require.Equal(t, len(rangeProof.Keys), len(leaves))
+
+			for _, key := range rangeProof.Keys {
+				if bytes.Equal(key, startKey) {
+					continue
+				}
+				allKeys = append(allKeys, key)
+			}
+
+			if !rangeProof.HasMore {
+				break
+			}
+			assert.Equal(t, rangeProof.Keys[len(rangeProof.Keys)-1], rangeProof.EndKey)
+			startKey = rangeProof.EndKey
+
+			_, err = mpv.VerifyRangeProof(rootHash, nil, nil, rangeProof.Proof)
+			assert.Equal(t, trie.ErrInvalidProof, err)
+		}
+
+		assert.ElementsMatch(t, keys, allKeys)
+	})
+	t.Run("absence in range", func(t *testing.T) {
+		absentKey := keccak.NewKeccak().Compute("absent")
+		rangeProof, err := tr.GetRangeProof(absentKey, absentKey, 0)
+		require.Nil(t, err)
+		assert.Empty(t, rangeProof.Keys)
+		assert.NotEmpty(t, rangeProof.Proof)
+
+		leaves, err := mpv.VerifyRangeProof(rootHash, absentKey, absentKey, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.Empty(t, leaves)
+	})
+	t.Run("single key range", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(keys[7], keys[7], 0)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{keys[7]}, rangeProof.Keys)
+
+		leaves, err := mpv.VerifyRangeProof(rootHash, keys[7], keys[7], rangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(leaves))
+		assert.Equal(t, keys[7], leaves[0].Value())
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(nil, nil, 0)
+		require.Nil(t, err)
+
+		proof := rangeProof.Proof[:len(rangeProof.Proof)-1]
+		_, err = mpv.VerifyRangeProof(rootHash, nil, nil, proof)
+		assert.Equal(t, trie.ErrInvalidProof, err)
+	})
+	t.Run("keys are in trie order", func(t *testing.T) {
+		orderedTrie := emptyTrie(t)
+		_ = orderedTrie.Update([]byte{0x12}, []byte("a"))
+		_ = orderedTrie.Update([]byte{0x21}, []byte("b"))
+		_ = orderedTrie.Update([]byte{0x13}, []byte("c"))
+
+		rangeProof, err := orderedTrie.GetRangeProof(nil, nil, 0)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{{0x21}, {0x12}, {0x13}}, rangeProof.Keys)
+
+		rangeProof, err = orderedTrie.GetRangeProof([]byte{0x21}, []byte{0x12}, 0)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{{0x21}, {0x12}}, rangeProof.Keys)
+	})
+}
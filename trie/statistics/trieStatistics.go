@@ -0,0 +1,122 @@
+package statistics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TrieStatsDTO holds the statistics collected while walking a trie
+type TrieStatsDTO struct {
+	NumBranches          uint64
+	NumExtensions        uint64
+	NumLeaves            uint64
+	TotalSizeInBytes     uint64
+	MaxLevel             int
+	LeavesLevelHistogram map[int]uint64
+	MissingNodes         [][]byte
+}
+
+// NumNodes returns the total number of nodes found in the trie
+func (dto *TrieStatsDTO) NumNodes() uint64 {
+	return dto.NumBranches + dto.NumExtensions + dto.NumLeaves
+}
+
+// ToString returns the statistics as a list of human readable lines
+func (dto *TrieStatsDTO) ToString() []string {
+	lines := []string{
+		fmt.Sprintf("num nodes: %d (branches: %d, extensions: %d, leaves: %d)",
+			dto.NumNodes(), dto.NumBranches, dto.NumExtensions, dto.NumLeaves),
+		fmt.Sprintf("total size: %d bytes", dto.TotalSizeInBytes),
+		fmt.Sprintf("max level: %d", dto.MaxLevel),
+		fmt.Sprintf("num missing nodes: %d", len(dto.MissingNodes)),
+		"leaves per level:",
+	}
+
+	levels := make([]int, 0, len(dto.LeavesLevelHistogram))
+	for level := range dto.LeavesLevelHistogram {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+
+	for _, level := range levels {
+		numLeaves := dto.LeavesLevelHistogram[level]
+		lines = append(lines, fmt.Sprintf("  %3d: %-10d %s", level, numLeaves, histogramBar(numLeaves, dto.NumLeaves)))
+	}
+
+	return lines
+}
+
+const histogramBarMaxLength = 50
+
+func histogramBar(value uint64, total uint64) string {
+	if total == 0 {
+		return ""
+	}
+
+	return strings.Repeat("#", int(value*histogramBarMaxLength/total))
+}
+
+type trieStatistics struct {
+	stats TrieStatsDTO
+}
+
+// NewTrieStatistics returns a structure able to collect the statistics of the nodes of a trie. It is not
+// concurrent safe, as a trie walk is sequential
+func NewTrieStatistics() *trieStatistics {
+	return &trieStatistics{
+		stats: TrieStatsDTO{
+			LeavesLevelHistogram: make(map[int]uint64),
+			MissingNodes:         make([][]byte, 0),
+		},
+	}
+}
+
+// AddBranchNode will account a branch node found on the provided level
+func (ts *trieStatistics) AddBranchNode(level int, size uint64) {
+	ts.stats.NumBranches++
+	ts.addNode(level, size)
+}
+
+// AddExtensionNode will account an extension node found on the provided level
+func (ts *trieStatistics) AddExtensionNode(level int, size uint64) {
+	ts.stats.NumExtensions++
+	ts.addNode(level, size)
+}
+
+// AddLeafNode will account a leaf node found on the provided level
+func (ts *trieStatistics) AddLeafNode(level int, size uint64, _ []byte, _ []byte) {
+	ts.stats.NumLeaves++
+	ts.stats.LeavesLevelHistogram[level]++
+	ts.addNode(level, size)
+}
+
+func (ts *trieStatistics) addNode(level int, size uint64) {
+	ts.stats.TotalSizeInBytes += size
+	if level > ts.stats.MaxLevel {
+		ts.stats.MaxLevel = level
+	}
+}
+
+// AddMissingNode will record the hash of a node referenced by its parent but not found in the storage
+func (ts *trieStatistics) AddMissingNode(_ int, hash []byte) {
+	ts.stats.MissingNodes = append(ts.stats.MissingNodes, hash)
+}
+
+// GetTrieStats returns a copy of the collected statistics
+func (ts *trieStatistics) GetTrieStats() *TrieStatsDTO {
+	stats := ts.stats
+	stats.LeavesLevelHistogram = make(map[int]uint64, len(ts.stats.LeavesLevelHistogram))
+	for level, numLeaves := range ts.stats.LeavesLevelHistogram {
+		stats.LeavesLevelHistogram[level] = numLeaves
+	}
+	stats.MissingNodes = make([][]byte, len(ts.stats.MissingNodes))
+	copy(stats.MissingNodes, ts.stats.MissingNodes)
+
+	return &stats
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *trieStatistics) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,69 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrieStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+
+	assert.False(t, check.IfNil(ts))
+	assert.Equal(t, uint64(0), ts.GetTrieStats().NumNodes())
+}
+
+func TestTrieStatistics_AddNodes(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(1, 100)
+	ts.AddExtensionNode(2, 50)
+	ts.AddLeafNode(3, 30, []byte("key1"), []byte("value1"))
+	ts.AddLeafNode(2, 20, []byte("key2"), []byte("value2"))
+	ts.AddLeafNode(3, 30, []byte("key3"), []byte("value3"))
+	ts.AddMissingNode(3, []byte("missing"))
+
+	stats := ts.GetTrieStats()
+	assert.Equal(t, uint64(1), stats.NumBranches)
+	assert.Equal(t, uint64(1), stats.NumExtensions)
+	assert.Equal(t, uint64(3), stats.NumLeaves)
+	assert.Equal(t, uint64(5), stats.NumNodes())
+	assert.Equal(t, uint64(230), stats.TotalSizeInBytes)
+	assert.Equal(t, 3, stats.MaxLevel)
+	assert.Equal(t, map[int]uint64{2: 1, 3: 2}, stats.LeavesLevelHistogram)
+	assert.Equal(t, [][]byte{[]byte("missing")}, stats.MissingNodes)
+}
+
+func TestTrieStatistics_GetTrieStatsReturnsACopy(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddLeafNode(1, 10, nil, nil)
+
+	stats := ts.GetTrieStats()
+	stats.LeavesLevelHistogram[1] = 100
+	stats.MissingNodes = append(stats.MissingNodes, []byte("hash"))
+
+	newStats := ts.GetTrieStats()
+	assert.Equal(t, uint64(1), newStats.LeavesLevelHistogram[1])
+	assert.Empty(t, newStats.MissingNodes)
+}
+
+func TestTrieStatsDTO_ToString(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(1, 100)
+	ts.AddLeafNode(2, 30, nil, nil)
+	ts.AddLeafNode(2, 30, nil, nil)
+
+	lines := ts.GetTrieStats().ToString()
+	assert.Equal(t, "num nodes: 3 (branches: 1, extensions: 0, leaves: 2)", lines[0])
+	assert.Equal(t, "total size: 160 bytes", lines[1])
+	assert.Equal(t, "max level: 2", lines[2])
+	assert.Contains(t, lines[len(lines)-1], "2: 2")
+}
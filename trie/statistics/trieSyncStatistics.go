@@ -1,20 +1,31 @@
 package statistics
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
+
+// batchLatencySmoothingFactor is the weight of the newest sample in the average batch latency
+const batchLatencySmoothingFactor = 0.2
 
 type trieSyncStatistics struct {
 	sync.RWMutex
-	numReceived      int
-	numMissing       int
-	numLarge         int
-	missingMap       map[string]int
-	numBytesReceived uint64
+	numReceived         int
+	numMissing          int
+	numLarge            int
+	missingMap          map[string]int
+	numBytesReceived    uint64
+	numBatchesCompleted int
+	numBatchesTimedOut  int
+	averageBatchLatency time.Duration
+	startTime           time.Time
 }
 
 // NewTrieSyncStatistics returns a structure able to collect sync statistics from a trie and store them
 func NewTrieSyncStatistics() *trieSyncStatistics {
 	return &trieSyncStatistics{
 		missingMap: make(map[string]int),
+		startTime:  time.Now(),
 	}
 }
 
@@ -26,6 +37,10 @@ func (tss *trieSyncStatistics) Reset() {
 	tss.numLarge = 0
 	tss.numBytesReceived = 0
 	tss.missingMap = make(map[string]int)
+	tss.numBatchesCompleted = 0
+	tss.numBatchesTimedOut = 0
+	tss.averageBatchLatency = 0
+	tss.startTime = time.Now()
 	tss.Unlock()
 }
 
@@ -50,6 +65,28 @@ func (tss *trieSyncStatistics) AddNumLarge(value int) {
 	tss.Unlock()
 }
 
+// AddBatchResponse records the latency of a fully received batch of requested nodes
+func (tss *trieSyncStatistics) AddBatchResponse(latency time.Duration) {
+	tss.Lock()
+	defer tss.Unlock()
+
+	tss.numBatchesCompleted++
+	if tss.numBatchesCompleted == 1 {
+		tss.averageBatchLatency = latency
+		return
+	}
+
+	delta := float64(latency-tss.averageBatchLatency) * batchLatencySmoothingFactor
+	tss.averageBatchLatency += time.Duration(delta)
+}
+
+// AddNumBatchesTimedOut will add the provided value to the existing numBatchesTimedOut
+func (tss *trieSyncStatistics) AddNumBatchesTimedOut(value int) {
+	tss.Lock()
+	tss.numBatchesTimedOut += value
+	tss.Unlock()
+}
+
 // SetNumMissing will write the provided value on the existing numMissing
 func (tss *trieSyncStatistics) SetNumMissing(rootHash []byte, value int) {
 	tss.Lock()
@@ -102,6 +139,54 @@ func (tss *trieSyncStatistics) NumBytesReceived() uint64 {
 	return tss.numBytesReceived
 }
 
+// NumBatchesCompleted returns the number of fully received batches of requested nodes
+func (tss *trieSyncStatistics) NumBatchesCompleted() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.numBatchesCompleted
+}
+
+// NumBatchesTimedOut returns the number of batches of requested nodes that were not fully received in time
+func (tss *trieSyncStatistics) NumBatchesTimedOut() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.numBatchesTimedOut
+}
+
+// AverageBatchLatency returns the smoothed time needed to fully receive a batch of requested nodes
+func (tss *trieSyncStatistics) AverageBatchLatency() time.Duration {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.averageBatchLatency
+}
+
+// NodesThroughput returns the number of received nodes per second, since the creation or the last reset
+func (tss *trieSyncStatistics) NodesThroughput() float64 {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return perSecond(float64(tss.numReceived), time.Since(tss.startTime))
+}
+
+// BytesThroughput returns the number of received bytes per second, since the creation or the last reset
+func (tss *trieSyncStatistics) BytesThroughput() float64 {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return perSecond(float64(tss.numBytesReceived), time.Since(tss.startTime))
+}
+
+func perSecond(value float64, elapsed time.Duration) float64 {
+	if elapsed < time.Millisecond {
+		return 0
+	}
+
+	return value / elapsed.Seconds()
+}
+
 // NumTries returns the number of tries that are currently syncing
 func (tss *trieSyncStatistics) NumTries() int {
 	tss.RLock()
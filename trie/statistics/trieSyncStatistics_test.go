@@ -2,6 +2,7 @@ package statistics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
@@ -106,3 +107,46 @@ func TestTrieSyncStatistics_BytesReceived(t *testing.T) {
 	tss.Reset()
 	assert.Equal(t, uint64(0), tss.NumBytesReceived())
 }
+
+func TestTrieSyncStatistics_Batches(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+
+	assert.Equal(t, 0, tss.NumBatchesCompleted())
+	assert.Equal(t, 0, tss.NumBatchesTimedOut())
+	assert.Equal(t, time.Duration(0), tss.AverageBatchLatency())
+
+	tss.AddBatchResponse(time.Second)
+	assert.Equal(t, 1, tss.NumBatchesCompleted())
+	assert.Equal(t, time.Second, tss.AverageBatchLatency())
+
+	tss.AddBatchResponse(2 * time.Second)
+	assert.Equal(t, 2, tss.NumBatchesCompleted())
+	assert.Equal(t, 1200*time.Millisecond, tss.AverageBatchLatency())
+
+	tss.AddNumBatchesTimedOut(3)
+	assert.Equal(t, 3, tss.NumBatchesTimedOut())
+
+	tss.Reset()
+	assert.Equal(t, 0, tss.NumBatchesCompleted())
+	assert.Equal(t, 0, tss.NumBatchesTimedOut())
+	assert.Equal(t, time.Duration(0), tss.AverageBatchLatency())
+}
+
+func TestTrieSyncStatistics_Throughput(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+	tss.startTime = time.Now().Add(-2 * time.Second)
+
+	tss.AddNumReceived(100)
+	tss.AddNumBytesReceived(1000)
+
+	assert.InDelta(t, 50, tss.NodesThroughput(), 1)
+	assert.InDelta(t, 500, tss.BytesThroughput(), 10)
+
+	tss.Reset()
+	assert.Equal(t, float64(0), tss.NodesThroughput())
+	assert.Equal(t, float64(0), tss.BytesThroughput())
+}
@@ -0,0 +1,203 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsStatisticsWalker is the DTO used to create a new instance of the statistics walker
+type ArgsStatisticsWalker struct {
+	DB          common.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type nodeToVisit struct {
+	hash   []byte
+	level  int
+	hexKey []byte
+}
+
+type statisticsWalker struct {
+	db          common.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewStatisticsWalker creates a walker able to visit all the nodes of a trie directly from the storage. The visited
+// nodes are not kept in memory, so it can be used on tries of any size
+func NewStatisticsWalker(args ArgsStatisticsWalker) (*statisticsWalker, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &statisticsWalker{
+		db:          args.DB,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Walk visits, depth first, all the nodes reachable from the provided root hash and reports them to the statistics
+// handler. The root node is on level 1, so the max level reported matches the one computed by GetNumNodes. Unlike the
+// trie iterator, it does not stop on a missing node: the node is reported as missing and the walk continues with the
+// rest of the trie
+func (sw *statisticsWalker) Walk(rootHash []byte, handler common.TrieStatisticsHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilTrieStatisticsHandler
+	}
+
+	onMissingNode := func(current nodeToVisit) error {
+		handler.AddMissingNode(current.level, current.hash)
+		return nil
+	}
+	onNode := func(current nodeToVisit, encodedNode []byte, decodedNode node) error {
+		size := uint64(len(current.hash) + len(encodedNode))
+		switch n := decodedNode.(type) {
+		case *branchNode:
+			handler.AddBranchNode(current.level, size)
+		case *extensionNode:
+			handler.AddExtensionNode(current.level, size)
+		case *leafNode:
+			key, err := hexToKeyBytes(concat(current.hexKey, n.Key...))
+			if err != nil {
+				return err
+			}
+
+			handler.AddLeafNode(current.level, size, key, n.Value)
+		}
+
+		return nil
+	}
+
+	return sw.walk(rootHash, onNode, onMissingNode)
+}
+
+// WalkNodes visits, depth first, all the nodes reachable from the provided root hash and provides them, in their
+// encoded form, to the nodes handler. The leaves are also provided as key-value pairs. A missing node stops the walk
+// with an error, as the handler would receive an incomplete trie
+func (sw *statisticsWalker) WalkNodes(rootHash []byte, handler common.TrieNodesHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilTrieNodesHandler
+	}
+
+	onMissingNode := func(current nodeToVisit) error {
+		return fmt.Errorf("%w for hash %s", ErrNodeNotFound, hex.EncodeToString(current.hash))
+	}
+	onNode := func(current nodeToVisit, encodedNode []byte, decodedNode node) error {
+		err := handler.HandleNode(current.hash, encodedNode)
+		if err != nil {
+			return err
+		}
+
+		ln, isLeaf := decodedNode.(*leafNode)
+		if !isLeaf {
+			return nil
+		}
+
+		key, err := hexToKeyBytes(concat(current.hexKey, ln.Key...))
+		if err != nil {
+			return err
+		}
+
+		return handler.HandleLeaf(key, ln.Value)
+	}
+
+	return sw.walk(rootHash, onNode, onMissingNode)
+}
+
+func (sw *statisticsWalker) walk(
+	rootHash []byte,
+	onNode func(current nodeToVisit, encodedNode []byte, decodedNode node) error,
+	onMissingNode func(current nodeToVisit) error,
+) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+
+	nodesToVisit := []nodeToVisit{{hash: rootHash, level: 1}}
+	for len(nodesToVisit) > 0 {
+		current := nodesToVisit[len(nodesToVisit)-1]
+		nodesToVisit = nodesToVisit[:len(nodesToVisit)-1]
+
+		encodedNode, err := sw.db.Get(current.hash)
+		if err != nil {
+			err = onMissingNode(current)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		decodedNode, err := decodeNode(encodedNode, sw.marshalizer, sw.hasher)
+		if err != nil {
+			log.Debug("statisticsWalker: can not decode node", "hash", current.hash, "error", err)
+			err = onMissingNode(current)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = onNode(current, encodedNode, decodedNode)
+		if err != nil {
+			return err
+		}
+
+		children, err := getNodesToVisit(current, decodedNode)
+		if err != nil {
+			return err
+		}
+
+		nodesToVisit = append(nodesToVisit, children...)
+	}
+
+	return nil
+}
+
+func getNodesToVisit(current nodeToVisit, decodedNode node) ([]nodeToVisit, error) {
+	switch n := decodedNode.(type) {
+	case *branchNode:
+		children := make([]nodeToVisit, 0, nrOfChildren)
+		for i := len(n.EncodedChildren) - 1; i >= 0; i-- {
+			if len(n.EncodedChildren[i]) == 0 {
+				continue
+			}
+
+			children = append(children, nodeToVisit{
+				hash:   n.EncodedChildren[i],
+				level:  current.level + 1,
+				hexKey: concat(current.hexKey, byte(i)),
+			})
+		}
+
+		return children, nil
+	case *extensionNode:
+		return []nodeToVisit{{
+			hash:   n.EncodedChild,
+			level:  current.level + 1,
+			hexKey: concat(current.hexKey, n.Key...),
+		}}, nil
+	case *leafNode:
+		return nil, nil
+	default:
+		return nil, ErrInvalidNode
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sw *statisticsWalker) IsInterfaceNil() bool {
+	return sw == nil
+}
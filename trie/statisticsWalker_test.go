@@ -0,0 +1,203 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStatisticsWalker(db common.DBWriteCacher) trie.ArgsStatisticsWalker {
+	return trie.ArgsStatisticsWalker{
+		DB:          db,
+		Marshalizer: &testscommon.ProtobufMarshalizerMock{},
+		Hasher:      &testscommon.KeccakMock{},
+	}
+}
+
+func TestNewStatisticsWalker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil db should error", func(t *testing.T) {
+		sw, err := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(nil))
+		assert.True(t, check.IfNil(sw))
+		assert.Equal(t, trie.ErrNilDatabase, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsStatisticsWalker(testscommon.NewMemDbMock())
+		args.Marshalizer = nil
+		sw, err := trie.NewStatisticsWalker(args)
+		assert.True(t, check.IfNil(sw))
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsStatisticsWalker(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		sw, err := trie.NewStatisticsWalker(args)
+		assert.True(t, check.IfNil(sw))
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sw, err := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(testscommon.NewMemDbMock()))
+		assert.False(t, check.IfNil(sw))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStatisticsWalker_Walk(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieMultipleValues(t, 100)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	db := tr.GetStorageManager()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		err := sw.Walk(rootHash, nil)
+		assert.Equal(t, trie.ErrNilTrieStatisticsHandler, err)
+	})
+	t.Run("empty trie", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		ts := statistics.NewTrieStatistics()
+		err := sw.Walk(trie.EmptyTrieHash, ts)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), ts.GetTrieStats().NumNodes())
+	})
+	t.Run("should visit all the nodes", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		leaves := make(map[string][]byte)
+		handler := &trieMock.TrieStatisticsHandlerStub{
+			AddLeafNodeCalled: func(level int, size uint64, key []byte, value []byte) {
+				leaves[string(key)] = value
+			},
+			AddMissingNodeCalled: func(level int, hash []byte) {
+				assert.Fail(t, "should have not found missing nodes")
+			},
+		}
+		err := sw.Walk(rootHash, handler)
+		require.Nil(t, err)
+
+		require.Equal(t, len(keys), len(leaves))
+		for _, key := range keys {
+			assert.Equal(t, key, leaves[string(key)])
+		}
+	})
+	t.Run("should match the in memory num nodes", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		ts := statistics.NewTrieStatistics()
+		err := sw.Walk(rootHash, ts)
+		require.Nil(t, err)
+
+		numNodes := tr.GetNumNodes()
+		stats := ts.GetTrieStats()
+		assert.Equal(t, uint64(numNodes.Branches), stats.NumBranches)
+		assert.Equal(t, uint64(numNodes.Extensions), stats.NumExtensions)
+		assert.Equal(t, uint64(numNodes.Leaves), stats.NumLeaves)
+		assert.Equal(t, numNodes.MaxLevel, stats.MaxLevel)
+		assert.Empty(t, stats.MissingNodes)
+
+		hashes, _ := tr.GetAllHashes()
+		totalSize := uint64(0)
+		for _, hash := range hashes {
+			encodedNode, _ := db.Get(hash)
+			totalSize += uint64(len(hash) + len(encodedNode))
+		}
+		assert.Equal(t, totalSize, stats.TotalSizeInBytes)
+	})
+	t.Run("missing node should be reported and the walk should continue", func(t *testing.T) {
+		memDb := testscommon.NewMemDbMock()
+		hashes, _ := tr.GetAllHashes()
+		for _, hash := range hashes {
+			encodedNode, _ := db.Get(hash)
+			_ = memDb.Put(hash, encodedNode)
+		}
+		// the root hash is the last one, the first one is deep in the trie
+		missingHash := hashes[0]
+		_ = memDb.Remove(missingHash)
+
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(memDb))
+		ts := statistics.NewTrieStatistics()
+		err := sw.Walk(rootHash, ts)
+		require.Nil(t, err)
+
+		stats := ts.GetTrieStats()
+		assert.Equal(t, [][]byte{missingHash}, stats.MissingNodes)
+		assert.True(t, stats.NumNodes() > 0)
+		assert.True(t, stats.NumNodes() < uint64(len(hashes)))
+	})
+}
+
+func TestStatisticsWalker_WalkNodes(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieMultipleValues(t, 100)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	db := tr.GetStorageManager()
+	hashes, _ := tr.GetAllHashes()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		err := sw.WalkNodes(rootHash, nil)
+		assert.Equal(t, trie.ErrNilTrieNodesHandler, err)
+	})
+	t.Run("should provide all the encoded nodes and leaves", func(t *testing.T) {
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		nodes := make(map[string][]byte)
+		leaves := make(map[string][]byte)
+		handler := &trieMock.TrieNodesHandlerStub{
+			HandleNodeCalled: func(hash []byte, encodedNode []byte) error {
+				nodes[string(hash)] = encodedNode
+				return nil
+			},
+			HandleLeafCalled: func(key []byte, value []byte) error {
+				leaves[string(key)] = value
+				return nil
+			},
+		}
+		err := sw.WalkNodes(rootHash, handler)
+		require.Nil(t, err)
+
+		require.Equal(t, len(hashes), len(nodes))
+		for _, hash := range hashes {
+			encodedNode, _ := db.Get(hash)
+			assert.Equal(t, encodedNode, nodes[string(hash)])
+		}
+		require.Equal(t, len(keys), len(leaves))
+		for _, key := range keys {
+			assert.Equal(t, key, leaves[string(key)])
+		}
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		memDb := testscommon.NewMemDbMock()
+		for _, hash := range hashes[1:] {
+			encodedNode, _ := db.Get(hash)
+			_ = memDb.Put(hash, encodedNode)
+		}
+
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(memDb))
+		err := sw.WalkNodes(rootHash, &trieMock.TrieNodesHandlerStub{})
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		sw, _ := trie.NewStatisticsWalker(createMockArgsStatisticsWalker(db))
+		err := sw.WalkNodes(rootHash, &trieMock.TrieNodesHandlerStub{
+			HandleNodeCalled: func(_ []byte, _ []byte) error {
+				numCalls++
+				return expectedErr
+			},
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
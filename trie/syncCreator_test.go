@@ -44,6 +44,18 @@ func TestNewTrieSync_SecondVariantImplementation(t *testing.T) {
 	assert.True(t, isInstanceOk)
 }
 
+func TestNewTrieSync_ThirdVariantImplementation(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	syncer, err := CreateTrieSyncer(arg, 3)
+
+	require.False(t, check.IfNil(syncer))
+	require.Nil(t, err)
+	_, isInstanceOk := syncer.(*parallelTrieSyncer)
+	assert.True(t, isInstanceOk)
+}
+
 func TestCheckTrieSyncerVersion(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +68,9 @@ func TestCheckTrieSyncerVersion(t *testing.T) {
 	err = CheckTrieSyncerVersion(secondVersion)
 	assert.Nil(t, err)
 
-	err = CheckTrieSyncerVersion(3)
+	err = CheckTrieSyncerVersion(thirdVersion)
+	assert.Nil(t, err)
+
+	err = CheckTrieSyncerVersion(4)
 	assert.True(t, errors.Is(err, ErrInvalidTrieSyncerVersion))
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -58,7 +59,9 @@ type governanceContract struct {
 	governanceConfig            config.GovernanceSystemSCConfig
 	initialWhiteListedAddresses [][]byte
 	enabledEpoch                uint32
+	viewFunctionsEnableEpoch    uint32
 	flagEnabled                 atomic.Flag
+	flagViewFunctions           atomic.Flag
 	mutExecution                sync.RWMutex
 }
 
@@ -94,19 +97,21 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	}
 
 	g := &governanceContract{
-		eei:                    args.Eei,
-		gasCost:                args.GasCost,
-		baseProposalCost:       baseProposalCost,
-		ownerAddress:           nil,
-		governanceSCAddress:    args.GovernanceSCAddress,
-		delegationMgrSCAddress: args.DelegationMgrSCAddress,
-		validatorSCAddress:     args.ValidatorSCAddress,
-		marshalizer:            args.Marshalizer,
-		hasher:                 args.Hasher,
-		governanceConfig:       args.GovernanceConfig,
-		enabledEpoch:           args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		eei:                      args.Eei,
+		gasCost:                  args.GasCost,
+		baseProposalCost:         baseProposalCost,
+		ownerAddress:             nil,
+		governanceSCAddress:      args.GovernanceSCAddress,
+		delegationMgrSCAddress:   args.DelegationMgrSCAddress,
+		validatorSCAddress:       args.ValidatorSCAddress,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		governanceConfig:         args.GovernanceConfig,
+		enabledEpoch:             args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		viewFunctionsEnableEpoch: args.EpochConfig.EnableEpochs.GovernanceViewFunctionsEnableEpoch,
 	}
 	log.Debug("governance: enable epoch for governance", "epoch", g.enabledEpoch)
+	log.Debug("governance: enable epoch for governance view functions", "epoch", g.viewFunctionsEnableEpoch)
 
 	err := g.validateInitialWhiteListedAddresses(args.InitialWhiteListedAddresses)
 	if err != nil {
@@ -166,6 +171,14 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.getValidatorVotingPower(args)
 	case "getBalanceVotingPower":
 		return g.getBalanceVotingPower(args)
+	case "getGovernanceConfig":
+		return g.getGovernanceConfig(args)
+	case "getProposalInfo":
+		return g.getProposalInfo(args)
+	case "getProposalTally":
+		return g.getProposalTally(args)
+	case "getUserVotes":
+		return g.getUserVotes(args)
 	}
 
 	g.eei.AddReturnMessage("invalid method to call")
@@ -242,7 +255,7 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		return vmcommon.UserError
 	}
 	commitHash := args.Arguments[0]
-	if len(commitHash) != commitHashLength {
+	if !IsValidCommitHash(commitHash) {
 		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
 		return vmcommon.UserError
 	}
@@ -619,7 +632,7 @@ func (g *governanceContract) whiteListProposal(args *vmcommon.ContractCallInput)
 		g.eei.AddReturnMessage("address is already whitelisted")
 		return vmcommon.UserError
 	}
-	if len(args.Arguments[0]) != commitHashLength {
+	if !IsValidCommitHash(args.Arguments[0]) {
 		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
 		return vmcommon.UserError
 	}
@@ -685,7 +698,7 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 	commitHash := args.Arguments[2]
-	if len(commitHash) != commitHashLength {
+	if !IsValidCommitHash(commitHash) {
 		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
 		return vmcommon.UserError
 	}
@@ -950,6 +963,154 @@ func (g *governanceContract) getBalanceVotingPower(args *vmcommon.ContractCallIn
 	return vmcommon.Ok
 }
 
+// getGovernanceConfig returns the thresholds the proposals are checked against when closed, followed by the
+//  proposal fee
+func (g *governanceContract) getGovernanceConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if scConfig.MinQuorum == nil || scConfig.MinPassThreshold == nil || scConfig.MinVetoThreshold == nil || scConfig.ProposalFee == nil {
+		g.eei.AddReturnMessage("governance config not set")
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(scConfig.MinQuorum.Bytes())
+	g.eei.Finish(scConfig.MinPassThreshold.Bytes())
+	g.eei.Finish(scConfig.MinVetoThreshold.Bytes())
+	g.eei.Finish(scConfig.ProposalFee.Bytes())
+
+	return vmcommon.Ok
+}
+
+// getProposalInfo returns the details of a proposal. Accepts a single parameter:
+//  args.Arguments[0] - proposal reference
+func (g *governanceContract) getProposalInfo(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(generalProposal.IssuerAddress)
+	g.eei.Finish(generalProposal.CommitHash)
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.StartVoteNonce).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.EndVoteNonce).Bytes())
+	g.eei.Finish([]byte(strconv.FormatBool(generalProposal.Closed)))
+	g.eei.Finish([]byte(strconv.FormatBool(generalProposal.Passed)))
+	g.eei.Finish(big.NewInt(int64(len(generalProposal.Votes))).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getProposalTally returns the voting power cast on each option of a proposal. Accepts a single parameter:
+//  args.Arguments[0] - proposal reference
+func (g *governanceContract) getProposalTally(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(generalProposal.Yes.Bytes())
+	g.eei.Finish(generalProposal.No.Bytes())
+	g.eei.Finish(generalProposal.Veto.Bytes())
+
+	return vmcommon.Ok
+}
+
+// getUserVotes returns the votes cast by an address on a proposal, each one as 5 consecutive values: the vote
+//  option, the power, the balance, the address the vote was delegated to and whether the vote was cast with funds.
+//  The votes are deleted when the proposal is closed, so only the votes cast with funds not yet claimed are
+//  returned for a closed proposal. Accepts 2 parameters:
+//  args.Arguments[0] - proposal reference
+//  args.Arguments[1] - voter address
+func (g *governanceContract) getUserVotes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	reference := args.Arguments[0]
+	voterAddress := args.Arguments[1]
+	if len(voterAddress) != len(args.CallerAddr) {
+		g.eei.AddReturnMessage("invalid argument - voter address")
+		return vmcommon.UserError
+	}
+
+	generalProposal, err := g.getGeneralProposal(reference)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	voteKey := getVoteItemKey(generalProposal.CommitHash, voterAddress)
+	if generalProposal.Closed {
+		voteKey = g.getVoteSetKeyForVoteWithFunds(append([]byte{}, reference...), voterAddress)
+	}
+
+	voteSet, err := g.getOrCreateVoteSet(voteKey)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.ExecutionFailed
+	}
+
+	for _, voteItem := range voteSet.VoteItems {
+		withFunds := voteItem.Balance != nil && voteItem.Balance.Cmp(zero) > 0
+
+		g.eei.Finish([]byte(voteTypeToString(voteItem.Value)))
+		g.eei.Finish(voteItem.Power.Bytes())
+		g.eei.Finish(voteItem.Balance.Bytes())
+		g.eei.Finish(voteItem.DelegatedTo)
+		g.eei.Finish([]byte(strconv.FormatBool(withFunds)))
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) checkArgumentsForViewFunc(args *vmcommon.ContractCallInput, numArguments int) vmcommon.ReturnCode {
+	if !g.flagViewFunctions.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	// the SC query service calls the view functions with the contract address as caller
+	if !bytes.Equal(args.CallerAddr, g.governanceSCAddress) {
+		g.eei.AddReturnMessage("this is only a view function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Vote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != numArguments {
+		g.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.FunctionWrongSignature
+	}
+
+	return vmcommon.Ok
+}
+
 // saveGeneralProposal saves a proposal into the storage
 func (g *governanceContract) saveGeneralProposal(reference []byte, generalProposal *GeneralProposal) error {
 	marshaledData, err := g.marshalizer.Marshal(generalProposal)
@@ -1209,6 +1370,20 @@ func (g *governanceContract) castVoteType(vote string) (VoteValueType, error) {
 	}
 }
 
+// voteTypeToString returns the vote option string a vote type was cast from
+func voteTypeToString(value VoteValueType) string {
+	switch value {
+	case Yes:
+		return yesString
+	case No:
+		return noString
+	case Veto:
+		return vetoString
+	default:
+		return value.String()
+	}
+}
+
 // getOrCreateVoteSet returns the vote data from storage for a given proposer/validator pair.
 //  If no vote data exists, it returns a new instance of VoteSet
 func (g *governanceContract) getOrCreateVoteSet(key []byte) (*VoteSet, error) {
@@ -1437,10 +1612,18 @@ func (g *governanceContract) convertV2Config(config config.GovernanceSystemSCCon
 	}, nil
 }
 
+// IsValidCommitHash returns true if the provided value has the length of the commit hashes referencing the proposals
+func IsValidCommitHash(commitHash []byte) bool {
+	return len(commitHash) == commitHashLength
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (g *governanceContract) EpochConfirmed(epoch uint32, _ uint64) {
 	g.flagEnabled.SetValue(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagViewFunctions.SetValue(epoch >= g.viewFunctionsEnableEpoch)
+	log.Debug("governance contract: view functions", "enabled", g.flagViewFunctions.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
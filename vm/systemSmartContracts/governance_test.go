@@ -2782,6 +2782,284 @@ func TestGovernanceContract_GetBalanceVotingPowerWrongArgumentsLength(t *testing
 	require.Contains(t, retMessage, errSubstr)
 }
 
+func TestGovernanceContract_GetGovernanceConfig(t *testing.T) {
+	t.Parallel()
+
+	returnedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			if bytes.Equal(key, []byte(governanceConfigKey)) {
+				configBytes, _ := args.Marshalizer.Marshal(&GovernanceConfigV2{
+					MinQuorum:        big.NewInt(10),
+					MinPassThreshold: big.NewInt(20),
+					MinVetoThreshold: big.NewInt(30),
+					ProposalFee:      big.NewInt(40),
+				})
+				return configBytes
+			}
+
+			return nil
+		},
+		FinishCalled: func(value []byte) {
+			returnedData = append(returnedData, value)
+		},
+	}
+
+	gsc, _ := NewGovernanceContract(args)
+	callInput := createVMInput(big.NewInt(0), "getGovernanceConfig", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedData := [][]byte{
+		big.NewInt(10).Bytes(),
+		big.NewInt(20).Bytes(),
+		big.NewInt(30).Bytes(),
+		big.NewInt(40).Bytes(),
+	}
+	require.Equal(t, expectedData, returnedData)
+}
+
+func TestGovernanceContract_GetProposalInfo(t *testing.T) {
+	t.Parallel()
+
+	returnedData := make([][]byte, 0)
+	issuer := []byte("issuer")
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+	generalProposal := &GeneralProposal{
+		IssuerAddress:  issuer,
+		CommitHash:     proposalIdentifier,
+		StartVoteNonce: 10,
+		EndVoteNonce:   20,
+		Yes:            big.NewInt(100),
+		No:             big.NewInt(50),
+		Veto:           big.NewInt(5),
+		Closed:         true,
+		Passed:         true,
+		Votes:          [][]byte{[]byte("voter1"), []byte("voter2")},
+	}
+
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			if bytes.Equal(key, append([]byte(proposalPrefix), proposalIdentifier...)) {
+				proposalBytes, _ := args.Marshalizer.Marshal(generalProposal)
+				return proposalBytes
+			}
+
+			return nil
+		},
+		FinishCalled: func(value []byte) {
+			returnedData = append(returnedData, value)
+		},
+	}
+
+	gsc, _ := NewGovernanceContract(args)
+	callInput := createVMInput(big.NewInt(0), "getProposalInfo", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{proposalIdentifier})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedData := [][]byte{
+		issuer,
+		proposalIdentifier,
+		big.NewInt(10).Bytes(),
+		big.NewInt(20).Bytes(),
+		[]byte("true"),
+		[]byte("true"),
+		big.NewInt(2).Bytes(),
+	}
+	require.Equal(t, expectedData, returnedData)
+
+	returnedData = make([][]byte, 0)
+	callInput = createVMInput(big.NewInt(0), "getProposalTally", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{proposalIdentifier})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedData = [][]byte{
+		big.NewInt(100).Bytes(),
+		big.NewInt(50).Bytes(),
+		big.NewInt(5).Bytes(),
+	}
+	require.Equal(t, expectedData, returnedData)
+}
+
+func TestGovernanceContract_GetProposalInfoProposalNotFound(t *testing.T) {
+	t.Parallel()
+
+	retMessage := ""
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		AddReturnMessageCalled: func(msg string) {
+			retMessage = msg
+		},
+	}
+
+	gsc, _ := NewGovernanceContract(args)
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+	callInput := createVMInput(big.NewInt(0), "getProposalInfo", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{proposalIdentifier})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, vm.ErrProposalNotFound.Error(), retMessage)
+}
+
+func TestGovernanceContract_ViewFunctionsWrongCallValueOrArguments(t *testing.T) {
+	t.Parallel()
+
+	retMessage := ""
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		AddReturnMessageCalled: func(msg string) {
+			retMessage = msg
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	for _, function := range []string{"getGovernanceConfig", "getProposalInfo", "getProposalTally", "getUserVotes"} {
+		callInput := createVMInput(big.NewInt(10), function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Equal(t, vm.TransactionValueMustBeZero, retMessage)
+
+		callInput = createVMInput(big.NewInt(0), function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{{1}, {2}, {3}})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+		require.Equal(t, vm.ErrInvalidNumOfArguments.Error(), retMessage)
+	}
+}
+
+func TestGovernanceContract_ViewFunctionsBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	retMessage := ""
+	args := createMockGovernanceArgs()
+	args.EpochConfig.EnableEpochs.GovernanceViewFunctionsEnableEpoch = 10
+	args.Eei = &mock.SystemEIStub{
+		AddReturnMessageCalled: func(msg string) {
+			retMessage = msg
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+	viewFunctions := map[string][][]byte{
+		"getGovernanceConfig": nil,
+		"getProposalInfo":     {proposalIdentifier},
+		"getProposalTally":    {proposalIdentifier},
+		"getUserVotes":        {proposalIdentifier, vm.GovernanceSCAddress},
+	}
+	for function, arguments := range viewFunctions {
+		retMessage = ""
+		callInput := createVMInput(big.NewInt(0), function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, arguments)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode, function)
+		require.Equal(t, "invalid method to call", retMessage, function)
+	}
+
+	gsc.EpochConfirmed(10, 0)
+	for function, arguments := range viewFunctions {
+		retMessage = ""
+		callInput := createVMInput(big.NewInt(0), function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, arguments)
+		_ = gsc.Execute(callInput)
+		require.NotEqual(t, "invalid method to call", retMessage, function)
+	}
+}
+
+func TestGovernanceContract_ViewFunctionsNotCalledByTheQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	retMessage := ""
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		AddReturnMessageCalled: func(msg string) {
+			retMessage = msg
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+	callerAddress := bytes.Repeat([]byte("c"), len(vm.GovernanceSCAddress))
+	viewFunctions := map[string][][]byte{
+		"getGovernanceConfig": nil,
+		"getProposalInfo":     {proposalIdentifier},
+		"getProposalTally":    {proposalIdentifier},
+		"getUserVotes":        {proposalIdentifier, callerAddress},
+	}
+	for function, arguments := range viewFunctions {
+		retMessage = ""
+		callInput := createVMInput(big.NewInt(0), function, callerAddress, vm.GovernanceSCAddress, arguments)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode, function)
+		require.Equal(t, "this is only a view function", retMessage, function)
+	}
+}
+
+func TestGovernanceContract_GetUserVotes(t *testing.T) {
+	t.Parallel()
+
+	voter := bytes.Repeat([]byte("v"), len(vm.GovernanceSCAddress))
+	delegatedTo := []byte("delegated to")
+	proposalIdentifier := bytes.Repeat([]byte("a"), commitHashLength)
+	generalProposal := &GeneralProposal{
+		CommitHash: proposalIdentifier,
+		Yes:        big.NewInt(0),
+		No:         big.NewInt(0),
+		Veto:       big.NewInt(0),
+	}
+	voteSet := &VoteSet{
+		VoteItems: []*VoteDetails{
+			{Value: Yes, Power: big.NewInt(10), Balance: big.NewInt(0), DelegatedTo: delegatedTo},
+			{Value: Veto, Power: big.NewInt(20), Balance: big.NewInt(400)},
+		},
+	}
+	fundsVoteSet := &VoteSet{
+		VoteItems: []*VoteDetails{
+			{Value: No, Power: big.NewInt(30), Balance: big.NewInt(900)},
+		},
+	}
+
+	returnedData := make([][]byte, 0)
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			switch {
+			case bytes.Equal(key, append([]byte(proposalPrefix), proposalIdentifier...)):
+				proposalBytes, _ := args.Marshalizer.Marshal(generalProposal)
+				return proposalBytes
+			case bytes.Equal(key, getVoteItemKey(proposalIdentifier, voter)):
+				voteSetBytes, _ := args.Marshalizer.Marshal(voteSet)
+				return voteSetBytes
+			case bytes.Equal(key, append(append([]byte(fundsLockPrefix), proposalIdentifier...), voter...)):
+				voteSetBytes, _ := args.Marshalizer.Marshal(fundsVoteSet)
+				return voteSetBytes
+			}
+
+			return nil
+		},
+		FinishCalled: func(value []byte) {
+			returnedData = append(returnedData, value)
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+	callInputArgs := [][]byte{proposalIdentifier, voter}
+
+	callInput := createVMInput(big.NewInt(0), "getUserVotes", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedData := [][]byte{
+		[]byte(yesString), big.NewInt(10).Bytes(), big.NewInt(0).Bytes(), delegatedTo, []byte("false"),
+		[]byte(vetoString), big.NewInt(20).Bytes(), big.NewInt(400).Bytes(), nil, []byte("true"),
+	}
+	require.Equal(t, expectedData, returnedData)
+
+	// the votes are deleted when closing the proposal, only the not yet claimed funds being kept
+	generalProposal.Closed = true
+	returnedData = make([][]byte, 0)
+	callInput = createVMInput(big.NewInt(0), "getUserVotes", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs)
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	expectedData = [][]byte{
+		[]byte(noString), big.NewInt(30).Bytes(), big.NewInt(900).Bytes(), nil, []byte("true"),
+	}
+	require.Equal(t, expectedData, returnedData)
+}
+
 // ========  Begin testing of helper functions
 
 func TestGovernanceContract_GetGeneralProposalNotFound(t *testing.T) {